@@ -1,375 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// res/bootstrap.min.css
-// res/bootstrap.min.js
-// res/index.html
-// res/jquery-3.2.1.slim.min.js
-// res/popper.min.js
-// res/prism.css
-// res/prism.js
-// DO NOT EDIT!
-
-package main
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _resBootstrapMinCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x6b\x8f\xe3\x38\x92\x28\xfa\xfd\xfc\x0a\x6d\x16\x0a\x5d\x39\x6d\xb9\x24\xf9\x99\x4e\x54\x63\x66\x1a\xb3\x38\x03\x4c\xef\x87\x9d\xb3\xc0\x05\xfa\xd4\x05\xf4\xa0\x6d\x4d\x49\x96\xae\x24\x57\x2a\xc7\xf0\xfe\xf6\x0b\xbe\x24\x3e\x82\x94\xe4\x74\x76\xcf\x01\xce\xd6\x4e\xa7\x4c\x05\x23\x82\x8c\x60\x44\x90\x22\x83\x9f\xff\xf0\x6f\xff\xc3\xf9\x83\xf3\xe7\xa2\x68\xea\xa6\x0a\x4b\xe7\xfb\x72\xee\xcd\x3d\x37\x42\x4d\x38\x0f\x9c\x4f\xc7\xa6\x29\xeb\xdd\xe7\xcf\x07\xd4\x44\x1c\x66\x1e\x17\xf9\x23\xae\xf5\x73\x51\xbe\x56\xe9\xe1\xd8\x38\x81\xe7\xfb\x6e\xe0\xf9\x1b\xe7\x7f\x1d\x91\x80\xed\x4f\xe7\xe6\x58\x54\xb5\x11\xf8\x25\x6d\x1a\x54\xcd\x9c\xbf\x9e\xe2\x39\x06\xfa\x5b\x1a\xa3\x53\x8d\x12\xe7\x7c\x4a\x50\xe5\xfc\xf2\xd7\xff\x25\xb0\x90\x36\xc7\x73\x84\x89\x7f\x6e\x5e\xa2\xfa\x73\xc7\xcf\xe7\x28\x2b\xa2\xcf\x79\x58\x37\xa8\xfa\xfc\xb7\xbf\xfe\xfc\x97\xff\xf8\xfb\x5f\x30\x7f\x9f\x77\x55\x51\x34\x17\xd7\x8d\xb2\x33\xda\x7d\xf0\xbc\x4d\xb4\xdf\x3f\xbb\x6e\x7a\x4a\xd2\x43\xb1\xfb\xb0\x5e\xfb\xde\x3e\x78\x76\xdd\xf2\x5c\x95\x19\xda\x7d\x58\xef\x97\x41\xec\xe3\x82\xf4\xf4\x6d\xf7\x01\x6d\x17\x68\x1b\x3f\xbb\x6e\x85\x92\xdd\x87\x24\x5e\xac\x96\xab\x67\xd7\x2d\xaa\xf0\x74\x40\xbb\x0f\xfb\x64\x83\xfc\xe5\xb3\xeb\xbe\xa2\x2c\x2b\x5e\x76\x1f\xf6\xfb\xd8\xf7\x36\xcf\xae\x7b\xa8\x10\x3a\xed\x3e\x04\xdb\x70\x43\x6a\x34\x28\xcc\x76\x1f\x02\x2f\x7e\x7a\xc2\xaf\xe3\xd7\xf0\xb4\xfb\xe0\x6f\xc2\x20\xda\x3e\xbb\xee\xcb\x31\x6d\x30\x3a\xc2\xdb\xa1\x0a\x5f\x77\x1f\xb6\xeb\x2d\x7a\x5a\xb3\x9f\x6e\x12\x56\xdf\x76\x1f\x16\xcb\x45\xb8\xf4\x30\x73\x55\x9a\x87\xd5\xab\xd0\xa0\x1a\xc5\xc5\x29\x21\x65\x5d\xcd\xfa\x1c\xc7\xa8\xae\x05\x2e\xd2\xd3\xbe\x10\xc9\x86\xd5\x29\x3d\x1d\x04\xb6\x13\xdc\xae\x4a\x68\x69\x86\xc5\xb5\xfb\xb0\xdf\xee\x9f\xf6\x21\x01\x90\x18\x89\x2a\x14\x7e\x2b\x8b\xf4\xd4\xb8\x6d\xbd\x53\x4a\xea\x7c\xb7\xda\xac\xcb\x56\x2e\xcd\x93\xdd\x66\xbd\x55\x4b\xb3\xc3\xee\xe9\x29\x50\x4b\xdb\x6c\xe7\x07\x9e\x47\x8a\xf7\xc5\xa9\x71\xf7\x61\x9e\x66\xaf\x6e\x1d\x9e\x6a\xb7\x46\x55\xba\xdf\xb9\x61\x59\x66\xc8\xad\x5f\xeb\x06\xe5\xb3\x3f\x67\xe9\xe9\xdb\x2f\x61\xfc\x77\xf2\xf3\xdf\x8b\x53\x33\x7b\xf8\x3b\x3a\x14\xc8\xf9\xaf\xbf\x3e\xcc\xfe\xb3\x88\x8a\xa6\x98\x3d\xfc\x4f\x94\x7d\x47\x4d\x1a\x87\xce\x7f\xa0\x33\x7a\x98\xfd\xa9\x4a\xc3\x6c\xd6\x23\x9d\x3d\xfc\x09\x23\x75\x7e\x2e\xb2\xa2\x72\xfe\x92\x17\xff\x48\x1f\x7a\x3c\x7a\xc1\xdf\x5f\xf3\xa8\xc8\x1e\x14\x26\xf3\xe2\x54\xd4\x65\x18\xa3\xdd\xc3\xdf\xff\xfd\x97\xe2\x54\xb8\xff\x89\x0e\xe7\x2c\xac\x1e\x66\xbf\xa0\x53\x56\xcc\x7e\x29\x4e\x61\x5c\xcc\x7e\x2e\x4e\x75\x91\x85\xf5\xec\xe1\x6f\x69\x84\xaa\xb0\x49\x8b\x93\x83\xe1\x1f\x66\x0f\x3f\x17\xe7\x2a\x45\x95\xf3\x1f\xe8\xe5\x61\xd6\x21\xbc\xfe\x31\x47\x49\x1a\x3a\x65\x95\x9e\x9a\xcb\x1f\x66\xbb\x5d\xb8\xc7\x63\x68\xb7\x8b\xd0\xbe\xa8\xd0\xa5\x41\x6d\xe3\xd6\xc7\x30\x29\x5e\x76\xa7\xe2\x84\xfe\x2d\xcd\xcb\xa2\x6a\xc2\x53\xf3\x1c\x15\x2d\xfc\xe6\x1a\xce\xc2\xdd\xf7\xb4\x4e\x1b\x94\x50\x04\x09\x8a\x0b\xca\xce\x8e\x8c\xc5\x2c\x3d\xa1\x6b\x18\x45\xd5\xaf\x4d\xda\x64\xe8\x2b\x23\x7b\x89\x8b\x53\x83\x4e\xcd\xee\xc1\xf9\xf4\xe0\x84\x4d\x53\x7d\x22\xef\x1f\x9d\x87\xc7\x87\x6b\x59\xa1\x0b\x51\x6f\x97\xf6\x45\x59\x21\xf7\xa5\x0a\x4b\x81\x70\x94\x15\xf1\xb7\xff\xef\x5c\x34\x68\x86\xa1\xa3\xa2\x4a\x50\xb5\xf3\xcb\xd6\xa9\x8b\x2c\x4d\x9c\x0f\x4f\x4f\x4f\xcf\x65\x78\x40\x54\x35\xdc\xf4\x54\xa7\x09\xda\x85\xdf\x8b\x34\xb9\x36\x47\x14\x26\x97\x24\xad\xcb\x2c\x7c\xdd\x35\x61\x94\x21\x17\x17\xa1\xca\x3d\x54\xc5\xb9\xbc\xa6\xf9\x61\xd6\x54\x17\x53\xfd\x63\x30\x3b\x2e\x66\xe5\xa5\xa8\xca\x63\x78\xaa\x77\x8b\xe7\x97\x34\x29\x5e\xea\xdd\x82\xbe\x12\x2b\x92\xe6\xb2\x7a\xf3\x53\xf8\x3d\x0a\xab\x8e\x32\xee\xcc\xeb\x3c\x0a\x93\x03\xd4\x02\xcf\xf3\xae\x73\xc2\x1c\x7b\xe9\xc6\x45\x96\x85\x65\x8d\x76\xfc\x41\xe8\x10\x0a\xe9\x34\xc9\x8c\x3f\x1d\x2f\x51\x18\x7f\xc3\x0d\x3a\x25\xb8\x6a\x51\x11\x6b\xa1\xd5\x71\x29\x76\x94\xf4\x95\x85\xa2\x23\xc0\x59\x92\x24\x02\x96\x2b\xa0\x4c\x44\x63\xd2\x7f\x62\x43\xc1\x78\x8f\x8a\xf6\x7a\x6c\xf2\xec\x22\xa8\xfb\xae\x1f\x3e\xcf\x58\x51\xdc\x23\x22\xa6\xc3\x9f\xfb\xab\x67\xf7\x05\x45\xdf\xd2\xc6\xa5\x7a\x99\xfe\x13\xb9\x61\xf2\x8f\x73\xdd\xec\x7c\xcf\xfb\xf8\xec\xe6\xb5\xe5\x4d\xf1\x1d\x55\xfb\xac\x78\x71\xeb\xe6\x35\x43\xbb\x3a\xae\x8a\x2c\x8b\xc2\xaa\x47\x1a\x96\xee\x31\x3d\x1c\x89\xa9\x62\x9d\xd3\x54\xe1\xa9\x2e\xc3\x0a\x9d\x9a\xeb\x1f\x31\x96\xef\x29\x7a\xc1\x8d\xbc\xbc\xa4\x49\x73\xdc\x25\xe8\x7b\x1a\x23\x97\xfc\xb8\x86\x55\x93\xc6\x19\x9a\x85\x58\x2d\x66\x49\x1a\x66\xc5\x61\xb6\x4f\x0f\x71\x58\x62\xe5\xc7\x8f\xe7\x0a\xcd\xf6\x45\x81\xfb\x85\x2a\xd7\xec\x48\xb4\x6b\x96\x87\xe9\x69\x76\x0a\xbf\xcf\x6a\x14\x63\xe0\x4e\x1f\x88\x52\x5f\xa3\x22\x79\xbd\xe4\x61\x75\x48\x4f\x3b\xef\x59\xec\xae\x7f\x25\xbb\x45\xf8\xc2\x9d\xbf\xf3\x2b\x94\xd3\x9f\x2f\x54\x7c\x4b\xcf\x53\xc4\xb9\x7a\x66\xfa\x17\xf8\xc1\x2a\x78\x7a\x26\x92\x0b\xb3\xf4\x70\xda\x65\x68\xdf\x3c\x83\x8a\x7a\xfd\xb5\x09\xa3\xf4\x94\xa0\xf6\xcb\x83\xeb\x3f\x7c\xdd\xed\x8b\xf8\x5c\x5f\x8a\x73\x83\x91\xef\x3c\x41\x05\x8f\x95\xa8\x71\xcc\xb6\x60\x95\x7b\x66\x2c\x78\xcf\x5c\x25\x88\xb9\x8a\x32\x74\x3d\xfa\x33\x3a\x8e\x8f\xcb\xd9\x71\x35\x3b\xae\x59\x9f\xbb\x4d\x51\xee\xbc\x67\xf6\x23\x2a\x9a\xa6\xc8\x77\xf3\x55\x85\xf2\x6b\x69\x03\xc1\xdd\x40\x2d\x5d\x12\x36\xa1\x5b\x54\xe9\x21\x3d\x85\x99\x4b\xed\xde\x4c\xb0\x81\x66\x4b\x29\x2b\x3d\x04\xe1\x24\x45\xd3\xa0\xe4\x79\x10\x20\x3e\x57\x75\x51\xed\x8e\x28\x2b\x9f\xbb\x21\x48\x18\xf5\xae\x61\x92\x54\xa8\xae\x2f\x7a\x03\x98\x58\xc9\xa8\x39\x15\x55\x1e\x66\x92\x24\xd3\xd3\x11\x55\x69\x73\x4d\xb2\x59\x91\xcd\xce\xd9\x60\x7f\x14\x99\x53\x60\x58\xe7\x8c\xc1\x1d\x52\xc9\xe9\xeb\x75\x1c\x25\xcd\x45\xd4\xa0\x8d\xe7\x5d\x93\xe4\x02\xc8\x80\x13\xc1\x6a\xb3\xf3\x04\x37\xd0\x8d\x18\xc7\x73\x08\xe9\x64\x7f\xba\x08\xad\x49\x9b\x30\x4b\xe3\x6b\x34\xab\x9b\xaa\x38\x1d\x24\x72\x51\x91\x25\xa8\xba\xd6\x79\x98\x31\xfb\x44\x14\x7b\xeb\x7d\xbc\xd6\xe7\x68\x56\x9f\xcb\x4b\x59\xd4\x29\xe9\xe8\x0a\x65\x61\x93\x7e\x47\xc2\x00\xd8\xac\x3e\x4a\xbd\xe4\x3d\x7f\x47\xd8\x3c\x84\x19\xd3\xf1\x28\xac\x11\x71\x84\xf5\x39\xba\xb0\xd6\xb8\xf3\x60\x85\xf2\x2b\xc6\x8d\x7b\xcf\x9d\xe3\x5f\xe1\x85\xa9\x3f\x8b\xd1\x54\x29\x63\x87\xa1\x0f\x16\xc1\x70\x99\x14\xc8\xad\xbf\xa5\xe5\xae\x88\xfe\x81\xe2\xa6\xbe\x86\xbb\x23\x1e\x0e\x3d\xb1\xd5\x3a\x5a\x98\x55\xea\x1a\xee\x4e\x45\xf3\xe9\xd7\x63\x85\xf6\x5f\x1f\xe9\x33\x1f\x9a\x5f\x1f\x19\x16\xa6\x1a\x20\xcb\x76\x04\x74\x5c\xcf\xec\x30\x22\xc3\x6f\x25\xd5\x9b\x90\x6b\x5c\x24\x68\xf6\x2d\x4a\x70\x10\x31\xab\xc3\xbc\x94\xdc\x53\x17\x3c\xf5\x61\x94\x68\xf7\xb0\x45\xa8\xd0\xd0\x18\xe8\x2d\x4f\x78\x6e\x0a\xab\x7b\xba\x52\xaf\xa1\xa9\x72\x9a\x1f\x2e\x8a\x4a\xe5\x69\x92\x64\x88\x8f\x6c\x3e\x60\xb1\x8a\x7d\x3f\x90\x56\x93\x49\xcc\xe3\xa5\x23\x7e\x4c\x93\x04\x9d\xae\xbf\x56\x45\x86\xbe\x44\xe7\xa6\x29\x4e\x5f\x67\xe1\x2c\xac\x50\x38\xa3\x3f\x67\xe9\xa9\x3c\x37\xac\xcb\x5e\x4b\xf4\x85\x4c\x58\xbe\x3e\xce\xb2\x30\x42\xd9\xac\x46\x19\x8a\x9b\x59\x7d\xce\xf1\x34\x62\x86\x3b\x1f\x57\xbe\x10\x4f\x5c\x9c\xe3\xa3\x1b\x12\x6f\xb6\xcb\xc3\x53\x5a\x9e\x33\x22\x93\x67\xe3\x9b\xab\x3d\xb2\xb9\x32\x47\x7a\x29\xc3\x24\x49\x4f\x07\xd2\xbd\xf3\x0d\x31\x01\xbc\x88\xdb\x05\x5a\xca\x94\x99\xcd\x64\x54\x0f\xc3\xd0\xb9\x24\x8a\xa3\x15\xaf\xcd\xf1\x22\x80\x71\xdb\x46\x5a\xdb\xb9\xe4\xf4\x44\x46\x36\xb1\x33\xa0\x4f\xa0\x7d\xc7\xdb\x51\x85\x49\x7a\xae\xb1\x61\x22\xc5\x8a\xbe\xe1\x10\x8a\x99\x67\x5e\xb4\x2a\x5b\x07\xeb\x85\xc3\x07\x2e\xa9\xe1\x56\xb8\x7d\xa4\x45\x57\x51\x38\xb3\xa2\x6c\x68\x0c\xc1\xa4\xd1\x49\x01\x8c\x17\xf8\x40\xe9\x75\x96\x97\x40\x46\x5d\x24\x74\xd1\xdc\x25\x7b\x4b\xe9\xd2\x7e\x23\x76\x67\x5f\x54\x39\xd5\x3c\xa6\x34\xa8\x46\xcd\xd7\x19\xfd\x51\x9f\xa3\x3c\x6d\xbe\x72\x05\xc3\xf1\x9f\x43\xdf\x30\x0d\xbc\xf0\x66\x87\x65\x89\xc2\x2a\x3c\xc5\x68\x47\x5f\x5d\x25\xb8\xdd\xce\xcd\x8b\x7f\xb2\xce\x49\x4f\x27\x54\xcd\x44\x72\xc6\xd7\x8c\x01\xe0\x3d\x13\x90\xf6\x82\x2b\xdc\xce\x03\x06\x17\xe9\x1b\x8a\x39\x3e\xa2\xf8\x5b\x54\xb4\x5f\x67\x42\x21\x16\x7f\xf1\x15\x8e\x7e\x9f\x3b\xc4\x22\x9a\x24\x6c\x90\x84\x02\x17\x34\x69\x8e\xdc\xac\x88\xc3\x4c\x7a\x95\x17\xa7\xe6\x28\x95\x60\x40\xb0\x0f\xb3\xb4\x6e\x70\xc4\xdd\xe9\x87\x6c\x84\x2a\x44\xb4\x81\xdb\x94\xeb\x3e\x45\x59\x52\xa3\xe6\x92\xa7\x27\x1a\xe0\xee\xbc\x9e\xdf\xe7\x4e\xb9\xd8\x54\xc0\xbb\x66\xe8\x80\x4e\x89\x1c\xb8\x3e\xd3\x8a\x24\x08\xcf\xc3\xd6\x15\x7e\xaa\xa8\x64\x97\x2e\x98\x54\x5a\x00\x68\xe7\xb3\x6c\xfa\xc5\xd9\x21\x8d\x53\xae\x65\x55\x1c\x48\x54\x63\x72\xbe\xb4\xcb\x4e\xe7\x3c\x42\x15\xd6\x08\xd6\x6b\x44\xea\x6e\x5d\x62\xae\xa8\x9a\x1a\x00\x8b\x73\x23\x03\x5e\x18\x8b\xb8\x4b\x19\xf6\x1a\x85\x55\x7c\xfc\xca\x47\xbc\x5b\xec\xf7\x35\x6a\x76\x2e\x59\xa5\xd0\xc5\x24\x8c\x1b\x56\xb3\x27\x47\x0b\xdc\x18\x03\x66\x32\x6b\x26\xd8\xde\x17\x42\x3a\x41\x88\xf5\x75\xf6\x69\x86\xdc\x73\x99\x15\x61\xc2\xdb\x83\x05\xd1\x75\xb1\x79\x64\x16\xe7\x06\x9b\x08\xc8\x44\x5e\x99\x7b\xe8\x5e\x62\x45\x74\xd3\x06\xe5\xd7\x06\xe5\x65\x16\x36\x48\x9e\xfd\xfe\x4a\xfd\xd2\x57\xa9\x54\x9c\xa2\x1e\xfd\xd9\xfc\x18\xcc\xe6\xc7\xc5\x6c\x7e\x5c\xce\xe6\xc7\xd5\x6c\x7e\x5c\xcf\x8c\xd1\xbb\xae\x59\x90\x31\x64\x81\xdf\x4a\x9b\xa9\x04\xb2\xa2\x11\xfa\x47\x5f\x08\x0a\x03\x6a\xf6\x31\x4f\xc7\x40\x2c\xa7\xa5\x0b\x3c\xfd\x17\x15\x7a\xc3\xc0\x97\xb3\xe3\xf2\xa2\x6a\xfa\x15\x37\xe7\xb8\x92\xca\x03\xf6\x62\x8d\x1b\x25\xcf\xb2\xae\xf3\x0c\x85\x89\x0e\x2d\xb5\x69\xe1\x79\xd7\x39\xeb\x4d\x57\xe4\x7c\x0d\x40\xaa\xad\xef\x6b\x8a\x6d\x5b\xcd\x21\x2a\xe6\xba\x62\x0f\x2c\x27\xd6\x15\x3b\x69\x31\xae\xee\xb1\x12\x63\x30\x5f\x98\x25\x88\x61\x18\xb7\x5d\xdc\xac\x13\xd8\x6e\x4d\xa3\x3a\x44\xe1\x27\x6f\x86\xff\xcd\xfd\xc7\xeb\x9c\xcc\x05\x66\xc0\x8c\x40\x9d\xe9\x5e\xe7\x79\x58\x7d\x9b\xe1\xff\x74\xae\x63\x1e\x60\x7a\xfa\x9c\x36\xde\x6f\xd1\xe2\x3a\x27\x63\xe2\x7c\x22\x7e\x25\xe9\x02\x1c\x3a\xa3\x79\x26\x2f\x05\x97\x43\xa1\xe9\x18\x9b\x02\x4b\x06\x1d\x3c\x46\x35\x30\x1a\x30\x66\x61\xdd\xb8\xf1\x31\xcd\x92\x47\xde\x9f\x15\x1d\x25\x65\x7b\x9d\xa7\xa7\xb4\x49\xc3\x2c\xad\x73\xa1\x3f\x9e\xbc\x8f\xcf\x4a\x28\x70\x2e\x4b\x54\xc5\x61\x8d\xae\x73\x6d\x72\x06\xcc\x34\x25\x9d\xef\x2b\xb8\x74\xe1\x44\x71\x30\xb2\x20\xa4\x80\x0f\xa8\xdc\x2d\x46\x75\x6b\x8d\xff\x3b\xf0\xfc\xa5\xf3\xbf\x3d\xef\x4f\xde\xc3\x75\x9e\xe6\x07\x77\x9f\x9d\x53\x3c\xcf\x94\xbc\x95\x68\xd6\x09\x54\x73\x3c\xe7\xd1\x29\x4c\x33\x41\xc2\x44\x33\xc1\x75\x8b\x67\x70\xc1\xec\x59\x8e\x10\x19\x02\xd2\x6f\x74\x62\x19\x66\x99\x33\x0f\x6a\x07\x85\x35\x72\xd3\x13\x76\x37\xaa\x1b\x95\x18\x63\x73\x05\x58\xc2\xf4\xa5\x8b\x27\x0e\x90\x5d\x94\x46\x50\x07\xcd\x43\x6e\x59\xbe\x72\x37\xdb\x27\x4c\xf2\x9a\xf5\x9b\x97\xac\x31\x31\xa1\xcb\x2b\x94\x3b\xf3\xa5\xac\x3b\x02\x87\x51\xb2\xf4\x97\x1b\x48\x26\xf4\x43\x04\x24\x80\x6b\xf8\x93\x44\xc4\x53\xe2\x0c\x0d\x19\xf7\x0b\xdf\xa2\x64\x3c\x67\x44\x29\x34\xb6\xd8\x5a\x98\xca\x16\xe6\xea\x5b\x94\x38\x22\x05\x4f\x1c\x2d\x9e\x62\x84\x36\x9e\x47\xe6\xa1\xf2\x58\x19\x9a\x95\x82\x8c\x52\x96\x30\x36\x47\xe9\x16\x7d\x0a\x31\xd0\x51\xe2\xa2\x84\x3a\x39\x9a\x97\x15\x72\xe9\xac\x97\x4c\x02\xb1\x9a\x33\x6d\x5c\x2c\xbd\xb2\xed\xa6\xcc\xee\x2b\x9b\x1c\x5f\xe7\x78\x10\x87\x29\x0e\xd0\xf5\xb8\x92\xd9\x29\x7f\x55\x76\x51\x36\x35\x91\xa4\x44\xb2\x65\x24\xfc\x15\xd7\x90\xc8\x60\x62\xdf\x46\x3e\xf5\xe1\x2f\xf9\x08\xf5\x78\x11\xc8\xf6\x63\x71\x85\x79\xbc\x02\x95\xc8\x37\x2a\x43\xa5\x4d\x60\xa8\x44\x3e\x61\x19\x2a\x3d\xad\x0d\x95\xe8\x17\x2e\x43\x2d\xdf\xa7\x0c\xf6\x2f\x99\x9d\x7b\x87\x9e\x9b\x57\xc5\x4b\xa7\x79\x6e\x5e\xbb\xfb\x0c\xb5\x78\xb6\xc3\xcb\xf0\xef\x67\xfe\x82\x7c\xc5\xd9\xe1\xff\x3c\x2b\x3f\x25\x52\xae\x48\x9d\xd0\x22\x25\xd7\xf9\xa9\x70\x0f\xe7\xa6\x41\x55\x2d\x7b\x28\x4f\x59\x16\x14\x00\x7f\x9a\xc7\x45\x36\x13\x0b\x7e\x8d\xb3\xb0\xae\xff\xf0\x25\x2e\x32\xf7\xeb\x45\xee\x08\x4f\xee\x05\xef\x4a\x6b\x63\x50\x9f\xfd\xf1\xd8\x5f\xfe\x3b\xa0\x7f\xd9\x9f\x05\xfd\xb3\xa4\x7f\x56\xf4\xcf\x9a\xfe\xd9\xd0\x3f\x5b\xfa\xe7\x89\xfe\xc1\xbd\x48\x9f\xb2\x03\xff\xcb\x69\xe1\x27\xaf\x7f\x14\x4a\x83\xee\xb1\x7f\x5a\x74\x4f\xcb\xee\x69\xd5\x3d\xad\xbb\xa7\x4d\xf7\xb4\xed\x9e\x9e\xba\xa7\x9e\x9f\x3c\xe1\x7f\x39\x3f\xf8\xc9\xeb\x1f\x85\xd2\xa0\x7b\xec\x9f\x16\xdd\xd3\xb2\x7b\x5a\x75\x4f\xeb\xee\x69\xd3\x3d\x6d\xbb\xa7\xa7\xee\xa9\xe7\xa7\xce\xf9\x5f\xce\x0f\x7e\xf2\xfa\x47\xa1\x34\xe8\x1e\xfb\xa7\x45\xf7\xb4\xec\x9e\x56\xdd\xd3\xba\x7b\xda\x74\x4f\xdb\xee\xe9\xa9\x7b\xea\xf9\x69\x33\xfe\x97\xf3\xd3\xf6\xea\xd1\xf6\x1a\xd2\xf6\x4a\xd2\x76\x7a\xd2\x76\xaa\xd2\x76\xda\xd2\x76\x0a\xd3\x76\x3a\xd3\x76\x6a\xd3\x76\x9a\xd3\x76\xca\xd3\x52\xfd\x01\x56\xa7\xc5\xb9\x78\x7a\xea\x7c\xbd\x30\xcc\x6d\x23\x9f\xa8\xfd\xa5\x1b\xb5\x65\x85\xf6\xa8\xaa\x50\x42\x3d\x80\x47\x07\x6f\x14\xd6\x29\xf9\x7e\xdf\x81\x11\x2e\xbe\xa3\x9d\x4f\x01\x0e\x55\xf1\xb2\xf3\x95\x20\xe6\xda\x69\x7d\x87\x9f\x2c\x74\x12\xf3\x22\xff\xa2\x95\x98\xdd\xe1\x38\x68\xa0\x4b\x46\x9e\x8c\x60\x3b\x5f\x90\xff\xfb\xf8\x0c\x14\xf5\xf5\xbb\x32\x8a\x24\x90\x91\xf8\xeb\xf9\x1a\xff\xdf\x46\xc0\x22\x94\x09\x4d\xe9\x0a\x29\x9e\x85\x8c\x27\x58\x09\x08\xf0\x8f\xbe\x66\xb0\x62\x55\x96\x72\x95\xc5\x42\x6f\x80\x50\xd6\x23\xe8\x0b\x29\x9e\x95\x8c\x67\xe9\xeb\x4d\x10\xca\x7a\x3c\x7d\x21\xc5\xb3\x96\xf1\xac\x3c\x01\xc1\x4a\x5a\xd3\x59\x71\x31\x6e\x94\x2a\x80\x0c\x56\x90\x10\x56\x8a\x14\xb6\x32\x9e\x35\x20\x85\x35\x24\x85\xb5\x22\x85\x27\x19\xcf\x46\x94\xc2\x46\x92\xc2\x86\x4b\xc1\xf7\x14\x35\x02\xc4\xb0\x85\xc4\xb0\x55\xc4\xe0\x2b\xfa\xf8\x04\xc8\xe1\x09\x92\xc3\x93\x22\x07\x5f\xd5\x49\x4f\x94\x04\xb0\xbc\x76\x9d\xd3\xf8\x6a\x9f\x56\x75\xd3\x8f\x5a\x3a\x0b\x71\xfd\x67\xfe\xc0\xe1\x7c\x15\x86\x83\x74\x10\x81\x0a\x11\x30\x88\x80\x43\x2c\x54\x88\x05\x83\x58\x70\x88\xa5\x0a\xb1\x64\x10\x4b\x0e\xb1\x52\x21\x56\x0c\x62\xc5\x21\xd6\x2a\xc4\x9a\x41\xac\x39\xc4\x46\x85\xd8\x30\x88\x0d\x87\xd8\xaa\x10\x5b\x06\xb1\xe5\x10\x4f\x2a\xc4\x13\x83\x78\xea\x7a\xcc\xd3\xba\xcc\xe3\x7d\xe6\x75\x40\x7a\xbf\x76\x1d\xdb\xf7\xbd\xd6\xb5\x3e\xef\x5b\x1f\x77\x2e\x59\x2f\x74\xfd\x8b\x18\xcf\x08\x83\x85\xbd\x0f\xa4\xf7\xa2\x2d\x62\x00\x0b\x09\x80\x98\x1c\xf6\x66\x29\xbd\x11\x6d\x09\x03\x58\x49\x00\xa2\x91\x60\x00\x6b\x09\x80\xd8\x02\xf6\x66\x23\xbf\xd1\xf9\xde\x4a\x00\x6b\x9d\xef\x27\x09\x60\x23\xf0\xed\x7b\x72\x9f\xe8\x8c\xfb\x72\xaf\x09\xc3\xca\x16\xde\x63\xa7\xfe\x9e\x9e\x8e\x85\x0c\x6f\x75\x76\x38\xa8\xb9\x87\xbf\xc3\x11\xd1\x9d\x5c\x1e\x0e\xa9\xa6\x7b\x3d\x1c\x7e\xdd\xc9\xf1\xe1\xf8\xed\x4e\xbe\x0f\x07\x80\xd3\xdd\x1f\x0e\x16\xef\xe4\x01\x71\xb4\x79\x27\x27\x88\xc3\xd5\xe9\x7e\x90\xc4\xd2\x77\x72\x85\x24\x18\xbf\x93\x37\x24\xd1\xfc\x8d\x0e\xb1\xce\x47\xfb\x44\x69\x7c\x99\xdc\xa2\x34\x78\x4c\x9e\x51\x1a\x16\x26\xe7\x28\x8d\x02\x93\x7f\x94\xf4\xdb\xe4\x22\x25\xcd\x35\x79\x49\x49\x51\x4d\x8e\x52\x52\x41\x93\xaf\x94\x94\xcb\xe4\x2e\x65\x5d\x32\x7b\x4c\x59\x4f\xcc\x4e\x53\xd6\x01\x8b\xdf\xac\x73\x57\x76\x13\x9e\xf8\x6a\xc8\xab\x12\xf1\x0e\x38\x56\x22\x5d\x93\x6f\x25\x52\x1d\x70\xaf\x44\xa8\x03\x1e\x96\xc8\xd4\xe4\x64\x89\x2c\x07\xfc\x2c\x11\xe5\x80\xab\x25\x92\x34\x79\x5b\x2a\xc1\x01\x87\x4b\xc5\x67\xf0\xb9\xd6\xe5\x31\x3c\xb3\x7f\x4f\xa7\xcb\xd6\x0d\xde\xea\x74\xf3\xe4\x3e\x4e\x37\x4f\xee\xe6\x74\xf3\xe4\x16\xa7\x9b\x27\x77\x73\xba\x79\x72\x37\xa7\x9b\x27\xb7\x38\xdd\x3c\xb9\x9b\xd3\xcd\x93\xbb\x39\xdd\x3c\xb9\xc5\xe9\x92\x05\xb5\x3b\x39\x5d\xb2\x22\x77\x27\xa7\x4b\x96\xf4\x6e\x74\xba\x79\x32\xda\xe9\x4a\xe3\xcb\xe4\x74\xa5\xc1\x63\x72\xba\xd2\xb0\x30\x39\x5d\x69\x14\x98\x9c\xae\xa4\xdf\x26\xa7\x2b\x69\xae\xc9\xe9\x4a\x8a\x6a\x72\xba\x92\x0a\x9a\x9c\xae\xa4\x5c\x26\xa7\x2b\xeb\x92\xd9\xe9\xca\x7a\x62\x76\xba\xb2\x0e\x58\x9c\x6e\x9e\x18\x9d\x2e\x11\xb0\xdd\xe9\x12\xf1\x0e\x38\x5d\x22\x5d\x93\xd3\x25\x52\x1d\x70\xba\x44\xa8\x03\x4e\x97\xc8\xd4\xe4\x74\x89\x2c\x07\x9c\x2e\x11\xe5\x80\xd3\x25\x92\x34\x39\x5d\x2a\xc1\x01\xa7\x4b\xc5\x37\xde\xe9\xf6\x9f\x97\x32\x37\x3b\xbc\xa7\xd3\x65\x1f\x0f\xde\xea\x74\xb3\xc3\x7d\x9c\x6e\x76\xb8\x9b\xd3\xcd\x0e\xb7\x38\xdd\xec\x70\x37\xa7\x9b\x1d\xee\xe6\x74\xb3\xc3\x2d\x4e\x37\x3b\xdc\xcd\xe9\x66\x87\xbb\x39\xdd\xec\x70\x8b\xd3\x25\x5f\xd5\xee\xe4\x74\xc9\x67\xb9\x3b\x39\x5d\xf2\x5d\xef\x46\xa7\x9b\x1d\x46\x3b\x5d\x69\x7c\x99\x9c\xae\x34\x78\x4c\x4e\x57\x1a\x16\x26\xa7\x2b\x8d\x02\x93\xd3\x95\xf4\xdb\xe4\x74\x25\xcd\x35\x39\x5d\x49\x51\x4d\x4e\x57\x52\x41\x93\xd3\x95\x94\xcb\xe4\x74\x65\x5d\x32\x3b\x5d\x59\x4f\xcc\x4e\x57\xd6\x01\x8b\xd3\xcd\x0e\x46\xa7\x4b\x04\x6c\x77\xba\x44\xbc\x03\x4e\x97\x48\xd7\xe4\x74\x89\x54\x07\x9c\x2e\x11\xea\x80\xd3\x25\x32\x35\x39\x5d\x22\xcb\x01\xa7\x4b\x44\x39\xe0\x74\x89\x24\x4d\x4e\x97\x4a\x70\xc0\xe9\x52\xf1\x8d\x77\xba\xc2\xf6\x8c\xcc\x6d\xdf\xf5\x4b\x6a\x7b\x9f\x8f\xa9\xed\x9d\xbe\xa7\xb6\xf7\xfb\xa4\xda\xde\xf4\x55\xb5\xbd\xdf\x87\xd5\xf6\x7e\xdf\x56\xdb\x9b\x3e\xaf\xb6\xf7\xfb\xc2\xda\xde\xef\x23\x6b\x7b\xd3\x77\xd6\xf6\x8e\x9f\x5a\xdb\x3b\x7e\x6d\x6d\xdf\xf0\xc1\xb5\xcd\x46\x7b\x5d\x69\x7c\x99\xbc\xae\x34\x78\x4c\x5e\x57\x1a\x16\x26\xaf\x2b\x8d\x02\x93\xd7\x95\xf4\xdb\xe4\x75\x25\xcd\x35\x79\x5d\x49\x51\x4d\x5e\x57\x52\x41\x93\xd7\x95\x94\xcb\xe4\x75\x65\x5d\x32\x7b\x5d\x59\x4f\xcc\x5e\x57\xd6\x01\x8b\xd7\x6d\x33\xa3\xd7\x25\x02\xb6\x7b\x5d\x22\xde\x01\xaf\x4b\xa4\x6b\xf2\xba\x44\xaa\x03\x5e\x97\x08\x75\xc0\xeb\x12\x99\x9a\xbc\x2e\x91\xe5\x80\xd7\x25\xa2\x1c\xf0\xba\x44\x92\x26\xaf\x4b\x25\x38\xe0\x75\xa9\xf8\x4c\x5e\x97\xa5\x46\x30\x1f\x3a\x82\xce\x00\x58\x76\xa9\x82\x19\x14\xba\x2d\xbe\xf4\x8c\xa1\x72\xb4\xa8\x29\x4a\xf8\x34\xc1\x07\xf4\x84\x62\xb4\xef\x50\x1e\x51\x48\x52\x29\xa8\x47\x93\x08\x6b\xca\xf1\xec\xc0\x88\x25\x2a\x92\xd7\x1f\xc9\x7f\x2f\x02\x55\x23\x3c\xcf\x1d\x01\x9e\xad\x67\x79\x1e\xea\x5c\x48\xfa\x80\x7f\x08\x4d\x5e\x90\x3d\xf9\x72\x3e\x08\x20\x19\x84\x44\x74\x7a\x2e\x09\x53\x75\xda\x65\x10\x12\xd6\x97\x52\xaf\xf1\x28\xa0\x6c\xbb\x96\x35\x55\x5a\x62\x78\xdc\x5f\x4e\x53\xed\x4e\xcd\xd1\x2d\xf6\x6e\xf3\x5a\xa2\x4f\x45\x92\x3c\xea\x1d\x23\x9e\x01\xf1\x56\x8f\x1c\x13\x39\x7a\xdc\xe3\xa1\x27\x91\xed\x95\x37\x7d\x6d\x96\x76\x67\x26\xff\xfc\xa9\x6f\x59\x57\x02\xe5\xeb\x88\xb6\x49\xd8\x4b\x8b\x72\x22\xd7\x33\xf1\xf3\xe1\x69\x1f\x27\x63\xaa\x0a\xac\xd8\x80\x20\xee\x64\x12\x5d\x3a\xa1\x99\x5a\x20\x90\x10\xca\x20\x8c\x49\x92\xec\x51\x00\x32\xdd\x67\x2b\x32\xb5\x38\xde\x27\x41\xb2\x1e\x53\xd9\xd4\x66\x0d\x0c\xe2\x51\x26\xc3\x12\x26\xcd\xe4\x9f\x62\x8b\x79\x09\x88\x6b\x81\xd6\x71\x04\xb3\xcc\x32\x31\x99\x5a\x1b\xf9\xc9\x3e\x1a\x51\xd5\xd8\x56\x19\x08\xd4\x3e\x89\x44\x7a\xda\x17\x33\xe1\x59\x40\x4c\x7f\x82\x28\x10\x5a\x21\x98\x4b\x92\x58\xca\xd4\xba\x30\x4a\x12\xb4\x1a\xa8\x67\x6a\x9a\x08\x01\x31\x25\x23\x67\x19\xad\x66\xf2\x4f\x01\x77\x57\x02\x67\xd4\x41\x28\x0a\x41\x46\x79\xaa\x2c\x53\x1b\xf7\x7b\xb4\x0d\xfd\xe1\xaa\xa6\x66\x2a\x40\x06\xee\x04\x12\x34\x53\xd7\x4c\xfa\x25\x20\xe7\x05\x20\xa2\x55\x6c\x52\x54\x96\xff\xcb\xd8\x4a\x3f\xf2\xa2\xcd\x60\x4d\x53\x23\x65\x18\x90\x35\x89\x00\xc9\xe8\x33\x13\x7f\x08\x98\xd9\x6f\x10\x4b\xb2\x4f\xf6\x08\x64\x93\xe6\x33\x33\xb5\x0f\xc5\x28\xde\xc3\x56\x47\xa8\x68\x6a\x9e\x04\x02\xf1\x25\xa3\x4f\xc2\xea\xdb\x4c\x78\x96\xa4\x87\x7f\x82\x46\x66\x1d\x6f\x63\x58\x45\x49\x6a\x36\xa3\x85\x79\x8a\xa2\x08\xee\x92\xbe\x9e\x59\x6e\x3d\x04\x68\x18\x24\xe4\x61\xdc\xa4\xdf\xd1\x4c\xfa\x25\x60\xe6\x05\x00\x22\x93\xdf\x95\x98\xa1\xf5\xa7\xba\x6e\x23\x0a\x53\x9b\x65\x98\xf1\xcc\x3a\x73\x12\xd5\x90\x3e\xc3\xa1\xcd\x84\x63\x63\xac\x70\x11\x2c\xb6\x0b\xa4\xa0\x23\xba\x25\xe0\x5b\x3e\xad\xbc\x15\x74\x40\x8e\xc6\x60\x0a\x4a\x39\x30\xc3\xac\x8d\xe1\x4b\x84\x77\x64\xe5\x74\x9a\xa3\xf2\x53\x8e\xe4\xa0\xa6\x10\x48\x43\x0c\xea\x01\x30\xb7\x46\x7d\xc1\x6a\x35\xe3\xff\x13\x63\x3f\x01\xf5\x84\x30\x50\xc6\x86\xe5\xdc\x2d\x11\xf6\x0b\x34\x9b\x15\x59\x21\xa4\xc8\x2b\x54\x97\xc5\xa9\x4e\xbf\xe3\x28\xdc\x9c\x56\xa1\x3b\x17\xd7\xb2\x64\x32\x3c\x35\x41\x97\x50\x86\x9c\x96\xc3\x4e\x81\x24\x5e\x81\xb2\xcd\xe0\x22\x5c\xf9\x98\x92\x33\x1f\x7d\xf2\x19\x88\x15\x63\xd7\x03\x2d\xda\xac\x37\x70\x8b\x72\x4b\xa2\x88\xdf\xb8\x45\x79\x32\xa5\x45\x4f\x4f\x3e\xdc\xa2\xec\xf0\x2f\xd3\xa2\xec\x30\xa5\x45\xbe\xff\xf4\x04\x37\xa9\xcd\xfe\x65\x9a\xd4\x66\xe6\x26\x69\xd0\xff\x2a\x5c\x9b\x8d\xd4\xbe\xa8\x72\x37\x2e\x4e\x4d\x55\x58\xfa\xb8\x9f\x76\x93\xa5\x06\x87\xad\x38\x28\x69\xf6\x0c\x69\xf5\x8c\x96\x5d\x31\xd5\x69\x1e\x1e\x90\x9e\x45\x2c\x4b\xcb\x5d\x9f\x54\xa9\x05\x0e\xb0\xc7\x28\x59\x26\xf0\x11\x6a\xf1\x0c\xbb\x68\xc9\xc5\x83\xec\xce\xdc\x5f\xd5\xb3\x3e\x8d\xa8\xf6\x4e\xee\xa5\x1d\xe9\x77\xd4\x96\xe1\x29\xd1\x4d\xac\x7e\xb2\x58\xed\x65\x96\x76\x69\x54\xe7\x48\xbe\x67\xeb\x45\xc9\x7e\xdf\x25\x67\xf2\xc4\xcc\xa7\x9e\x83\xff\xd1\xb3\xde\xcc\x8f\xfb\xc1\x82\xda\xf8\x00\x7b\x0c\xa5\x05\x5d\x7a\x99\xf2\xdc\xb8\x65\x16\xc6\xe8\x48\xd2\xcc\x5d\xe4\x44\x55\x45\x19\xc6\x69\xf3\x4a\x8e\xe1\x8b\x08\x70\x0f\xdc\x5a\xf7\x6d\x95\xa7\x57\x49\xd2\x1a\x2b\x7f\x32\x93\x8a\x7f\xad\x50\x98\x14\xa7\xec\xf5\x2b\x14\xd6\xd2\x90\xa3\xc7\x48\x13\x4b\xc9\x78\x49\x2a\x32\xac\xfc\x3c\x93\x5b\x7e\xce\x9a\xb4\xcc\xd0\xd7\x47\x9e\x7b\x27\x0e\xb3\xf8\x53\x40\x35\xd1\xf9\xd1\x09\xca\xf6\x11\x44\x45\x34\x82\x76\xcc\xf7\x30\x3b\xa3\x31\xca\x21\xb7\x92\x24\xcc\x91\x5b\xe8\x92\x0c\x69\x4a\xfa\x4e\xf2\x55\x81\x40\xd1\x4c\x62\x62\x02\x33\xc2\x2d\x1f\xe1\x3f\x3a\xd8\xbb\xa8\xd9\xcc\x20\x10\x25\x5b\xa2\x6a\x05\x54\x92\xd8\x3d\xe9\x54\x07\x69\x8a\x00\x7a\x8a\x99\x21\x9a\x75\x0e\xd0\x0c\x06\x89\x06\x30\xd5\xf9\x76\x33\x44\x95\x66\xa0\x92\xd2\xc3\x2d\xe0\xfc\x70\xac\x58\xed\x45\x35\xb7\x8e\x24\xd9\x32\x0b\xd3\x53\x83\xda\xe6\x2d\x14\x54\x6b\x3d\xc6\x94\x51\x83\x0b\x64\x4f\x60\xbe\xa2\x6c\x1d\xcf\xc4\xab\x5c\x9c\x1d\x66\xa3\xe0\xea\x7c\x36\xa7\xc6\x82\xa4\x96\x73\xb3\xc3\x4f\x63\xea\x8d\xae\x24\x82\x85\x49\x52\x9c\xf4\x9a\xe2\xef\xa8\x39\x19\x51\x45\x8d\x52\xb9\xce\x6f\xe0\xd5\x52\x69\x80\x57\x5c\x73\x02\xaf\x83\xc9\x05\xac\x72\x50\xd9\xb4\xb3\x32\x96\x5b\x81\x2d\xe6\xc0\x1d\x35\x0b\x9b\x61\xf0\x81\x99\x4a\x06\x09\x32\x63\x1c\x35\x27\x9b\x39\xd7\x18\x9f\xe8\x0e\x4c\xf5\xb5\x1e\xb2\x22\x01\x88\xba\x75\x3e\xda\x0d\xf9\xf3\xad\x2f\x39\x22\x7d\x3c\xda\x86\x8c\x7d\x58\x8c\x1d\x39\x92\x7c\x09\x33\x70\xb6\xa5\x21\xe9\x2e\x34\xe9\x42\xe4\x6e\x91\x6e\x76\x78\x9b\x74\xfb\xfa\x6f\x97\x6e\x76\x98\x10\x64\x6c\x37\xba\x70\x09\x71\x20\xbd\x15\x7b\x4d\x3c\x88\x31\x31\x0f\xcf\x7b\x45\x40\xdf\x25\x91\x8a\x96\x47\x85\x24\x77\xe0\xf4\x58\x6e\x94\xee\xa7\x2d\x33\x8a\x96\x27\xa2\xc7\x44\x52\x61\x02\x39\x28\xc0\x76\x4b\xc9\x53\x85\xfa\x73\x1e\x44\x3a\x42\x21\x0b\xa1\x94\x4c\x5f\xda\x7b\x39\x7f\x05\xd3\x6f\x35\xc5\xb4\x58\x8d\xe6\x38\xed\x38\x0e\xa3\xba\xc8\xce\x0d\xd2\x65\xa3\x24\xa1\x91\x04\xc6\x51\x91\xcc\x6c\xb6\x7c\xb1\xb4\x03\x79\x26\x40\xad\x2e\xd0\x60\x30\xd9\xef\x75\xfe\x3d\xcc\xd2\xc4\xdd\x23\x94\xe0\x48\x42\x4a\x99\x08\xb0\xae\x1b\x74\xbe\x52\x47\xae\xf6\xe0\xe8\x9a\xa2\xc0\xfa\x0e\xf4\x06\xf9\xa6\x8d\xe7\xa6\xff\x74\x49\xfe\xe4\xdd\xea\x59\x22\xc9\x77\x5e\x79\xbd\x6a\xc8\x49\xba\x09\x33\xfe\x08\xe7\x62\x4d\x94\x45\x26\x5b\x4b\x6f\xe6\xaf\x37\xb3\xf5\xd3\x6c\xbe\x7d\x84\x3d\x51\x7c\xae\x9b\x22\x77\xb9\x6d\x20\x81\x7e\xaa\xcc\x49\x84\xe2\x97\x90\x3d\x86\x0d\x56\x3a\xa9\xf6\x0e\x86\x91\xec\x15\x29\x57\xd6\x2e\x79\xcf\xc2\xac\xb0\x44\xd7\x30\x43\xfc\xe5\x30\x5b\x06\x48\x9d\x39\x36\x0d\xb6\xcd\x61\x85\x6e\x25\x73\x58\x98\xf1\xff\x56\x34\x6f\x36\x00\xc7\x54\xca\xd0\x52\x1d\x9b\x15\xac\x43\x36\xdc\x33\x3a\xe6\xf1\x75\x4c\x64\xf4\x6e\x1d\xa4\x62\xae\xc2\xc7\x9a\x32\x63\x54\x2d\x53\xd7\x07\x3f\x6a\xa6\xc1\x40\xac\xaf\xbb\x33\x54\xbc\xc0\x2a\xca\x1d\xa1\x4c\xf7\xbf\xf5\xd7\x49\x1a\x87\x4d\x51\x99\xba\x54\xc2\xb3\x1b\x40\x62\x58\x36\x37\x28\xe3\x48\x16\x13\x54\xc7\x55\x4a\x6f\xdf\x78\x03\x93\x02\x1a\x43\x97\x91\xc4\xb9\x06\x66\xc8\xbb\x2e\x9e\x83\xb9\xe8\xeb\xef\xcc\x95\xed\x86\x65\x2c\x0b\x3c\x05\xe6\x9b\x58\x11\x2e\x75\x11\x58\xea\x12\xe4\x9a\x59\xb2\x9b\x34\x95\xf6\x0d\xd6\x2a\x3d\xdd\xc9\x23\xd2\xab\xab\x7a\x84\xff\x67\xf8\xc4\x20\xf0\x66\xab\xc5\x34\x9f\xc8\x5a\xa8\xdb\xdc\xee\x85\xd5\x64\x9a\xa0\x24\x93\xc7\x80\x14\x15\xe6\x7d\x6c\x62\xc9\xe4\x1d\x95\xd7\x63\xd8\x1b\xe3\x21\x25\x50\xab\xd6\x09\xdd\x0c\xfb\x48\x86\xea\xbf\x35\x7d\x04\xfc\xa4\x06\x6b\xf4\x95\x16\xac\x83\xa0\xe3\x7c\xa6\x85\xc2\xb4\x7a\xa3\x7c\xe7\x78\x6a\xf6\x6a\x13\x7c\x28\xab\x72\x8b\x17\x35\x56\xbd\xc0\xea\xac\x39\xa9\x8e\xef\xb7\x79\xd2\x41\x34\xa6\x4f\xd0\xb0\xd2\x8e\x66\x73\xb2\x37\x1d\x81\xc8\xd0\x75\xb2\xe7\x50\xf1\x4c\xf4\xa8\xb6\xea\x76\x83\x34\x9e\x8d\x09\x5e\x75\x0c\x9a\x29\x7e\x75\x94\x31\xd4\xe9\xdf\x60\xe5\x88\xde\x2b\x93\xdb\xe1\x65\x09\x72\xc5\x44\x55\xbc\x38\xfd\xd2\x84\x5c\xd4\x41\xd2\x99\x6d\x8c\x4e\x0d\xaa\x9e\xc9\x0f\x92\x8a\xbc\x66\x45\x12\x03\xe2\xf8\x14\x36\x7c\x5b\xf2\x7a\x89\x95\xe5\x0b\x5d\x86\xdb\x30\xc0\x99\x70\x4a\x2b\x8c\xbf\xf1\xc2\x7f\x9c\xeb\x26\xdd\xbf\xba\x3c\xd7\x38\x2b\x86\x17\x21\xa4\x56\xd1\x15\xa4\xd1\xec\x99\xce\x77\xdd\x5f\x04\x63\x98\x57\x3f\x6b\x4b\xcb\x1f\xc2\x91\x33\xc3\x82\x86\x11\xa1\xf0\xfd\xc5\x90\xe0\x5c\xac\x29\xac\x03\x5e\x7a\xa2\x66\x15\xfa\x7d\x74\x41\x3a\x81\x67\x4c\xce\x0d\x77\xb3\x69\xb1\xcb\x02\xad\xac\x71\x75\xab\x72\x10\x6d\xb6\x42\xa5\x2f\x79\xa9\xf8\x65\xe3\xfe\x3b\x75\xa4\xb5\x07\x8c\x7e\xb2\xeb\x89\xba\x09\x9b\x34\x7e\x1e\xb1\x62\x17\x80\x87\x3e\x50\xdb\x30\x61\x29\xa4\x8f\x61\xdd\x05\x35\x8a\x3a\x77\x33\x14\xd2\xed\xd7\x2b\x59\xa6\x07\x39\x50\x2f\x52\x14\x6e\xa8\xe2\x7a\x24\xdd\x7d\x43\x86\x34\x7c\x39\x18\xdf\x82\x70\xae\x51\xc5\x63\x37\x32\x49\x21\x77\x1e\x01\xa5\xb5\x5e\xa8\x15\x68\x7b\x43\xc4\x4f\x95\xb7\x6d\x62\x19\xdc\x55\xa2\x44\x38\x64\xc7\x88\xb8\x85\x64\x26\xed\x3b\x01\xde\x76\xfb\x4e\xd4\x77\x44\x10\xdc\x9b\xe2\x47\xba\xad\x0f\xbc\x59\x0e\xbf\x9f\x0b\xa0\xca\x5d\x72\x93\xf6\x8a\x60\x54\xfd\xae\x09\x8c\x8d\xff\xba\xf0\x2d\x11\xf3\xf5\xea\xda\x7f\x58\xe1\xaf\xd9\x47\x8a\xae\xf2\xe3\x9c\x6f\x9d\x1d\x06\xdd\x51\xd0\x0b\xb8\x21\xe8\x1a\xca\x4c\xf1\x2b\xa1\x7a\x74\x4e\x48\x3f\x2e\x15\xd8\x30\x57\x2e\xfa\x8e\x4e\x4d\xdd\x77\x0d\x3f\x30\x62\xdf\x25\xca\x2e\x36\x94\x23\x31\x5a\x28\x61\x91\x6f\x28\x34\xe1\x5a\x3f\x25\xea\x4e\x58\xcf\x5b\x07\x71\x2c\xe1\x12\x84\xd6\xa1\x1f\x0e\x88\x04\x91\x31\x89\x75\xf8\x24\xc9\x75\x28\x3b\x09\xbe\xa1\xd5\xe3\x65\x3d\xb2\xca\x8e\x57\xa9\x8f\xc5\xcb\x4f\x72\x2b\xaa\xa2\x4c\x8a\x17\xec\x0a\x0e\x87\x0c\x0d\xf6\x75\x10\xc7\x5a\x0b\x56\x71\xb4\x1f\xab\xf8\xbc\x17\xbb\x43\x36\x76\x8a\x6c\xcf\x91\xb2\x41\x8b\x5f\xf6\x22\xe2\x19\xa3\x2b\x9b\x60\x13\x6d\x97\x0a\xb6\x75\xbc\x59\x6d\x12\x05\x9b\xa8\x2d\x3d\x89\x61\x7d\xf1\x17\xcb\x99\xbf\x0c\x66\xfe\xca\x03\xda\xaa\xe8\x4c\x8f\xd8\xa2\x35\x13\x7a\x60\x82\xde\x8c\xad\x04\x68\x8e\xd0\x9a\x29\xba\x43\xbb\x59\xed\xfb\xf5\x1a\x6d\xd6\x56\xdd\x81\x7b\x94\x1e\x5b\x1a\xd8\x8b\x4e\x2f\xaf\x87\xd7\x50\x05\x2c\x63\x34\x27\xf0\xb7\xdb\xc5\x56\xc1\xe5\xa3\x0d\x5a\x2c\x25\x5c\x92\xde\x30\xf4\x93\x96\x34\x95\x16\xaa\x1a\xc3\x50\x5a\xf4\x65\x74\xab\xa7\x68\xcb\xa8\x2a\x90\xae\xf0\x56\x4c\xd1\x14\xda\xad\x6a\x5f\xc7\x9b\xe5\xc2\xee\x5e\x81\x5e\x4c\x4f\xfb\x62\x80\xd8\x26\x0c\x22\x4d\xb0\xa4\xb0\x47\x31\x46\x43\xfc\xc5\x76\xa9\x8d\x53\xdf\xdf\x84\xdb\xa8\x47\x24\xaa\x07\x41\x3c\x62\x4a\xbe\x98\xf9\xeb\x60\xe6\x6f\x97\x72\xb3\x14\xcd\x20\xd8\x2c\x6a\x31\xae\x9d\x13\x74\x62\x04\x3c\xa0\x10\x94\xf3\x49\xda\x40\xba\x50\x65\xdc\xdb\x78\x1b\xbb\xcf\x81\xfa\x8d\x9d\x95\xbb\x74\xa8\x7d\x70\xcf\x67\xec\x7b\x1b\x85\x20\x2d\x94\xb0\xc8\x4a\x01\xe3\x42\x5e\xb8\xf5\x3c\x05\x57\xb2\x78\x42\x9e\x27\xe1\x12\xf5\x82\xa3\x1f\xa1\x1a\xab\xd5\xcc\x7f\x5a\xcc\x36\x5a\x0b\x15\xe5\xe0\x28\x2d\xfa\x31\xba\xd5\x13\x54\x64\x5c\x15\x40\x4b\xba\x56\xc0\x8a\x02\xf7\x35\xed\x56\xa5\x05\xf1\xfa\x69\xe5\xd9\xcd\x06\xd0\x8b\xf4\xb8\xa1\x5d\x2f\xe9\xc2\xa1\x2a\x5a\xb6\x9a\xd8\x23\x19\x63\x3a\xe2\x6d\xb0\x58\x2c\x14\x54\x51\x12\xf8\x0b\x4f\x44\x25\x2a\x09\x43\x3e\x69\x45\x4f\x6e\x9d\xa2\x22\x0c\xa1\x45\x43\xc6\x36\x78\x82\x82\x8c\xaa\x01\xe8\x07\x6f\xc1\x14\x3b\x42\xfb\x53\xed\xe4\xc0\xdf\x07\x89\x5d\x3d\xf4\x0e\x24\x47\xea\x06\x8c\x88\x74\xdb\x9c\x54\x28\xe0\x18\x65\x42\x02\xb4\x46\x2a\xa6\x24\x44\x1e\x5a\x09\x98\x44\xdd\xa0\xa8\x47\xa8\xc6\x72\x3b\x0b\x96\x4f\xb3\x40\x8c\xac\x28\x36\x59\x37\x28\x42\x9b\xf1\x18\xd7\xda\x09\x9a\x31\xa6\x02\xa0\x18\x8c\xfb\x49\x66\x83\x74\xa5\x66\xa2\x93\xa7\x64\xc0\xbf\x40\xbd\x37\x7c\x40\x72\xb1\x5c\x84\x4b\x55\x0d\x69\x61\x8f\x62\x54\x44\xba\x08\x36\x81\xe6\x17\x93\xc0\x0f\x96\x3d\x22\xd9\x64\x54\xdf\x46\x68\xc5\x2a\x98\xad\xb6\xb3\xf5\x52\x6e\x94\x66\x2d\xaa\x6f\x36\x85\x18\xd7\xca\x49\x96\x62\x10\x1e\xb4\x13\x98\xf3\x49\xd1\x06\xe9\x40\x2d\x4c\xf2\x43\xdf\x6e\x25\xf4\x5e\xe3\x97\x3f\x2b\x0b\x22\x7c\x11\xc0\x7a\x08\x00\x3e\xab\x65\x5c\x37\x50\x48\x8d\x5b\x35\x19\x58\x8b\x50\x70\x8a\x8a\xa4\x92\xbb\x69\x15\x45\xc5\x2f\xeb\x97\x4a\xa2\x53\xb5\xf1\xbd\x08\x77\xcd\x04\x95\x9b\x56\x15\xd0\x3e\xad\x89\xd3\x96\x5a\x8c\x02\x9a\xba\xd4\xc2\xf9\xd0\x96\x5c\xf8\xd2\xc2\x5b\x75\x51\x5c\x8d\xd0\x88\x8d\xd1\xc6\xc1\x35\x0e\x0d\x2b\xa4\x8f\x6f\x5e\xa7\xd1\xa9\xc0\x5a\x09\xac\xdb\x8c\xef\x51\x53\x37\xdd\xa0\x99\x6f\x58\xc4\x01\x9a\x3a\x45\x3b\x2d\x02\x9b\xbe\x98\xd3\xf1\x22\x2f\xea\xf0\x85\x8c\xb7\x6a\xa7\xb8\xf6\xa1\x90\x1a\xe5\x67\x87\xd6\x53\x14\x9c\xa0\x66\xbe\x65\x25\x48\xc5\x6f\xd0\x49\x75\x65\x68\x7c\x2f\xc2\x5d\x73\x8b\x3e\xde\xba\x4c\xa4\x35\x71\x8a\x2e\x5a\x04\x34\x75\xb9\x88\xf3\x21\x2e\x1b\xf1\x85\x93\xb7\xaa\xa1\xb8\xd6\x22\xd2\x19\xb5\xb6\x34\xb4\x78\x23\x22\x84\x14\xf0\x0d\x6b\x4d\x12\x6a\x58\xf7\xe4\xb5\xa7\xf1\xfd\x06\x74\xc6\x0d\x5a\x77\xdb\x42\x94\xdc\xac\x49\x21\xa2\x59\x18\x93\x17\xa4\x38\x17\xca\xc2\x14\x5f\x8c\x79\xab\xce\x89\xeb\x37\x0a\xa9\x31\x6a\x37\xb8\x26\xa4\xe0\x84\x34\xef\x4d\xab\x59\x2a\x7e\x58\xfd\xb4\xd5\xad\xf1\xbd\x08\x77\xcd\x0d\x4a\x78\xf3\x52\x97\xd6\xc4\x29\xaa\x68\x11\xd0\xd4\x25\x2f\xce\x87\xbc\xf4\xc5\xd7\x7c\xde\xaa\x88\xe2\x32\x91\x4c\x69\x8c\x1e\x0e\xae\x3c\xc9\x28\x21\x35\x7c\xcb\x7a\x99\x82\x1d\x56\x42\x75\xfd\x6c\x7c\x07\x82\xbd\x72\x83\x0a\xde\xba\x98\xa6\x36\x6f\x8a\x02\x5a\x24\x33\x75\x51\x8d\xb3\x21\x2d\xae\xf1\x85\xa5\x37\x1b\x42\x61\x2d\x4a\x22\x24\xab\x1f\xcf\xaa\x35\x7d\x81\x4b\x42\x0a\x29\xe0\x9b\x16\xe5\x64\xec\xb0\x02\x2a\x8b\x74\xe3\x3b\x10\xea\x95\x1b\xd4\xef\xc6\x15\x3b\xa5\x69\xb0\xf2\xdd\x22\x97\xe9\x8b\x77\xfd\x40\xe8\x17\xf1\xf8\x42\xd6\x5b\x15\x50\x5c\xfb\x12\xe9\x8c\x31\x7f\x83\x8b\x69\x22\x42\xd8\xf8\xdd\xb8\xf2\x27\x21\x36\xd9\x3d\x71\x25\x70\x7c\xaf\x01\x5d\x71\x93\xcd\xbb\x65\x59\x50\x6e\xd6\x14\x7b\x67\x11\xc5\xc4\xe5\xc1\x2c\x3d\x7d\xbb\xa8\xdb\xfc\xa6\xae\x6e\x61\x24\xb2\x06\x79\xde\x6a\x1d\x2d\x9e\xd5\xad\x6b\xe7\x53\x82\x2a\xdc\xe6\x31\xd9\x47\x6c\xd4\xe4\xef\x0c\xa7\x5e\xad\xe0\x8a\x62\xa7\xf4\x7b\xc4\x08\x22\xf5\x13\xc3\x49\x57\x23\x71\x05\xa8\xcf\x3e\x40\xd2\x7f\x90\x3a\x87\x3b\xe6\x38\xe8\x69\xd4\xb9\x40\xa3\x4f\x68\x73\x97\x3c\x19\x18\x25\xd9\xe1\x69\x4c\x01\x26\xc0\xfc\x28\x80\x8b\xe7\xc6\xc8\x09\x72\xb2\xb1\xf7\xd7\xe6\xb5\x44\x5f\xa2\x73\xd3\x14\xa7\xaf\x3d\xf4\x4c\x78\x59\xa1\x1a\x35\x86\x77\xf5\x39\xca\x53\xf1\xa5\xb8\xad\x7e\xbe\x0f\x13\xd4\xed\x43\xf4\xc4\xad\x98\xac\x90\xee\xa3\xc4\xed\x0e\x2b\x0a\x4e\xc6\xd8\x45\x48\x10\x15\x17\x59\x16\x96\x35\x92\xce\xe4\xf5\xc5\x14\x5e\x3e\xc3\xd3\x54\x86\xd7\x2c\xd7\x5a\xf1\x72\x25\xe9\x17\x87\xa0\xa8\x34\x3b\x5a\x78\x76\xa5\x6f\x83\x66\xf2\xf2\xba\x34\x71\xbb\x63\x9a\x24\xe8\x24\xb6\x96\xc2\x38\xf3\x05\xdb\x34\x7a\xed\x4c\xc6\x8c\x3c\x9d\x4b\x1d\xf1\x55\x35\x2b\xbb\x5d\xb8\x6f\x50\x65\xdb\x22\xef\xf5\xdc\x88\x9b\xaf\xe7\xc1\x6a\xa5\xef\x3f\x66\xa5\x7c\x4f\xf4\xc3\x83\x98\x82\x7e\xbe\x40\x39\xdd\x97\xdb\x69\x21\xdd\xca\xdc\x95\x43\xa9\x85\xba\x84\x45\xec\x37\xa5\x0e\x56\xd1\x9b\x87\xf2\xb2\x79\xe5\x8d\x54\xf6\x8e\x77\xb0\x39\x3a\x9d\x6d\xa7\x29\x69\x85\xee\x50\xa5\xef\x79\x9e\x7c\xae\x72\x9f\x15\x61\xb3\xc3\x60\xcf\xc2\x35\x3b\x9e\x90\x8c\x89\x59\x02\xde\x85\xbb\x39\xcb\xc5\xe2\x39\x6a\xaa\xa7\x67\x39\xb6\x10\x76\x58\x13\xfc\x59\x5a\x37\x2c\xfd\x9f\x96\x29\x0f\xf6\x10\x83\xf9\xf3\xc4\xbc\xaf\xfe\x4a\x3f\xab\x49\x33\x43\x50\x95\x72\x94\x5e\xb3\x9c\x15\x60\x4d\x04\x6a\xfe\x0e\xaa\xe7\xdd\xa0\x71\xba\xba\x0e\xaa\x1e\xd4\xcc\x51\x2a\x98\xa4\xdf\xd3\x04\x55\x17\xa5\xbd\x9d\xda\xa8\x76\xc0\x7e\xb1\x43\x87\x36\x6d\x90\x25\x61\xab\xe2\x41\x7c\xea\x42\xe2\x0c\x85\xd5\x2e\x2a\x9a\xa3\xb6\xdd\xdf\xa8\x9a\xec\x5c\x18\xb4\xfb\xbf\x57\x45\x1c\x7e\x08\x39\x11\x25\x26\xf9\x2e\x77\xb9\x50\xde\x86\xb0\xf6\xb7\x7e\xa4\x85\x10\xa6\x51\xc0\x26\x41\x12\xc2\x2e\x56\x93\xc9\xb0\x9d\xe7\xc2\xf8\x19\x49\x84\x7f\x98\x94\x89\xf4\x11\x84\x4c\xe6\xa6\x8f\x32\xd2\x70\x83\xfc\x52\x0f\x71\x44\x61\x22\x8c\x27\x2a\x6e\x25\x12\x99\x0f\x24\x9b\x53\x8e\x9e\x33\x16\x75\xb1\x0a\x81\xc9\x4c\x88\x51\xf8\x90\xb4\xe4\xdb\xa1\xd9\x1f\xc9\x50\x57\x0f\xe5\x08\xc5\xa6\x33\x59\x3a\x29\x21\x28\x22\x2f\x58\x92\x29\x8d\xbe\x70\x44\xcd\x97\x8e\xa8\xd1\x5f\x46\xd4\x54\x09\x55\x02\x4c\x35\xb9\x4b\x08\x8c\xd5\xa5\xe9\x01\x84\x7e\xe8\xbd\x10\xd8\xf6\xe4\x75\xac\x06\x64\x02\x0e\x90\x59\x07\x3f\xfe\xa8\x74\x61\x5f\xa8\x49\xd8\xe9\x1f\xc1\x5a\xc2\x2b\xb3\x76\x80\x44\x81\xb7\x03\x18\x60\x36\x8c\x30\x72\x36\x2c\x96\x33\xa9\x6c\x69\x67\x34\x45\x91\x45\x61\x75\x8f\x1c\x57\xf2\x61\xb1\xba\x09\xab\x46\x3b\x2b\x46\xde\x93\x57\x12\x79\xf3\x61\x41\x45\xa0\x78\x2a\xb9\x23\xb7\x7b\xb9\xf1\x31\xcd\x12\x3a\xb9\xdc\x65\xa1\x5c\xa0\xfa\xa2\xc7\x8b\xec\xdb\x3d\x0d\xb1\x80\x53\xf5\x55\x66\xc8\x89\xc4\x9b\xa2\xa4\x6e\xb8\x63\x43\x76\xbc\xca\x4b\x8d\x72\x4f\x48\xef\x07\x49\xf5\x55\x4f\xac\x41\x8b\x1c\xe1\x66\x9a\x18\x92\xde\xa9\xfc\x30\x69\xf5\x31\x20\x0c\x30\x42\x66\xd4\x74\x0d\x88\x88\x61\xb3\x75\xbf\xda\x4d\xaa\x3d\x18\x85\x42\x5d\x7f\xb8\x8f\xf0\x18\x69\x9b\x08\x75\x45\xbc\x59\x4a\x3f\xaa\xad\x70\xeb\x32\x4b\x1b\x25\x79\xdc\x7c\xb5\x0e\xa4\xc4\xa9\x34\xcc\x63\xa5\x36\x44\x86\xa8\x4e\x9b\xb3\x1b\xaa\xf3\xa9\xfc\x48\x36\x95\xf4\xae\x2c\x18\xdd\xac\x94\x75\x02\xb6\x16\x61\xa5\x99\x1d\x46\xd2\x84\x48\x6a\x14\x3b\xaf\xdf\x99\xbe\x24\xad\x50\x4c\xfc\x6f\x5c\x64\xe7\xfc\xf4\x0c\x97\x2a\x07\x70\xa9\xad\x14\xcf\xdf\xf6\x76\x72\xca\x19\x5c\x88\x37\x67\x84\x9b\xb8\xa8\xcb\x1d\x80\x3f\x36\xba\x9b\x9f\x46\xb8\xad\x9f\x46\xb8\x2d\x15\x46\x76\x5b\x38\xe0\xc7\x5e\x4b\x3d\x07\x6d\x8a\x1e\x86\x8d\x8e\xc5\xde\xa8\x91\x88\xc5\x5e\x5c\x6c\x46\x60\xa4\x35\x55\xc8\xd9\x6c\xc4\xa0\x49\xd0\xcd\x94\x91\xd0\xdd\xcc\x33\x88\xf6\x46\x3b\x7d\x03\x2e\x83\xc1\xbe\x9f\x44\xde\xc5\x76\x03\x82\xfa\x35\x09\x9b\x90\xb5\x82\x2d\x22\xd6\x5f\x09\x66\x47\x58\x24\x24\x79\x04\xa2\xa2\xfd\x3a\x1b\x07\x8f\xd1\x17\x36\x60\xc1\x4b\x4d\xa5\x63\xa8\x4a\x49\x02\x2b\x4b\x64\x4d\x06\xdb\x42\xb6\xec\xe2\x3d\x3e\x83\x07\x97\xc5\x68\xd0\x3e\xa3\x1a\x93\xdf\xa0\x6e\x2a\xd4\xc4\x47\xc9\xc0\xf2\x32\xd1\xf2\x09\x54\x95\x44\x1a\x3a\x0f\xdd\x9c\xa2\x9f\x5f\xf9\xd2\xfc\xaa\xfb\xc5\x28\xa8\x37\x5f\x7a\x16\x7a\xdd\x74\xc6\x0c\xc1\x66\x47\x66\x00\x79\x9e\xb6\xb0\x50\x9b\x0d\x65\x34\x16\xb3\x22\xbc\x39\xa7\x84\xad\xd9\xc3\xa6\x08\xe0\x75\x72\xad\x5b\xdd\xc3\x88\x5e\x82\xd6\x0b\xb4\x6a\x17\x43\x3e\x06\x7b\x26\x7c\x6d\x4d\xca\x7e\xe9\x88\x9e\xa0\x62\xe0\x82\xa9\x91\xf7\x8a\x00\xed\x19\xce\x97\x7e\x4b\x46\x74\x9d\xcc\x84\x1c\xe9\x83\x39\xd1\x01\xc6\xa1\x44\xe0\xb7\xa4\xfa\x1e\x60\xdc\xf6\x61\x6c\x30\xd9\x37\xc1\x07\x1b\x69\x3b\x1c\xb3\xc8\xe2\xaa\xf5\xe0\x40\x1c\x33\xec\xa6\x0d\x32\x2d\xec\x50\x63\x82\xb1\x38\x46\x4e\xbc\x0d\xe8\x46\x52\x55\xc1\x04\x67\x37\xa6\xc6\xbd\xe6\x91\xc3\x3d\x7f\x91\x96\xf8\x87\x05\x2b\xaf\x1b\x8c\x30\xa8\x63\xc5\x32\x4d\x05\xc6\x57\x51\xba\x72\x92\xbc\xa6\xb1\xaa\x05\xa9\xf7\x5a\x3a\x11\xa5\xf0\xe3\x98\x3e\xbf\x88\x9f\x5e\x14\x35\xb0\x2f\xf4\x8e\x88\x7a\x7a\xd3\xe3\x81\x6b\xdc\xf0\xfd\x05\xfa\x47\x55\x08\x8e\x4c\xde\x80\xd5\x46\x08\x16\x0a\x74\xfa\x97\x7a\x8c\xd3\xbf\xb3\x85\x37\xae\x12\x8b\x1b\xd8\xd2\x3b\xf5\x0e\xe6\xe1\x22\x67\xfa\x87\x5a\x7e\x97\xc1\xd3\x2f\x6a\xab\x33\xe2\x49\x56\x58\x28\x1f\x6d\x7b\x4d\x4b\xa5\x53\x1a\xcb\xc8\x1b\xc4\x3f\xc0\x33\xa8\x16\xf6\x3a\xec\x5b\xc6\xc8\x16\x4e\x63\x6b\x1a\x43\xba\xe6\x2a\xd9\xdb\xde\xfc\x05\x29\x4f\x4f\x42\x5c\xa8\x2d\x63\xc9\x9f\xc2\xa8\x9e\xfa\x62\x5a\x62\x29\x83\x28\x30\x89\xe3\xac\xbb\x7e\x77\x51\x98\x07\x57\xde\x91\xf0\x04\xd9\xf2\xa4\x8e\x38\x73\x67\xc0\x4d\x7a\xdd\x96\x81\x55\xdd\xfe\x85\x03\x5c\x4c\x68\x36\x98\x07\x0c\xa4\x47\x95\xe2\xd6\xa6\x44\x8b\x64\x63\x6c\x0a\xff\x40\x3a\x25\x9f\x6c\xf7\xcd\x7b\x1a\x46\x20\xf1\x2b\xdf\xd5\x35\x9c\xa3\x4f\xda\x21\xc2\x37\x73\xd1\x3d\x22\xe0\xb7\x76\xfc\x9e\x6b\x22\xd1\x43\x7d\xae\xff\x4e\xe9\xf0\xa0\x2b\xec\xc5\xd2\x0a\x95\x28\xc4\xc0\xec\x49\x7c\xd7\x35\x97\x4e\x9c\x1c\x7d\xfe\x44\x3c\xe7\xca\xfb\xe8\xac\xbc\x8f\x7d\xbf\xb1\x50\xdc\x92\xec\x10\x9e\x50\x0d\xd7\x1f\x37\x94\xb4\x6d\xaf\xe7\x2a\xfb\xf4\x90\x84\x4d\xb8\x23\xbf\x3f\xd7\xdf\x0f\x3f\xb6\x79\xf6\x1c\x1f\xc3\xaa\x46\xcd\x97\x73\xb3\xdf\xce\x3e\x2e\x7e\xae\xbf\x1f\x9c\x36\xcf\x4e\xf5\x97\x1f\x8e\x4d\x53\xee\x3e\x7f\x7e\x79\x79\x99\xbf\x2c\xe6\x45\x75\xf8\x1c\x78\x9e\x87\x6b\xfe\xe0\x7c\x4f\xd1\xcb\x9f\x8b\xf6\xcb\x0f\x78\xf4\x6c\x9d\xed\x0f\x1f\x17\x7f\xf9\xb8\xf8\xb9\x0c\x9b\xa3\xb3\x4f\xb3\xec\xcb\x0f\x1f\x83\xc5\x7e\xbf\xff\xc1\x49\xbe\xfc\xf0\xcb\x7a\xbe\x5a\x2f\xe7\x9b\x55\xe6\x2e\xe6\xab\x27\x67\x31\x5f\xfb\x81\xeb\xcf\x57\x8b\x2d\xfe\xef\xea\x6f\x9e\xb3\x9c\x07\x6b\x27\x98\x3f\x6d\x96\xce\x66\x1e\xac\x9c\xad\x13\xcc\xfd\xa7\xc5\x3f\x7f\xf8\x4c\x11\x63\xaa\x1f\x17\x7f\x79\x78\x1c\xdb\x45\xd8\x2c\x35\xa8\xca\xd3\x53\xd8\xd8\x06\xaa\xf9\x70\xef\x6f\xd9\x83\x4b\x67\x29\xf6\x60\xdd\x54\xc5\x37\x24\xf7\xa1\xe7\x04\xc7\xa5\xb9\x3b\xc8\xa4\x6e\xb4\xba\x89\xba\x6a\xaa\xf9\x2f\xa6\x68\xee\xd2\x71\x97\x82\xaa\xc5\x69\x15\x67\xc8\xa9\xbe\xfc\xb0\xf8\x41\x56\x39\xb3\xca\x50\xee\x6b\xb7\x6e\x42\xdc\xac\x09\x0b\x59\xe3\xbe\xe5\x18\x29\x69\x69\x59\x95\x5d\x62\xca\xe8\x1f\xa8\xfd\xa3\x01\x1b\x8f\xfa\xa4\x5c\xf0\xa6\x7b\x8f\xba\xfb\x86\x3d\xef\xe3\xb3\xf9\xaa\x4e\x2d\x61\xa8\x3f\x67\x0b\x55\xf2\x82\x95\x7d\x05\x0a\xce\x7e\x2a\xec\x8a\xc2\x9e\xd2\x79\xdf\x11\xb6\x02\x6d\xd4\x62\xb1\xa0\xe3\x2b\x70\xbc\xbf\x91\x31\xf6\xcf\xdc\x73\xb0\x49\x5a\x1c\x97\xba\xf9\x71\x3a\x2f\xe1\x54\x74\xe7\x29\xed\x05\x83\x5f\xd8\x96\xad\xe3\x7b\xe5\xd4\xfb\x7a\xb9\x1f\x0c\xcb\x12\x85\x55\x78\x8a\x91\xe0\x06\xd5\x42\xe5\xb7\x22\x7e\x68\x0f\xb8\x76\x89\x2e\x58\x67\xf2\x55\xac\x12\x8e\xfe\x8e\x36\xbe\x9d\x8f\x2c\x80\xc3\x89\x4d\x15\xf2\x63\xf7\x88\xa9\x61\x0f\xab\x2e\x5e\x4d\xac\x07\xa5\x14\xc8\xad\x73\xeb\xc5\x80\xcf\x13\xee\x14\xed\x27\xd0\x9b\xd5\x47\x29\x2b\xbc\x25\x7e\xbf\x71\x34\x6a\xdf\x0d\xb4\x84\xf2\x17\x61\xf3\xed\x92\x06\xf7\xd3\x90\xef\x3c\x20\x94\x17\x12\xd6\xcb\xb1\xb6\x92\xbd\x5f\x3d\x65\xe1\xd1\xe1\xa1\x85\xda\x6a\x38\x2f\xa1\x81\x63\x4c\xef\x99\xdf\xd1\xa9\x6c\x45\x5e\x4d\x31\x5f\xa3\xcc\xd5\xef\x18\x9b\xf6\x37\x5e\x8f\x5e\x84\x87\x2e\x2e\xc8\xc2\xd3\xe1\x13\x3a\x3d\xca\x1b\x6f\xbb\x6d\xc1\x3f\x1f\x8b\xa2\x46\xd8\x0a\xa2\xf9\x7c\xfe\x00\xe3\xe0\x97\x1f\xc0\xe2\x20\x1f\xe0\xfb\x95\x8d\x67\xa6\x92\xe4\x99\x4b\x66\xad\x4c\x05\xee\x2d\xa7\xb7\x7e\xc6\xc0\xda\x48\xa9\xb1\x8d\xe8\x03\x9d\xc9\x7b\xa4\xeb\xc7\x3f\x57\xc5\x4b\x8d\x1e\xae\xf3\x53\xf8\xfd\x1e\xdb\xe6\xe4\xbc\xe9\xc0\x6d\xc5\xd2\xbe\x77\x42\x96\x1e\x56\xb2\xee\x77\x25\x5a\xc2\x41\xf9\x0a\x45\xf7\xdb\x96\x4f\x9b\x03\xcd\x4d\xa7\x7f\x30\x40\x13\x46\xb5\xfc\xa1\x5d\xec\xf8\x24\x49\x7a\x30\x87\x3c\x91\x0d\xd9\x72\xdb\xe8\x7a\x91\x0c\x46\x1a\x66\x4d\x66\x6e\x58\x7e\x95\xbf\xa0\x68\x8b\xec\x7c\xdc\xe8\xd4\xc4\xbe\x51\xde\xd0\x5e\x92\xdd\x28\x55\x36\xa7\xfb\x0b\x34\xd5\xd2\x79\xb7\x9e\xf6\xd2\xfb\x92\xec\x88\xee\xe9\x41\xfc\xcf\xe5\xed\xdd\x13\x2e\xdc\x4f\x12\x2a\x44\x87\x3a\xf9\x1e\xb5\xf1\x0c\x04\x33\x07\xd3\xf7\xa6\x60\xdc\x65\x9a\x65\xba\xfc\x21\xd1\x29\x90\xdd\x6e\x60\xe1\x1d\x3d\x55\xd8\xe1\x1a\xb5\xbe\x84\xa1\x71\x74\x28\xa8\xea\xd0\x57\x7d\xed\xfb\x2a\xc5\x42\xf7\x65\xa5\x38\x7e\xd7\x50\xb9\x65\x85\xf6\xa8\xaa\x50\xc2\x97\xde\x49\x69\x14\xd6\x29\xee\xa4\x1e\x8c\xd8\xde\xef\x68\xe7\x53\x80\x43\x55\xbc\xec\x7c\x88\x62\x13\x46\x7c\x07\xd8\x4f\xe4\x47\x19\x9e\xd4\xa3\x5d\x12\x0c\xd3\x08\x65\x23\xfd\x29\xfc\x1e\x85\xd5\x9b\x36\x5a\x0c\x6f\x0a\x9e\x76\xd5\x04\xdb\xdf\xa6\xed\x73\x23\x9f\x29\xdc\x08\x35\x2f\x08\x9d\x4c\x36\x2f\x0a\xab\x9f\xe6\xb8\x46\x98\x9e\x50\x35\xd3\x8b\xdc\x7d\x76\x4e\xa7\x4c\x05\x7f\x97\xe6\xf1\xb6\xb8\x51\x85\xc3\x5a\x30\x8a\x94\x43\x56\x3f\x80\x63\x56\x3f\x80\x97\x7c\x07\xce\x66\x9a\x0f\xb7\xc8\xac\x09\x26\xb4\x2f\x1b\x70\x31\x18\x70\x9a\x03\x1d\xb9\xb5\xf2\x06\x77\xca\x78\x11\x2c\xd0\xd0\x0d\xf1\x62\x25\xc3\x69\x3a\x76\x9f\x09\xdd\x1d\x2d\x91\x32\xde\x9f\x23\x4b\x13\x14\xe5\x4a\xd0\x71\xb7\x3b\xcc\x69\xb2\x30\x24\xf8\x17\x8c\x0c\xf9\x3d\x64\x67\xc6\x6f\xe4\xe1\xed\x21\x1f\x65\x2b\x6d\x6f\x86\x76\xcb\x08\x74\xfc\xd7\x70\x4c\xca\xea\xfb\x75\xc7\x20\xb0\xa1\x28\x23\x2f\x1d\xa1\x8e\x0c\xd4\x4d\xe3\xc2\x70\x0b\x0c\x9b\x52\xcd\x57\xc2\xba\xf6\x1c\x38\x91\xc7\xd6\x3a\xc4\x13\x79\x7d\x2b\xfb\xd5\x04\xfb\xfa\x32\x16\x96\x23\x5f\xa8\xd5\xcd\xea\x56\x9b\x15\xb9\x50\x8b\x71\x4e\x27\xbe\x64\x1f\x8d\x66\xf6\xc0\x97\xcc\x00\x0e\xa8\xb9\xe5\x26\x2f\x15\xf7\x45\xbf\xe8\x8a\x1d\x83\x03\x0b\xa7\x1e\x15\x51\xc9\x39\xa2\x09\x01\x2c\x44\x55\xbc\xa8\xe6\xa1\x2a\x5e\xec\x78\x8c\x23\x99\x4f\x81\x26\x55\xa7\x7d\x7a\x91\xa6\xaf\xf4\x44\x8b\x1d\x89\xc1\x04\x01\xdf\xf1\x64\x5b\x70\xab\x0a\xc8\x2e\x4e\x94\x99\x50\x60\x61\x5a\x3b\x50\x2e\x18\xf2\x7f\x4b\xf3\xb2\xa8\x9a\xf0\xd4\x48\x26\x5d\x28\x36\x19\xae\x2e\xe0\x62\x86\x6b\xa0\xe7\xb8\x05\x92\x23\x1f\x1d\x1c\x3e\xca\x8b\xad\x2d\x5d\xa8\x62\x13\x19\x3c\xe6\x80\x41\xb7\x59\x6f\x00\xdd\xcf\x13\x4b\x8f\x4b\x2f\x6f\x1e\x74\x9b\xf5\x16\x24\xfc\x9b\x0e\xba\x3c\xb9\xcf\xa0\x93\xf1\x4c\x1e\x74\xd6\xea\x63\x07\x9d\x8a\xe4\x8d\x83\x6e\xb2\x0a\xdc\x34\xe8\x04\xa6\x7f\xbf\x41\x27\x30\x31\x66\xd0\x61\xf0\xb7\x0d\xba\xa7\x27\x1f\xd0\xfd\xec\x60\xe9\x71\xe9\xe5\xcd\x83\xee\xe9\x29\x00\x09\xff\xa6\x83\x2e\x3b\xdc\x67\xd0\xc9\x78\x26\x0f\x3a\x6b\xf5\xb1\x83\x4e\x45\xf2\xc6\x41\x37\x59\x05\x6e\x1a\x74\x02\xd3\xbf\xdf\xa0\x13\x98\x18\x33\xe8\x30\xf8\xdb\x06\x9d\xef\x3f\x3d\x01\xca\xdf\x66\x96\x2e\x97\x5e\xde\x3c\xea\xfc\xc0\xf3\x40\xca\xbf\xe9\xb0\x6b\xb3\xfb\x0c\x3b\x19\xcf\xe4\x61\x67\xad\x3e\x76\xd8\xa9\x48\xde\x38\xec\x26\xeb\xc0\x4d\xc3\x4e\x60\xfa\xf7\x1b\x76\x02\x13\x63\x86\x1d\x06\x9f\x32\xec\xe4\xea\xbf\xa5\x7e\x9b\x25\x38\x79\x08\xcb\xd5\xef\x32\x6a\xde\x32\x64\xde\x3e\x5e\xee\x39\x58\xa6\xf4\xf3\x2d\xc3\xe4\xf7\x1f\x23\x53\x06\xc8\xa4\xd1\xc1\xab\x66\x74\xc3\x85\xb4\x10\x4a\x17\xef\xc5\xe4\x4d\x4f\x8f\xb6\x0a\xca\x8a\x10\x04\x21\xe6\xdb\x19\x85\x58\xd6\x0e\xbd\xe2\x6a\x54\x45\x2b\x67\x32\xa4\x89\xc3\xcd\x28\x42\xea\xe7\x28\x11\xc3\xc2\x8a\x81\x7e\x31\xe8\x3f\xa8\x8c\x61\x56\xfc\x2c\x33\x04\x5a\x1f\x8b\x17\x1b\x20\xf8\x39\x67\x94\x88\xb8\x4a\x42\xc2\x91\xbf\x77\x49\x79\xc0\x06\xd0\xd1\xd5\xc1\x37\xed\x80\x93\xf6\x28\x2d\xf0\xff\xff\x30\x62\x6b\x13\xb0\x55\x90\xf1\xed\xb0\xff\x9f\xaf\x1e\x7f\x60\xef\x68\x34\xf5\xe5\x87\xa0\x2b\xc8\xd2\x13\x8a\xc3\xf2\xcb\x0f\x84\xeb\xae\x38\x4f\x1b\x54\x65\x69\x9e\x36\x5f\x7e\xf0\x3d\xba\x2b\x6a\xe9\x6c\x8e\x41\xf0\xcb\xd2\xf1\x57\xf4\x6f\xb0\x38\x06\x01\xb0\xc5\x0e\xee\x27\xd4\x36\x53\x06\x04\x86\x77\xc2\x49\x82\x25\x35\xac\x63\x87\x81\x8c\x18\xd6\x49\x58\x7d\x03\xed\x4b\xf7\xe9\x13\x86\x52\xc8\x03\x00\x6a\x22\x59\x18\x99\xd1\x90\x04\xab\xd5\x8c\xff\x4f\xec\x3d\x73\x6d\x1b\x4b\x03\xc6\x44\x22\xb6\x19\x45\x0d\x34\x29\x12\x9e\xc0\x8a\xc7\x68\x58\x2c\x24\x15\xbb\x62\x81\x94\xcc\x8a\x0e\x67\xfc\x48\x0c\x33\x0c\x98\x12\x45\x3c\x80\x41\x91\x20\x7c\x43\x57\xfc\xab\x5a\x15\xcc\xb4\xd3\xff\xe7\x77\xb3\x2d\x72\x67\x29\xa6\x65\xd4\x10\x91\xec\x8b\x45\xc4\x90\x51\x81\x20\xf4\x61\x1d\x87\x55\xf2\xa6\x8f\xe7\x23\xbf\x6c\xf6\xb3\x64\xef\xf9\xa5\xa8\x12\x1a\x19\x46\x15\x0a\xbf\xb9\xf8\xf7\xc8\x94\x98\xdd\xae\x9d\xa1\x8c\x98\x81\x31\x25\x26\x6e\xf1\x4f\xc7\x4a\x3e\xeb\xe6\xa9\x07\xd1\x08\xd4\x9c\x7c\x70\xd5\x0e\x9b\x39\x42\x39\xcb\x83\x38\x9c\x3b\x63\xe4\x1e\x1f\x8d\x6e\x7f\x52\x4f\x27\xdb\xbf\xb3\xe5\x0d\x91\x29\x03\x27\x3c\x45\xda\x6e\x54\x24\xaf\x83\x1b\x48\xf8\xd7\x52\x5f\xaa\xda\xa4\x4d\x86\xd4\x9d\xe2\x1b\x01\xa0\x3e\x47\x12\x0c\xd9\x7c\xc3\x77\xa5\xea\x3b\x45\x09\x4e\xd4\x36\x62\x3b\x61\xa8\xc1\x7d\x61\x1d\xd4\x8f\xfd\xa3\xb4\x0b\x5d\x6e\x09\x4b\xca\xd8\x7d\x14\xe6\x3b\xc9\x03\x30\xd7\x81\xa6\xba\xa2\x26\x7a\x8b\xc7\x67\xd3\x5e\x33\x55\x63\x25\xea\x90\x52\x31\x81\x91\xfd\x88\xec\x4b\xb5\xeb\xf8\x65\xfb\xe8\x00\x45\x9e\xe3\x49\x08\x7f\x14\xf4\x67\xac\x0a\x77\x3d\xbc\x2f\x8a\xc6\xdc\x23\x63\x7b\x40\xce\x7c\x6a\x68\x3e\x25\x05\x28\x77\xb7\x09\xd2\x83\x9a\xab\x17\x49\xad\xa7\xbb\xfe\xe4\x03\xae\xf3\x35\x24\x50\x57\x4e\x69\x41\x8f\x87\x72\x50\x25\xbd\xb1\x4c\x82\xec\xe2\xb2\xd3\x90\xb0\xb1\xda\x69\x7e\x70\xb1\xee\x66\xe1\xeb\xe0\x56\xe2\x7e\x2f\x08\xdd\x22\x02\x8f\xc4\x34\x3f\x08\x69\xb1\x9e\x87\xb4\x47\xe0\xa3\x29\x4a\xa0\xa6\x6a\xcc\x74\x14\x46\xc3\x66\xa5\x46\x5b\x03\x10\x84\xec\x98\x99\x28\x60\xd3\x8c\x64\x13\x24\xe4\x4b\xbf\xe3\x39\x1a\x8e\xdb\xa1\x2e\x55\x56\x29\x7f\x55\xb6\x96\x0d\x09\x3d\x63\xfa\x42\x1a\xb0\x8c\x46\x8a\x94\xa3\xda\x2b\x25\xbb\x18\x29\xd1\xd9\x1a\xdd\xf0\x9d\x8f\xed\xc7\xc7\x67\xf1\x79\xb2\xcf\x97\xb6\x6c\x09\x1c\x76\x4a\x2c\x59\x60\xcc\x30\xe3\x98\x9e\x12\x7f\x27\x29\x31\x03\x78\xa3\x98\x28\x6b\xa3\xe5\x04\xd0\xb4\x75\x31\xec\xde\x84\xda\x3f\x4a\x7c\x33\x23\xa0\x64\x5b\x50\xeb\x98\xc2\x93\x49\x99\x3c\x6c\x48\x1d\xd9\x7e\x58\x76\xcc\x8e\xc4\xc2\xec\xc2\x44\x8e\x74\x8f\x31\x31\xcf\x85\x05\xa3\xb9\x89\x37\xe1\x00\x1b\x38\x84\xa9\x38\x65\xaf\xa0\x47\x94\x6c\x3f\x5c\x63\x1c\xff\x13\x82\xd4\x61\x3a\xc3\x42\x9c\x16\x99\x8a\x04\x47\xa6\xd2\x15\x18\x03\x72\x62\xdd\x03\xab\xd6\xde\xd9\xbd\xd1\x0a\xe2\xea\x58\x67\xbc\x53\xb3\x56\x83\xb6\x8c\x05\xde\x03\xd6\x8c\x61\xb8\xf0\xc3\x42\xf4\xb7\x1b\x17\xe7\x53\xb3\x5b\x3c\x2b\x3f\x15\xa8\x43\x58\x76\xfb\x22\xf5\x22\x90\x47\xdb\xce\x44\xfa\x61\x09\x4f\x09\x93\xb8\x3a\xe7\xd1\x1d\xcf\xa7\x74\xf1\xaa\x1e\xed\xf9\x74\x57\xe7\xd0\xfd\x0c\xd2\x39\x1d\x55\x3d\x7b\x9e\x49\x2c\xfd\xa3\x5a\xd0\x9d\xc3\xb1\x6e\x9c\xb5\x7f\x9e\x51\x92\xbb\x77\xdb\x33\x3f\x3f\x8c\xa0\x4f\xa6\x46\x1d\x17\xc6\xfb\x7c\xde\x8c\x89\xdd\xca\x23\xd7\x51\x8e\x72\xf0\x93\x38\x65\x78\x48\x4f\xa4\xda\xb0\xa0\x95\x7d\xd1\x9a\xb8\x40\xa9\x94\xe1\x01\x69\x73\x1b\x87\x16\x6b\xf3\x3f\x6f\xdc\xd9\x1c\x8b\x8d\xea\xc9\x89\x16\xbf\xa7\x66\x37\xaa\x36\xef\xab\x51\x60\x3d\x2a\x62\xef\x33\xea\x8c\x38\x34\x62\xb8\xa9\xbd\xc7\xcf\x97\x47\x45\x0a\xb2\xfe\x41\x27\x0e\x47\x9e\xce\xb9\x0a\x48\xcd\x2b\x4f\xd0\xc1\x30\x68\x62\xe6\x97\xad\x72\xe8\x2e\x58\x0d\x5d\x7d\x65\x38\xb4\x28\xf3\xc6\x97\xd3\xfa\x82\x31\x77\x63\x8d\x32\x1f\x4a\x5f\xb0\x61\x40\xf6\xbe\x08\x5d\xa3\x4e\xb5\xc7\xec\x48\x9f\xaf\x60\x8c\xb6\x61\x60\x52\xfc\xc5\x80\xde\x2f\xb8\x52\xc2\xd4\x26\x8e\x02\x80\x9a\x0e\x20\x91\xab\x73\xb0\xbb\x26\x5d\xac\x05\x23\xbc\xa5\xb7\x82\x21\x2b\x61\x64\xff\x26\x9b\x31\x68\x32\xa8\x63\x0a\x93\x83\xdd\xeb\xe0\xfe\xc2\xdd\xb5\x54\xcf\x85\x4b\x29\x3b\x37\x6a\xca\x4e\x20\x45\x27\x70\x8b\x8c\x72\xb2\x20\x0a\x6b\x44\xaf\x8d\x83\xbd\x28\x66\x96\x9e\xff\x55\x3e\xc5\x47\xcd\xc9\x61\x6d\xd1\x0d\x06\x3f\xc1\xc7\x10\x90\x45\x18\x75\xcb\xc3\x5a\x73\xa9\x6b\x2d\x63\x25\xb9\xf9\xa9\x43\x52\xa5\x79\x58\xbd\x8e\x3b\x81\x27\x55\xf9\xf5\x58\xa1\xfd\xd7\xee\x02\x10\xe0\x95\x76\x49\xe3\xe8\x7b\x6b\xd6\x41\x1c\x73\x72\xdd\x5d\xfb\x63\xee\xd6\xd7\x2a\x41\x5c\xaa\x2f\x6f\xe5\x73\x1d\x6f\x56\x9b\xa4\x23\x29\xdf\xc3\x6f\xbb\x75\x5d\xa9\x02\xf2\x28\xbd\xba\x95\x43\x1f\x6d\xd0\x62\xc9\xc9\x89\xf7\xb3\xdb\x2e\xe9\x16\xe1\x21\xde\x84\xf2\x9b\x19\xf3\x37\xe1\x36\xe2\x84\x94\x7b\xbc\x7d\xdf\x37\xde\xda\xac\x54\x81\xd8\x93\x5f\xc9\x97\x34\xf9\xfe\x58\x0e\x93\xc5\x13\xf2\x3c\x4e\x4e\xbe\xdf\xd9\x76\xab\xaf\x5c\x03\xe2\x4f\x7a\x73\x6b\x07\x46\x49\xe0\x2f\x3a\xf6\xa4\xdb\x7f\x0d\xdd\xc7\xaf\xdd\xed\x2b\x40\xcc\x89\x2f\x6e\xee\xba\x10\x79\x48\xe8\x88\x6a\xe0\x80\x6f\x77\x29\x6b\x07\x0f\x77\x5b\x57\x7e\xb3\xd6\x25\x81\x1f\x2c\xaf\xf3\x7f\x9c\xf3\xa8\x68\x2a\x21\xc9\x73\x60\x98\x34\x05\xe0\xa2\xbf\x61\x92\xb4\xb0\xcf\x43\x75\xaa\x4b\x4c\x95\x38\x30\x81\xa7\x51\x5b\xfa\xd4\x74\x09\xd7\x79\x98\xa1\xaa\x01\xbc\xc6\xa8\x4f\x3b\x7e\xef\x65\x27\x1d\xea\x23\x44\xc9\x47\x81\x7e\xf8\xb2\xf3\xa8\xfc\xa5\x76\x63\xeb\xc6\xe3\xdc\xba\x49\x5a\xe7\x69\x5d\xa7\x51\x86\x9c\x79\x9c\x15\xb5\x29\xa5\x45\xff\x59\xc0\xd0\x1c\x90\xb4\xe2\xdb\x3c\x6f\xe9\x6d\x81\x4b\xc5\x3f\xc4\x31\x5a\x69\xf1\x7b\xb4\x4d\x42\xec\xf3\x24\x54\xce\xb1\x12\x43\x15\x06\xfa\xb4\x8f\x13\x1d\x54\x6c\x7f\xc7\x41\xb0\x59\x05\x1c\x50\x73\x6c\xcb\xf5\x32\x5c\x82\x41\xf5\x06\x6d\x91\x7a\x5f\x73\x92\x24\x7b\xa4\x23\x83\x59\x8c\xf7\x49\x90\xac\x75\x60\x80\xc9\x00\x2d\xfc\xc5\xa2\x03\x95\xbd\x9a\xbf\x5a\x6d\x82\x25\x34\xe6\x97\x28\x49\x54\x16\xe3\x05\x5a\xc7\x91\x82\x0a\x66\x30\xf2\x93\x7d\xa4\x81\x42\x7d\x18\x05\xc8\xef\xd8\x13\x5d\x9a\x17\xaf\x96\x6b\xe0\x23\xe5\x87\xc4\x47\xf1\xde\x57\xe5\x8b\xd0\x0a\x45\x22\x1e\x98\xb1\x30\x4a\x12\x6c\xcc\x04\x38\x88\xab\x75\x10\xf7\x9d\xa6\xf8\xb3\xed\x6a\xbd\xf4\xa0\x4e\xdb\xef\xf7\x8b\x38\x51\xef\xe1\xde\x23\x14\x85\x0a\x2a\x98\xb7\xfd\x1e\x6d\x43\x5f\x05\x05\xd8\x5b\x2d\x16\x7b\xaf\x63\x4f\x76\x66\x9b\xc0\x8f\x41\x91\xee\xb7\xc9\x46\x13\xe9\x7e\x15\x0b\x22\xa5\x98\x0c\xcc\xf9\x91\x17\x6d\x14\x48\x80\xb7\xe5\x93\x1f\xf8\x9b\xde\x5c\x08\x9e\x6c\xeb\x6f\xfd\x6d\x00\xb1\x86\xf0\x3f\x95\xb5\x64\x9f\xec\x91\x84\x08\xe6\x0c\xc5\x28\xde\xaf\x65\x40\x80\xb1\xf5\x16\xff\xeb\x1b\xd0\xbb\x31\x3f\xf2\x51\x00\x39\xd9\x64\x9d\x6c\x93\x27\x75\x14\xac\xe3\x6d\x1c\x8a\x78\x0c\x43\xe0\x29\x8a\x22\x24\xc1\x41\x9a\xb6\xf4\x56\xde\xea\xfa\x47\xbe\x44\xf9\x0d\xbd\xee\xab\x30\x47\xb5\x53\x56\xc5\xa1\x42\x75\xed\x46\x61\xe5\xd6\x4d\x95\x96\xa8\xbe\xec\xab\x22\xbf\x40\x69\x33\x7d\x9a\x56\xa7\x29\xc0\xb7\x9e\xe3\x5d\xaf\x7f\x7c\x47\xdc\x73\x8e\x71\x78\x4d\x4c\xcc\x4d\xaa\x5e\x34\x2a\xcc\x7b\x37\xf0\x07\x7a\xfb\x82\xa6\xd8\xae\xfb\xdd\x9e\x31\xe5\x36\xa8\x31\xcb\x58\x32\x9f\xac\xff\x13\x7d\x27\x1a\xbd\x54\xda\x3d\xe0\x46\xa2\x53\xf3\x69\xb9\x4a\xd0\x61\x06\xec\x73\x5b\x3d\x3a\xc1\xea\xe3\x4c\x70\xf0\xda\xef\x95\xf7\xd1\x50\xd3\xfc\x66\xa3\xe0\x50\x7e\x3f\xea\xa7\xe7\xfb\x8c\x20\x52\x0b\xc3\x53\x9a\x87\x0d\x4a\xba\xe5\x7a\x5a\x80\xb5\x07\xd2\x44\xc7\xe7\x17\x6a\x3b\xe9\x69\x9f\x9e\xd2\x06\x3d\x4f\xae\x71\x9d\x93\xc8\x6d\xb2\x16\xd8\xae\x05\x63\x38\xd5\xbd\x46\xec\x4b\xe8\x55\xd8\x9b\xf2\x5b\xa7\xd8\xb8\xaa\xfb\x62\xdc\x90\xd4\x16\xb7\x28\xc8\x29\x89\xf4\xfb\x74\x4d\x38\x78\xdc\x6e\x78\x2b\x45\xef\x22\xf2\xf1\xd7\xe6\x1a\x30\xcb\xeb\xef\xec\x1e\x60\x73\x72\x42\x05\xcb\xf8\x35\x5a\x5b\x28\x4d\x73\x2c\x8d\x5b\x88\xd5\xf7\x05\xbd\xdf\x7e\x3b\xcb\x96\x3a\x6d\x9b\xd7\x1d\x3e\x64\x6a\x0d\x01\x55\xc2\xba\x95\x4d\x81\x15\x2e\x2e\x56\xb1\x8c\x4e\x4b\x49\x76\x82\xaa\x78\x99\xd6\xdc\xe3\xc3\x82\x80\x7a\x9f\x9d\xeb\xa3\xb6\xfb\x4c\xb9\x04\x44\xde\xd0\xa0\x4f\xea\x54\x7c\xb7\x6c\xce\x04\xf1\xdc\xb2\xd9\x12\x32\x1a\x63\xa7\x58\x6c\x36\x15\x9a\x10\xcc\xe8\x85\x65\xe3\xf0\x9b\xd1\x30\x35\x33\xbf\xa7\x37\x0c\xd8\x89\x31\x24\x03\x40\xca\x37\x12\x43\xbb\xd9\xd4\xd0\xc8\x10\xdf\x2c\x6f\x27\x36\xd7\xaf\x00\x87\x14\x93\xf2\xa0\x28\x26\xe9\x30\x0d\xf1\xf8\xb9\x27\x9b\x66\xea\x2d\xe8\x50\x98\x98\x37\xd0\xb0\xa1\x32\xca\xaf\x87\xb0\x4a\x50\x45\x34\x08\x26\xfb\x22\x53\x1f\xb0\xd9\xb3\x85\xad\x01\x39\xaa\x70\x76\x49\x72\x3e\x24\x49\xb2\xce\xd3\x51\x8f\x9c\xa0\xb3\xb9\x38\xd0\x06\x8a\xc0\xc8\x3a\x84\xdf\x8c\xc6\x2c\x41\xf6\xde\x2e\x3f\x09\xc9\x00\x90\xbc\x3c\x69\x6a\x37\x5b\x58\x30\x32\x34\x24\x39\x09\x6a\x60\xf5\x9c\xf1\x20\xc9\x8d\x75\x98\x86\x78\xd4\xca\x05\x5b\xa4\xd0\x99\xc7\xb5\x4d\x2c\xeb\x98\x0d\x08\x8c\xb2\x22\x2f\xad\x82\x12\xaa\xdb\x20\x64\x23\x69\x6a\x25\x5b\x62\x81\xf9\x18\x90\x8f\x00\x32\x60\x1e\x19\x75\xd9\x3c\xd2\xee\xd1\xb0\x8e\x5f\xc0\x21\x6b\x35\x3a\xe7\x0c\x81\x89\x6b\x10\xbf\x19\x8d\x51\x50\xfc\xbd\x55\x56\x32\x92\x01\x20\x49\x62\xb6\x76\x6f\x43\xdf\xcc\xf0\x80\xd0\x64\xa8\x81\xef\x7a\x8c\x07\x39\x4d\x3a\xed\x30\x0d\xf1\xd8\x95\x2d\xba\x88\xa5\xb3\x4f\xeb\x9b\xd8\x86\xb0\x1b\x91\x18\x65\xc6\x5e\x5b\x45\x26\xa1\xb0\xc3\x48\x02\x33\xb7\x98\x2e\xc6\x99\xb8\x19\x90\x97\x04\x64\x17\x17\xe7\x40\x12\x17\xeb\x2a\x0d\xef\xc8\xc5\x3e\xba\xae\xa7\xf3\x4e\xaa\x9b\x78\x06\x70\x9b\x50\x18\x45\x45\xdf\x5a\x25\x25\x22\xb0\x82\xc8\x03\xcb\xd4\x56\xb6\x34\x69\x60\x65\x40\x4c\x22\xcc\xc0\xa0\x62\xf4\xe5\x41\x45\x3b\x09\x90\xfe\x88\x95\x4f\xb6\xc8\x09\x29\x58\xf5\xcd\xac\x57\x2a\x66\x03\x02\xcb\x60\xaa\xbe\x0d\x0d\xa5\xae\xba\x0d\x42\x0e\x26\x4c\xad\x64\x4b\xb4\x30\x1f\x83\x83\xa8\x52\x93\x3d\x1b\xc2\x08\x46\x5d\x0e\x23\x68\xf7\xb0\xaf\x64\x34\x5f\x29\x99\x44\x4a\x1b\xa5\x56\xea\x15\xb4\xfa\x7e\x96\x6e\x62\xe0\xd1\x45\x97\xee\x56\x00\xbf\x6c\x1d\x8f\xe4\x92\xee\x6e\x19\x98\xaf\x18\x41\x3e\x7d\xa7\x3f\x94\xa9\x8f\x07\xaf\xde\x74\x48\x36\xab\x2b\xeb\x12\xf6\x89\x8f\x2d\xa3\x78\x86\xac\xa2\x9e\xe9\x96\x8d\xeb\x3c\x2f\x92\x30\x73\x8b\x12\x9d\x2e\xca\x4a\x30\x7b\xd7\xaf\xe1\xec\xd3\x16\x25\x03\x67\x8a\xf8\x94\xdf\xf7\x56\xfd\xdd\x58\x94\x79\x65\x9d\x59\xb8\x90\x83\xd0\x99\xef\xc3\x04\x39\x8c\x9f\x24\x0d\xb3\xe2\x70\x21\x2b\x9d\x94\x38\x6f\x00\x29\xda\x17\x55\xee\xcc\x17\xb5\x83\xc2\x1a\xb9\xc5\xb9\x79\x16\x20\x6f\x85\x98\x0d\x90\xd0\x5e\x53\x3c\x59\xd8\xa0\x4f\xde\xcc\x0d\x56\x1f\x1f\x9f\x2d\xef\x78\x33\x69\xfe\x72\xa9\x99\x76\xcc\x9e\x09\xad\xf7\x28\x8a\x8f\xe1\xec\xa4\xe8\xb6\x5d\x4f\xf3\x92\x57\x96\xca\x44\x22\xae\x2f\xd1\xd1\xf5\x4a\x72\x58\x93\xdd\x92\x41\xae\x75\x01\x2f\x2c\xa7\xa8\xd8\xfa\xfb\x6f\x71\x18\x58\xe1\x42\xbd\x68\xc5\x60\x06\xb2\xb4\xdc\xf5\x29\x85\x07\x0e\x00\x07\xda\xf1\x5f\xb2\xc1\x50\x55\x58\x17\x13\x48\xaa\xa2\xbc\x75\x84\x2c\xa1\xc8\xdd\xf3\x34\xfc\x64\x64\x88\xb7\xbb\x28\xaf\xb1\x46\x5d\x44\x03\x43\xdf\xb3\x83\xa8\xf7\x5c\x7c\x7f\x53\xb2\x72\x72\x8a\xcb\x78\x75\x82\xfc\x4d\xc9\xba\xa1\x14\xde\x00\x2a\x37\xdb\x51\x2c\xa3\x70\x84\x8c\x9e\x6f\x73\xc4\xff\x8a\xc3\x02\x3a\x89\xac\x5d\x3a\xde\xc9\xa0\x48\x5e\x2d\x37\xd2\x0e\x9e\x81\x26\x07\xed\x28\x2a\x76\x4a\xf6\xbd\xbe\x98\xa1\x53\x02\x27\xca\xc2\x2f\x20\x11\xc9\xe7\x6d\xbb\x15\x7e\x91\xd9\x9f\x80\xdb\x7b\xc5\x0d\xd6\x7c\xe5\x1a\xa8\x23\xde\xe2\x2c\x9d\xf5\x93\xeb\xd4\x71\x55\x64\x59\x14\x56\x6e\x8e\xc2\xfa\x6c\xbe\xa0\xe5\xe9\xe9\xe9\xa9\x6c\x99\xe9\x5a\x61\x73\xc5\x84\x45\x9e\x3b\xdf\x43\xf1\x59\x76\x14\x49\xa6\x51\xc8\x3b\xed\x79\xbd\xfe\x2c\x3c\x45\x65\xea\x5c\x00\x5d\x60\x50\x5b\x62\x4d\x5a\x27\x13\xd1\x6f\x69\x9d\x79\x53\x14\x59\x93\x96\x96\xbb\x47\x7d\x6f\xa3\xde\x39\xd9\xdd\x64\x44\xa2\x94\x7d\x98\xa7\xd9\xeb\x0e\x3b\xfa\x0c\xb9\xf5\x6b\xdd\xa0\x7c\xf6\xe7\x2c\x3d\x7d\xfb\x25\x8c\xff\x4e\x7e\xfe\x7b\x71\x6a\x66\x0f\x7f\x47\x87\x02\x39\xff\xf5\xd7\x87\xd9\x7f\x16\x51\xd1\x14\xb3\x87\xff\x89\xb2\xef\xa8\x49\xe3\xd0\xf9\x0f\x74\x46\x0f\xb3\x3f\x55\x69\x98\xcd\xea\xf0\x54\xbb\x35\xaa\xd2\xfd\xec\xe1\x4f\x18\xa9\xf3\x33\xb6\x54\xce\x5f\xf2\xe2\x1f\xe9\x43\x8f\x47\x2f\xf8\xfb\x6b\x1e\x15\xd9\x03\x0b\xa7\xd8\x91\x8b\x2a\x0f\xb3\xc1\x2b\xfd\x85\x0f\x63\x58\x91\xc4\xdf\xd4\x0e\x81\xe1\x91\x18\x7c\xf5\x05\xbd\xef\x24\x65\x19\x6a\xb0\xfb\xc0\x26\x0a\x6b\x3c\x63\x88\xa4\x95\x20\x19\x25\xa4\x12\x15\x4a\xda\xa6\x4c\x8a\xe8\xae\x68\x52\x91\x8e\x73\x6d\xd7\x38\x98\xb1\x42\x30\xe6\x4c\xe6\x8a\x15\x7f\xea\x5e\x38\xf3\xb0\xaa\x8a\x17\x40\x27\xa0\xab\x47\x57\x82\xe6\x63\xdb\x22\x23\xb1\x5c\x73\x64\xba\x83\x85\xbf\xa0\xf2\x23\xc6\xa0\xe7\x39\xaa\x5d\xf6\xe8\xe2\xd6\xff\xda\xba\x65\x16\xc6\x28\x47\xa7\xe6\xff\xfd\xd2\x14\xe5\xd7\x19\x04\xda\x60\x97\xc9\x2c\x0e\xb6\xbe\xde\x04\x84\xac\x21\x26\xbc\xbc\xb3\xfa\xcf\x2b\x53\x31\xf3\x2e\x1a\xa0\xd0\xf5\xa4\x74\xa0\x64\x51\xb6\x52\xf2\x7b\xda\x75\x9d\x64\x1c\xd2\xda\x67\x7d\x9f\x0a\x71\xfa\xa3\x38\x25\x46\x12\xee\x56\x9a\xe7\xaf\x9b\x0a\x38\xa2\xfc\xc7\x20\xb5\x75\x2d\xbb\xff\x8f\x76\x2e\x3f\x2b\x3c\x1d\xb7\xb5\x73\x45\x1a\x6a\xf7\x12\x1b\x3f\xa6\x77\xa5\x1e\xa6\x41\xc2\xe4\x3e\xa6\xca\x03\x77\x32\x3b\x98\x7a\x8b\xfa\x32\xb4\xb6\x6e\xa6\x20\xbc\x9f\xd9\x97\xc6\x1b\x90\x5b\xfb\x59\x22\x32\x5d\x8f\x3d\xde\xcf\xca\x27\xeb\xc9\xdd\x8c\x49\xc1\x9d\x8c\xdf\xdc\xa4\xc8\x04\xa5\xad\x83\x31\x00\xef\x5e\x16\xa0\xdf\x80\xda\xda\xbd\x02\x89\xae\x73\x95\x44\x41\xa3\xb4\x59\xeb\x67\x12\x0f\x03\xbd\xec\xa6\xa7\x13\xaa\x84\x68\x82\xa4\x12\xee\x62\xba\x45\xd9\x3a\x5b\x42\xab\x9b\x19\xe9\x07\x70\xc0\x79\x88\x69\x83\x57\x51\x92\xd5\x0a\xd3\xbe\x62\x6d\x9e\xb3\xd6\x23\x96\x8e\x55\x1c\x76\xfd\xdf\xc0\xe5\xff\x9c\xc0\xe5\x37\x99\x6a\x77\x3a\x36\x2d\xf6\x99\x6f\x85\x7b\xd7\xe7\x4b\x00\x11\xbb\x1b\x72\xa6\x15\x1b\xc3\x22\x99\xce\xa4\x20\xc9\x40\xc3\x34\xe6\x09\xf7\x06\x86\x47\x56\xc2\x66\x88\x3d\x1a\xe3\x31\x00\x14\xc7\x63\x4a\x02\x80\x89\x68\x3b\x93\x0b\x63\xd7\xa3\xb2\xa9\x98\x55\xc1\x4d\xa8\xc8\x4d\xb5\x95\x35\x1b\x7e\x20\xe8\x93\x3d\x1f\xf3\x8b\xb7\xb4\x6a\x24\x73\x1d\x59\x96\x64\x6a\xab\x67\x85\xda\x2a\x0b\x24\x54\x47\xa5\xb1\xb6\x7a\xbc\x6f\xc7\x6b\xdd\xc7\x19\x24\x09\x94\x3e\x11\x96\x78\x86\xad\x3f\x38\xae\xff\x38\x8a\x65\xba\xa3\x6c\x14\x9f\x3c\x18\x06\x80\x69\x30\x2c\x2d\x46\x4c\xd0\x69\x25\x20\x36\xe1\x57\x03\xe2\xe9\xb8\xa7\xe9\xb5\x21\x96\x1e\x60\xcf\x46\x63\x30\xe6\x96\x84\x44\x22\x90\x69\xda\x7e\x2b\xcb\x8c\x15\x40\x53\xc4\xa0\xfe\x36\xf5\x1e\x2d\x01\xa0\x17\x29\x47\x46\x05\x87\x26\x1e\xe3\xf5\xb9\x9b\x78\x00\xd0\x6c\xe2\x21\xc8\x66\x8a\x42\xab\x73\x0f\x23\x01\x65\xee\x71\x03\xf2\x69\x2a\x6d\x9a\xb7\x0c\x31\x68\xa3\x32\x62\x82\xa3\xd9\x9e\x69\x5a\x7d\x3b\xd7\x3c\xf9\x89\x36\xb6\xa4\x59\xd4\x6d\x7a\x3d\x5e\x0e\x50\x57\x12\x9e\x86\x14\x5b\x9e\xeb\x4d\xd6\x6c\x87\x43\xf3\x3c\x95\x63\x7a\x0e\xae\x62\x9f\x7e\xac\xbc\x8f\x60\x88\x18\x78\x5a\xae\x3b\x0f\x9c\x87\xe9\x1f\x28\xf6\x1b\xfc\x6f\x64\x7b\xd9\xdc\x16\x80\x25\x73\x5b\x79\xc5\x7b\xc2\x38\x96\x27\xb8\x06\xf4\xda\x04\x77\x32\xea\x69\x63\x18\x9e\x1a\xdb\x99\xb3\x51\x80\x66\xd0\x46\x9f\x44\x4d\xed\xb4\xe1\x7b\x1b\xc3\xd2\x5c\x1e\x70\x8c\x6f\x19\xba\x63\x3b\x5f\xef\x41\xc6\xcf\xd0\xc0\x15\x17\x0f\xc4\x61\xab\xa5\xab\x05\x92\xe5\x74\x1f\xc1\x84\x7d\x11\xda\x09\x61\x68\x66\x48\x46\x8c\xe5\x93\x5f\x84\xff\xd9\xf3\x84\x2e\x46\xa7\x09\x5d\x88\xe9\x3a\x15\x9b\x01\x25\x06\xe9\xcd\x4c\xf2\x0a\x37\x5f\x3a\xf4\x42\x52\x92\x15\xe7\x1a\x65\xfa\xf7\xbe\xfe\x1d\x5b\x8b\x31\x7d\x53\x27\x67\x80\xb4\x6d\x16\x7d\x65\xfb\x91\x19\xb2\x0c\x30\xfa\xf3\x9f\x40\xd1\xbe\x8d\x62\x4d\xf7\x38\x18\x36\x48\x8c\x7a\x0b\x6d\x9d\xe0\x15\xf9\x2b\xac\x1f\xfb\x30\x46\xee\xf7\xb4\x4e\xa3\x34\x4b\x9b\x57\xbe\x41\xc1\xf2\x8a\xd7\x2e\x51\x55\x97\x28\xa6\xd7\xf6\x7a\x74\x81\x4b\x2b\x52\xba\xd2\x3d\xa1\xb6\x99\x29\x65\x65\x85\xbe\x2b\x65\x86\xdb\xc1\xc7\xe1\x32\xf9\x21\xa8\xbe\x52\x84\xb5\x1d\x42\xa9\x14\xd1\xa9\x8c\x65\x8f\xc8\xff\xf3\x09\xde\x22\x82\xcb\xaf\x7f\xac\xcf\x65\x59\x54\x4d\xed\x7c\xfa\xa4\xe1\x60\x0b\x16\x65\x85\x6a\x54\x7d\x47\xee\x22\x79\x74\x8a\xca\xf9\x64\x03\xa0\xe9\xff\x7e\x9b\x96\x2d\x12\x6a\x4a\xc1\xe6\x75\x2f\xaf\x57\x26\x41\x08\xbd\xca\x06\xe6\xd6\xde\x99\x78\xc8\x18\xfa\x93\xbc\x7a\x97\x2e\xbd\x5f\x03\x16\x09\x61\xd3\xd6\x6d\xdd\x7b\x53\xcf\x99\xe4\x67\xef\x38\xd7\xd2\x73\xee\x6f\xdc\x75\xb7\xb4\x60\x91\x50\x36\x6d\x5d\xe7\x8a\x7d\xd7\xa1\xc7\xf1\x63\x55\x64\xaa\x91\xe0\xc5\x36\x3b\xd1\xef\x0f\xfa\x7d\xce\x4a\x33\x37\xb1\xfa\x68\xff\x40\x20\xed\x5f\x84\x9a\xdd\xed\x67\x04\x5f\xd2\xfd\xa4\x70\xcf\x18\x6b\x92\x97\xe3\x12\xd3\x74\x7b\xb3\x9e\xc5\x2f\xe8\xb0\x20\x84\x54\xc7\x3a\xa7\x7d\xd0\x0c\xbe\x26\xf7\x95\x18\x58\x1d\xbc\x3f\x3d\x10\xb6\xa5\x90\x67\x61\xb3\xa6\x78\xc6\x7b\xfa\x45\xe9\x36\x86\xde\x74\xb9\xca\xf0\x45\x2a\xce\x3e\xcd\xb2\x2f\x3f\x7c\x0c\x16\xfb\xfd\xfe\x07\xf9\x36\x96\xad\xb3\x15\x2f\x5a\x49\xbe\xfc\xf0\xcb\x6a\x1e\xac\x1c\x2f\x73\x97\x0e\xfd\xe7\xcf\x57\x2e\xfe\x5f\x40\xff\xe7\xb0\xbf\x2e\x2b\xff\x27\x70\x43\x8a\x59\x30\xff\x62\x6d\x0d\xe6\x1b\xd2\x56\x7f\xbe\xc2\xed\x74\x84\xf6\x91\x67\x5e\xbe\x74\xc9\x3f\x6b\x5b\xd3\x53\x92\xc6\x61\x53\x54\x35\x60\x48\x94\xad\x86\x64\x4a\xab\x7e\x85\x5b\x4d\xb0\x2f\x23\xcc\x06\x7c\x14\x9e\xa7\x6b\xff\xa8\x64\x67\xff\xa8\xa6\x5d\x05\x5b\xe6\x64\xa9\x6d\x2f\x9d\x27\xed\xa5\xeb\x7e\xf1\x5d\x57\xfd\xd8\x5a\xf4\x73\xfc\x4a\x2d\x20\x0c\xe1\xdf\xc4\x8c\xe0\xbe\x39\x35\x64\xf7\x18\x74\xc4\x1c\xbe\x6c\x07\xe6\x7c\x60\x5d\xc2\x15\x85\x32\x90\xc9\xb8\x4b\xcf\x21\x2f\x4d\x98\x29\xd3\x89\x20\xb4\xa7\x87\x9d\x9f\x7f\x27\xda\x3c\x70\x36\x9c\x0a\xef\x07\x6a\x58\x92\x44\x08\x26\xcd\xc5\x0a\xc2\xb3\x83\x75\xac\xe2\xc2\xfe\x1b\x72\xa7\x70\xb8\x33\x03\xe1\xfb\xb6\x2b\x56\xb4\xb9\xb0\xeb\x9c\x7a\x4a\x9e\x49\xf2\x62\xc8\x30\xd9\x5f\x10\xc9\x6b\x34\x45\xa9\x02\x37\x45\xa9\xc3\xe5\x69\x92\x64\x1a\x5e\x5a\xaa\x43\xb3\x05\x5d\x95\x0b\x52\x0a\xf0\x80\x9b\x03\x57\x11\x5e\x19\xea\x41\x0d\x60\xe5\x62\x8d\xe8\xd0\x1d\x0e\x37\x1d\xd2\x17\xc0\x43\x01\xbe\x3b\x4c\x22\x14\x51\xc7\x6d\x4a\x45\xa9\xd0\xed\x0f\x36\x9b\xd2\x50\xaa\x94\xf5\x03\xce\x52\xa1\x89\x3a\x4d\x30\xa9\x52\x67\x07\x72\x4d\xc9\x25\x35\xda\xca\xc1\x5c\xa1\xc8\x44\x97\xa6\x8d\x54\xe8\x92\x63\xa5\xa6\x94\x91\x2a\x51\xe9\x78\x29\xff\x6d\x24\x47\x92\x41\x2a\xe4\xf8\x11\x49\x53\x22\x48\x95\xa2\x7a\x54\x52\x28\x32\xd1\xa5\x29\x1e\x15\xba\xec\x90\x9f\x29\xbd\xa3\x4a\x56\x39\xec\xd7\x97\x98\x88\xd2\xc4\x8d\x0a\x51\x7a\x58\xcd\x94\xef\x44\xa5\x29\x1f\x5a\xeb\x0a\x8c\xcd\x24\xe9\x18\xb5\x66\x56\xdf\x00\x58\x9a\x8a\x51\x6f\xa4\x70\x04\x8b\xff\x36\x4a\x93\x24\x59\x54\xa5\x79\x4c\x1b\x83\xe9\x55\x20\x85\xd8\x52\x87\x17\x5e\x4a\xd5\xc8\x5a\xdb\x45\x4f\x74\x4d\xf7\x81\xeb\xa0\xae\xc7\x81\x3d\xe0\x65\x53\x94\x1d\x00\xcd\xa0\x01\x00\xd1\x45\x3d\x4f\x49\xe8\x01\x00\xb2\xaf\x10\x9e\x9a\x4c\x03\x00\x25\x2b\x8a\x1d\x20\xf5\x81\x00\x58\x67\xf7\x80\x1c\x24\x00\xb8\x60\xae\xe4\xf3\x7e\xaa\xa9\xea\x2a\x70\x0b\x23\x81\x6b\xd6\x85\x83\x53\xc3\x20\x9f\x56\x53\x8d\x02\x87\xed\x46\xb5\x92\xf2\x4e\x19\xd1\x1c\x9c\x0f\x46\x39\xf1\xa1\x3a\x10\xbb\xee\xa3\xa3\x48\x46\xad\x8e\xa0\x1e\x75\xf5\x4d\x81\xd5\x94\xbf\xe3\x9a\x6a\xaf\xc2\xb3\xd4\xd7\x44\x4b\x11\x7c\x5f\x09\x00\x37\x7c\x37\x89\x70\xf5\xb3\x3d\xb5\x0f\x84\xbd\x12\x7b\x62\xb0\xde\x70\xc2\x1f\x88\xc6\xd8\x5b\x4f\x8c\x74\x6c\x6d\xee\xc9\x90\x4f\x50\x13\x7b\x6a\x12\x81\x38\xad\xe2\x0c\x29\x82\x5b\x79\x1f\x21\x58\x4f\xbd\xa0\x44\x04\x8a\x33\x14\x56\xfb\xb4\xe5\x21\xae\xfc\x49\x8f\xbc\xc5\x11\xd3\x51\x8a\x54\x13\x17\xcf\x30\xa4\xd5\x7e\x11\x67\xe2\xd2\xd0\x57\x99\xa9\x43\x20\x34\x3a\x06\xa7\xf4\x32\xb8\x0c\x07\x00\x34\x61\x94\xf5\x14\xc9\x2f\x00\xc0\xad\x8a\x17\x19\x08\x97\x40\x80\x31\xca\x32\x05\x12\x17\xc9\xa0\x78\x9e\x74\xd3\xcd\xe8\x42\x0f\x68\x38\x84\x72\x18\x95\x00\x20\x60\x34\x9f\xb8\x49\xdc\x3a\x1f\x92\x58\x9d\x8f\x11\x5a\x07\x35\x5a\x6e\x75\x3e\x2c\xba\x3a\x1f\x96\x1e\x87\x19\x23\xc0\x0e\x76\x94\x0c\xeb\xfc\x6d\x62\xec\xfb\xe4\x5e\x92\x04\x44\xb9\x59\x6f\x99\x28\xf3\xc1\xc1\x97\x8f\x1a\x7f\xf9\xe4\x21\x98\x8f\x18\x85\xf9\x88\x81\x98\x4f\x18\x8b\xf9\xa4\xe1\x98\xbf\x71\x44\xe6\x77\x1f\x94\x96\x43\x6a\x89\x9b\x1d\x86\x44\x99\x1d\xc6\x88\xb2\x83\x1a\x2d\xca\xec\x30\x2c\xca\xec\x30\x2c\x4a\x0e\x33\x46\x94\x1d\xec\x28\x51\x66\x87\xb7\x89\xb2\xef\x93\x77\x14\xa5\x4f\xce\x01\x10\x59\xb6\xd9\x90\x2c\xdb\x6c\x8c\x2c\x3b\xa8\xd1\xb2\x6c\xb3\x61\x59\xb6\xd9\xb0\x2c\x39\xcc\x18\x59\x76\xb0\xa3\x64\xd9\x66\x6f\x93\x65\xdf\x27\x77\x93\xe5\x3c\xc1\x13\x92\x53\xa3\x74\x9d\x22\x35\x26\x71\x02\x79\x31\x54\x51\x7b\xbb\x47\xad\x48\x7b\x14\xee\x21\x0d\x51\xb1\xdf\xc2\xff\x14\xfd\x32\xa0\xb0\xa9\xfa\x75\x8e\xf2\x08\x87\xf3\xa8\x2e\x8b\x53\x9d\x7e\x87\xae\x85\x81\x76\x8e\x91\xc5\xd1\x3e\xcf\x86\xb6\x89\x43\x45\xab\xdd\xdb\xc6\x62\x56\x21\x50\x55\xab\x38\x5a\x09\xf9\xac\x37\xd3\x01\x49\x01\x50\x9e\x92\xdc\xd2\xc0\x8b\x22\xfa\x07\x8a\x1b\xe0\xc5\xf7\x34\x41\xc5\xf0\xb7\x49\xb6\x78\x0c\xad\x13\x77\x17\xef\xee\x3c\xbd\x49\x6e\xe0\x47\xaf\x4f\xfd\xda\xb8\xb0\x88\xbb\x0c\xe6\xdb\xd5\xc6\x5f\x2e\x3e\x02\xd5\xfc\xb5\xa9\xda\x6a\x3d\x0f\x56\x50\x95\x65\xf4\xba\x00\x6b\x6c\x40\x70\x3f\x7a\xf5\x41\x70\xfa\x41\x8d\x7c\x07\xc1\xb6\x06\xc8\x4f\x21\x19\x1c\x35\x4d\x85\x62\x8d\xc8\x5b\x9a\xba\x02\x42\x45\xdf\x98\xb1\xa9\xef\x7b\xc6\xdc\x0a\x7d\x47\x55\x8d\x0c\x0c\xf2\xd7\x56\x46\x75\x20\x89\x61\x1b\x09\x19\x62\xa8\x01\x46\x42\x2f\x55\x28\x5c\x88\xdb\xdd\xbd\xa8\xe2\x03\x5e\x30\x04\xf4\xb6\x28\x05\x05\x2d\x04\x91\xa8\xaf\x04\x3e\xf4\xe6\x76\x64\x4d\xed\xb4\x00\x5c\xe7\xca\x97\x33\x9a\x41\xe3\x22\x7f\x64\x23\x65\x02\x56\x30\x43\x83\x02\xa4\x63\x46\xa7\xe4\xa2\x65\x7b\x18\xc2\x2a\x81\xe8\x38\xe9\x47\x93\x0b\xf4\x4d\xd0\x82\x59\x05\xd0\xf1\xb2\xac\x20\x17\x30\x93\x88\x05\xb3\x94\x53\xc4\x46\x20\x24\x2b\x0b\x0a\xfe\x24\xad\x9b\x2a\x8d\xce\x0d\x1a\x24\x41\xeb\x8b\x14\x84\x1d\x16\xaa\x10\x85\xa3\x7d\x02\x62\x38\x79\x8a\x09\xa5\x24\x3d\x8a\x50\x16\x9f\x86\x0e\x99\xf9\x53\xc5\x26\x7e\x01\x33\xa0\xd4\x65\x26\x22\xec\x3e\x97\x29\x28\xf5\xcf\x65\x12\x52\xf3\xd7\x34\xde\x8f\x15\x6a\xe2\xa3\xde\x93\xa4\xd8\x80\x54\x7b\xcb\x71\x1a\x86\x18\x09\x1a\xc0\x71\x26\x55\xb3\xca\x08\x1c\x63\x3d\x62\x48\x52\xb6\x61\x26\x23\x55\xa5\xd5\xe3\x35\x48\xcc\x3c\xce\x64\xc4\xda\x28\xeb\x31\xeb\x43\x4d\x46\x6d\x1c\x68\x32\x05\x75\x98\xf5\x04\xc0\xb1\x06\xd1\x30\x8d\xb4\x5e\x9a\x8a\x8e\x88\xf2\x84\xf5\xa4\x23\x60\xd2\x94\x1a\x65\x7b\xb2\xff\xba\xc7\x4a\xd3\xdd\x13\xf5\xc3\x2f\x34\x9c\xb8\x8a\xf2\x46\xc2\xa6\x28\x9d\x80\x0e\xd6\x3a\x82\xcf\xa6\x72\x04\xab\xa4\x6f\x02\x4e\x48\xe1\x7a\x8c\xa0\xb6\x11\x7c\xaa\xaa\x09\x28\x0d\xba\x46\xb0\x9a\x14\x8d\xe0\xd4\x6d\x83\x80\xd5\x68\x20\x08\x5e\xb3\x7d\x60\x7d\xaa\x88\x5e\xea\x55\x58\xf6\x04\xaf\x2e\x78\xf3\xca\x23\x95\x41\x7e\xb7\x30\xaf\xce\xef\x1f\xe9\x51\xf6\xde\x35\xd8\xeb\xd8\xfe\x0d\xe2\xbd\x3a\x7f\x7b\xc8\x47\x96\x8b\xef\x12\xf5\x31\x6e\xde\x3d\xf0\xcb\xdf\x31\xf6\xab\xf3\x77\x09\xff\xb0\x52\xbc\x53\x04\x58\xe7\xef\x1f\x04\xd6\xf9\x7b\xc7\x81\x9a\x4c\xef\x11\x0a\xaa\xc2\x7c\x6b\x34\x08\x48\xf1\xcd\x01\x21\x16\xdf\x3b\xc5\x84\xf9\x7b\x85\x85\x9a\xb0\xee\x19\x19\xaa\x42\xbb\x57\x70\x08\x08\xef\x6e\xf1\x21\x34\x06\xef\x1e\x22\x02\x83\xf0\x3d\xa2\x44\x40\x6b\xee\x15\x28\xe2\x16\xdc\x37\x56\xd4\x34\xf1\x4e\xe1\xa2\xaa\x84\x77\x88\x18\x01\xfd\xbb\x47\xd0\x08\xda\x8f\xbb\xc5\x8d\x80\x32\xdc\x1e\x3a\x5a\x3e\x75\x12\xcc\x79\x72\xb7\xd8\x31\x4f\xee\x1f\x3b\x52\xf6\xde\x35\x76\xec\xd8\xfe\x0d\x62\xc7\x3c\x79\x7b\xec\x48\xbe\x4f\xdf\x25\x76\x64\xdc\xbc\x77\xec\x98\x27\xef\x18\x3b\xe6\xc9\xbb\xc4\x8e\x58\x29\xde\x29\x76\xcc\x93\xf7\x8f\x1d\xf3\xe4\x9d\x63\x47\x5d\xa6\xf7\x88\x1d\x55\x61\xbe\x35\x76\x04\xa4\xf8\xe6\xd8\x11\x8b\xef\x7d\x62\x47\xd2\xa7\xef\x11\x3b\xea\xc2\xba\x67\xec\xa8\x0a\xed\x5e\xb1\x23\x20\xbc\xbb\xc5\x8e\xd0\x18\xbc\x7b\xec\x08\x0c\xc2\x77\x88\x1d\x21\xad\xb9\x57\xec\x88\x5b\x70\xd7\xd8\x51\xd7\xc4\x3b\xc5\x8e\xaa\x12\xde\x21\x76\x04\xf4\xef\x1e\xb1\x23\x68\x3f\xee\x15\x3b\x42\xca\x70\xd7\xd8\x91\xef\xad\xa2\x6a\x76\xb8\x5b\xec\x98\x1d\xee\x1f\x3b\x52\xf6\xde\x35\x76\xec\xd8\xfe\x0d\x62\xc7\xec\xf0\xf6\xd8\x91\x6c\x88\xbb\x4b\xec\xc8\xb8\x79\xef\xd8\x31\x3b\xbc\x63\xec\x98\x1d\xde\x25\x76\xc4\x4a\xf1\x4e\xb1\x63\x76\x78\xff\xd8\x31\x3b\xbc\x73\xec\xa8\xcb\xf4\x1e\xb1\xa3\x2a\xcc\xb7\xc6\x8e\x80\x14\xdf\x1c\x3b\x62\xf1\xbd\x4f\xec\x48\xfa\xf4\x3d\x62\x47\x5d\x58\xf7\x8c\x1d\x55\xa1\xdd\x2b\x76\x04\x84\x77\xb7\xd8\x11\x1a\x83\x77\x8f\x1d\x81\x41\xf8\x0e\xb1\x23\xa4\x35\xf7\x8a\x1d\x71\x0b\xee\x1a\x3b\xea\x9a\x78\xa7\xd8\x51\x55\xc2\x3b\xc4\x8e\x80\xfe\xdd\x23\x76\x04\xed\xc7\xbd\x62\x47\x48\x19\xee\x1a\x3b\x76\x9b\xb9\x09\xea\x36\xbb\x5b\xf0\xd8\x66\xf7\x0f\x1e\x29\x7b\xef\x1a\x3c\x76\x6c\xff\x06\xc1\x63\x9b\xbd\x3d\x78\x24\x3b\xf0\xef\x12\x3c\x32\x6e\xde\x3b\x78\x6c\xb3\x77\x0c\x1e\xdb\xec\x5d\x82\x47\xac\x14\xef\x14\x3c\xb6\xd9\xfb\x07\x8f\x6d\xf6\xce\xc1\xa3\x2e\xd3\x7b\x04\x8f\xaa\x30\xdf\x1a\x3c\x02\x52\x7c\x73\xf0\x88\xc5\xf7\x3e\xc1\x23\xe9\xd3\xf7\x08\x1e\x75\x61\xdd\x33\x78\x54\x85\x76\xaf\xe0\x11\x10\xde\xdd\x82\x47\x68\x0c\xde\x3d\x78\x04\x06\xe1\x3b\x04\x8f\x90\xd6\xdc\x2b\x78\xc4\x2d\xb8\x6b\xf0\xa8\x6b\xe2\x9d\x82\x47\x55\x09\xef\x10\x3c\x02\xfa\x77\x8f\xe0\x11\xb4\x1f\xf7\x0a\x1e\x21\x65\x78\x43\xf0\x38\x27\x57\x19\xd3\x53\xfe\xf4\x56\x63\xfc\x28\xc7\x11\x18\x80\x66\x34\x10\xee\x3d\xd6\x41\xc8\x49\x2a\x0a\x01\x1f\xde\x02\x77\x55\xe2\x9a\x75\x3e\xcc\x40\x9d\x8f\xe1\x81\x9f\x0e\x07\xd9\xb0\x7e\xa1\xc7\xb5\xf3\x64\x98\x8f\x3c\x19\xc3\x07\x3f\xda\x3c\x96\x8f\x7e\xb5\x97\x48\xe3\x30\xcc\x47\x76\x18\xc3\x07\x3f\x97\x3b\x96\x0f\x61\xe6\x80\xab\xb7\xd9\x30\x23\x38\x7e\x1f\x66\x84\x1f\x2a\x85\x19\x99\xf3\x33\x65\xd8\x02\x34\x69\xdc\x9f\x31\xa3\xbf\x45\x7c\x1d\x28\x3f\x81\xa7\x9f\xc9\x03\xc1\xf9\x69\x35\xfd\xfc\x1a\x08\x4e\x6e\xe7\x55\x2e\xeb\x05\x01\xeb\x26\x8d\xbf\x09\xf7\xba\xf2\x9c\xa1\xb4\x5c\x18\x88\x42\x9b\xe4\x37\xd7\x39\xc1\x4e\xaf\x3a\xb4\xdd\x0e\xac\x5d\x96\xb5\xf0\x78\x5d\x7e\xd5\x9c\x5c\x7d\xf8\x5a\xe1\x85\x27\xa5\x59\x35\xb4\x82\xa6\x56\x55\x1a\xf0\xf8\x78\x99\xd3\x27\x99\x73\xb9\xaa\xda\x6c\xd6\xa4\x9e\x83\xc0\xbb\x5e\xe7\x75\xe5\x16\xa7\xec\x15\x38\x5b\xc8\xf4\xb2\x4f\xd8\xe7\x0b\xd7\x95\x69\x47\x2b\x9f\xc9\x8d\x52\x78\xaa\xc6\xd2\xaa\x7b\x8f\xca\x7d\x56\x78\x4a\xd3\x25\x8a\xc6\xd0\x6e\x19\x36\xc7\x5d\x7a\xaa\x51\xf3\x69\xe5\x7d\x7c\x7c\x06\x0b\xfb\x53\x8b\x8c\x55\x97\xe4\x63\x0a\xa3\x0c\xed\xd8\xbd\xf3\xc0\x1b\xf2\xa4\x2a\xb3\x78\x65\x36\x6b\x13\x79\xee\x9a\x42\x12\x5b\x67\x88\xb6\x85\xa6\x2d\xd4\xaf\xe4\xd2\x9b\x40\x72\x9b\xca\x3f\xaf\xf3\x17\x37\x58\x5d\x58\x46\xd1\x95\x94\x53\xe5\xc5\x5d\x79\x17\x7e\x61\xad\xf2\x66\xc3\xeb\x6c\xd4\x3a\xbe\xc7\x2b\xf9\x9e\x5c\xeb\x88\x29\xf1\x84\xa5\x2b\xe5\xd5\xca\xbb\x74\x57\xec\x2a\xaf\x36\x5d\xad\x8d\x5a\x0b\x13\x13\x8e\x8e\x8a\x2f\x73\xca\x4a\x7f\x39\x9c\x06\x70\xec\x00\x4c\x28\x5c\xef\xc2\xaf\xc3\x95\xca\x1b\xd7\x9b\xcd\xf3\xd7\xee\xb5\x9e\x83\x2a\xaf\x08\x48\xdb\x83\x00\xf9\xa7\xf2\x48\xc5\x03\xa5\x9e\xca\x33\x15\x95\x9e\x77\x2a\x77\x7d\xce\xa9\x9e\x47\x27\x6f\x5c\x9f\x90\xf1\xa5\xfb\x5e\x74\xb8\x8a\xc0\xb5\x3d\x9c\x78\x87\xb2\xc2\xb8\x82\x91\xdf\xf4\xa5\x83\x66\x2a\x52\xe1\x2e\x67\xb9\x09\x41\xd7\x04\xa0\x05\x01\xa1\x17\x48\x2d\x00\x1a\x10\x10\x5a\x81\xd2\x00\x80\x7f\x05\x1f\xe7\x1f\x60\x5f\x41\x49\xd9\xd7\xb9\x5f\x70\xee\x7d\x9d\xf9\x05\x21\xb6\x10\x99\xd7\xa0\x2a\x02\xd5\xf6\x50\x2c\x99\xa5\xce\xba\x82\x8d\xa7\x69\xd5\x39\x57\x10\xd2\x3c\x98\x1a\xe3\xcb\x8e\x71\xa8\xdf\x97\x84\xd8\x52\x62\x1d\xea\xf8\x25\xa1\xb5\x54\x98\x87\x7a\x5e\xc1\xc8\xd9\x87\xba\x5e\x41\x4a\x1b\x00\xf4\xfd\x8a\x37\x61\xa1\x37\x60\x45\xc8\xad\xc4\x06\x68\x50\x15\x81\x6a\x7b\x28\x96\xe8\x55\x67\x5e\xc1\xc6\x98\xd7\x00\x33\x15\x21\x4d\x14\xab\x80\x95\xae\xd7\xdf\x4b\x2a\xbd\x20\x06\xa6\x7c\xed\xdf\xeb\x16\xa6\x24\x16\xa6\x6c\x05\x18\xc0\xc4\x94\x91\x86\x09\xb2\x31\x65\xa6\x21\xd3\x8d\x4c\xe9\xfa\xfd\xcd\x16\xda\xf8\x2d\x89\x95\x29\x5f\x7b\x20\x83\x99\x29\x89\x99\x29\x5b\x01\xd0\x64\x67\xca\x48\xc3\x69\x34\x34\x65\xa6\xa1\x35\x58\x9a\xd2\x0d\xe4\x1b\x3a\x94\x66\x04\x84\x64\x20\x37\x03\x68\x45\x40\xc8\x05\x6a\x2b\x80\x46\xa8\x18\x4d\xd6\xa6\xcc\x34\xa4\xb0\xb9\x29\xdd\x45\xd7\x04\x75\x44\x97\xc4\xde\x94\xaf\x3d\x08\x68\x70\x4a\x62\x70\xca\x56\x00\x83\x2d\x4e\x19\x69\xf8\x0c\x26\xa7\xcc\x34\x94\xa0\xcd\x29\xdd\x65\xcf\x3d\x24\x81\x25\xa1\xb7\x94\xf9\x87\x44\xb0\x24\xe4\x96\x6a\x0b\x20\x19\xa8\x38\x8d\x76\xa7\xcc\x34\xb4\x06\xc3\x53\xba\xab\xae\x1d\xda\xd8\x26\x96\xa7\x7c\xed\x41\x40\xd3\x53\x12\xd3\x53\xb6\x02\x18\x6c\x7b\xca\x48\xc3\x67\x30\x3e\x65\xa6\xa1\x04\xad\x0f\x3b\x8c\xc0\x2c\xa7\xba\x60\x93\x37\xe4\x35\x31\x77\x02\x1c\x69\x84\x06\x5b\x71\xd8\x56\x82\xad\xba\xd0\x55\xb1\xa2\x20\x66\xd6\x1c\x0d\x3c\x83\x91\x93\x36\x29\xc0\xe6\x65\x84\x1c\x4f\xfc\x8d\xd1\x1c\x7e\x47\xf8\x11\x80\xc0\x98\x8e\x01\xb6\x12\x20\x1c\xd9\x81\x38\x0d\xf1\x1d\x88\x16\x8a\xf2\xea\xa1\x40\x0f\x03\x70\xaa\xc3\xe1\x1e\x83\x6e\x25\x68\x4b\xd0\x07\x62\xb7\x85\x7e\x20\x01\x63\x00\x58\x0f\xc4\x80\xf8\x3d\x27\x3f\x18\x09\x32\xe0\x56\x02\x36\xc7\x83\x20\x6e\x4b\x54\x08\xa2\x37\xc5\x86\xb5\x3d\x3c\xc4\xaf\x39\xed\xa1\x20\x91\xc1\xb6\x12\xac\x31\x54\x04\x31\x9b\x03\x46\x10\xb9\x21\x6c\xac\x87\x22\x47\x0c\xc0\x69\x0f\xc7\x8f\x0c\xba\x95\xa0\x2d\x51\x24\x88\xdd\x16\x4b\x82\x04\x8c\x11\x65\x6d\x0f\x2a\xf1\x6b\x4e\x7d\x28\xb4\x64\xb0\xad\x04\x6b\x0c\x30\x41\xcc\xe6\x30\x13\x44\x6e\x08\x36\x89\x71\x31\xc5\x9b\xd4\x04\x95\xaf\x12\x14\x18\x75\x32\xc8\x56\x86\x84\x63\x4f\x18\xab\x21\x02\x85\x11\x43\x71\x28\xb1\x26\xd6\x50\x94\x1a\x9e\xf2\x55\x02\x35\x07\xa4\x0c\xbc\x95\xc1\x2d\x61\x29\x8c\xdf\x16\x9c\xc2\x24\x8c\x21\x2a\x31\x2b\xb6\x28\x95\x1a\xa0\xf2\x55\x82\x34\xc6\xaa\x0c\xba\x95\xa1\xcd\x11\x2b\x8c\xdd\x12\xb7\xc2\x04\x4c\xd1\x2b\xb1\x2f\x96\x00\x96\x1a\xa2\xf2\x55\x02\x34\x85\xb1\x0c\xb8\x95\x81\x8d\xc1\x2c\x8c\xdb\x1c\xd2\xc2\xe8\x0d\x81\x2d\x31\x2e\xd6\xd8\x96\xda\xa1\xf2\x55\x02\x35\x47\xb8\x0c\xbc\x95\xc1\x2d\x71\x2e\x8c\xdf\x16\xed\xc2\x24\x8c\x31\x2f\xb1\x34\x96\xb0\x97\x9a\xa4\xf2\x55\x02\x34\x05\xbf\x0c\xb8\x95\x81\x8d\x21\x30\x8c\xdb\x1c\x08\xc3\xe8\x0d\xe1\x70\x3d\x18\x11\x33\x08\x6e\x9f\x47\xc4\xc5\x7d\x8d\x56\xad\x61\x8c\x8e\x2d\x54\xcc\x31\xb2\x85\x10\x14\x29\x5b\xbe\x74\xe5\x6e\x9e\x98\x43\x65\xfc\x8e\x70\x26\x00\x81\xa1\x32\x03\x6c\x25\x40\x38\x54\x06\x71\x1a\x42\x65\x10\x2d\x14\x2a\xe7\xc9\x40\xa8\x8c\x01\x38\xd5\xe1\x50\x99\x41\xb7\x12\xb4\x25\x54\x06\xb1\xdb\x42\x65\x90\x80\x31\x54\xce\x13\x7b\xa8\x8c\xdf\x73\xf2\x83\xa1\x32\x03\x6e\x25\x60\x73\xa8\x0c\xe2\xb6\x84\xca\x20\x7a\x53\xa8\x9c\x27\xd6\x50\x19\xbf\xe6\xb4\x87\x42\x65\x06\xdb\x4a\xb0\xc6\x50\x19\xc4\x6c\x0e\x95\x41\xe4\x86\x50\x39\x4f\x06\x42\x65\x0c\xc0\x69\x0f\x87\xca\x0c\xba\x95\xa0\x2d\xa1\x32\x88\xdd\x16\x2a\x83\x04\x8c\xa1\x72\x9e\x58\x43\x65\xfc\x9a\x53\x1f\x0a\x95\x19\x6c\x2b\xc1\x1a\x43\x65\x10\xb3\x39\x54\x06\x91\x1b\x42\x65\x62\x5c\x4c\xa1\x32\x35\x41\xe5\xab\x04\x05\x86\xca\x0c\xb2\x95\x21\xe1\x50\x19\xc6\x6a\x08\x95\x61\xc4\x50\xa8\x4c\xac\x89\x35\x54\xa6\x86\xa7\x7c\x95\x40\xcd\xa1\x32\x03\x6f\x65\x70\x4b\xa8\x0c\xe3\xb7\x85\xca\x30\x09\x63\xa8\x4c\xcc\x8a\x2d\x54\xa6\x06\xa8\x7c\x95\x20\x8d\xa1\x32\x83\x6e\x65\x68\x73\xa8\x0c\x63\xb7\x84\xca\x30\x01\x53\xa8\x4c\xec\x8b\x25\x54\xa6\x86\xa8\x7c\x95\x00\x4d\xa1\x32\x03\x6e\x65\x60\x63\xa8\x0c\xe3\x36\x87\xca\x30\x7a\x43\xa8\x4c\x8c\x8b\x35\x54\xa6\x76\xa8\x7c\x95\x40\xcd\xa1\x32\x03\x6f\x65\x70\x4b\xa8\x0c\xe3\xb7\x85\xca\x30\x09\x63\xa8\x4c\x2c\x8d\x25\x54\xa6\x26\xa9\x7c\x95\x00\x4d\xa1\x32\x03\x6e\x65\x60\x63\xa8\x0c\xe3\x36\x87\xca\x30\x7a\x43\xa8\xcc\x4f\x23\x9b\x43\x65\x06\xc1\xed\xf3\x88\x50\xb9\xaf\xd1\xaa\x35\x8c\xa1\xb2\x85\x8a\x39\x54\xb6\x10\x1a\x19\x2a\xf3\xcd\x58\xb9\x9b\x1d\xcc\xa1\x32\x7e\x47\x38\x13\x80\xc0\x50\x99\x01\xb6\x12\x20\x1c\x2a\x83\x38\x0d\xa1\x32\x88\x16\x0a\x95\xb3\xc3\x40\xa8\x8c\x01\x38\xd5\xe1\x50\x99\x41\xb7\x12\xb4\x25\x54\x06\xb1\xdb\x42\x65\x90\x80\x31\x54\xce\x0e\xf6\x50\x19\xbf\xe7\xe4\x07\x43\x65\x06\xdc\x4a\xc0\xe6\x50\x19\xc4\x6d\x09\x95\x41\xf4\xa6\x50\x39\x3b\x58\x43\x65\xfc\x9a\xd3\x1e\x0a\x95\x19\x6c\x2b\xc1\x1a\x43\x65\x10\xb3\x39\x54\x06\x91\x1b\x42\xe5\xec\x30\x10\x2a\x63\x00\x4e\x7b\x38\x54\x66\xd0\xad\x04\x6d\x09\x95\x41\xec\xb6\x50\x19\x24\x60\x0c\x95\xb3\x83\x35\x54\xc6\xaf\x39\xf5\xa1\x50\x99\xc1\xb6\x12\xac\x31\x54\x06\x31\x9b\x43\x65\x10\xb9\x21\x54\x26\xc6\xc5\x14\x2a\x53\x13\x54\xbe\x4a\x50\x60\xa8\xcc\x20\x5b\x19\x12\x0e\x95\x61\xac\x86\x50\x19\x46\x0c\x85\xca\xc4\x9a\x58\x43\x65\x6a\x78\xca\x57\x09\xd4\x1c\x2a\x33\xf0\x56\x06\xb7\x84\xca\x30\x7e\x5b\xa8\x0c\x93\x30\x86\xca\xc4\xac\xd8\x42\x65\x6a\x80\xca\x57\x09\xd2\x18\x2a\x33\xe8\x56\x86\x36\x87\xca\x30\x76\x4b\xa8\x0c\x13\x30\x85\xca\xc4\xbe\x58\x42\x65\x6a\x88\xca\x57\x09\xd0\x14\x2a\x33\xe0\x56\x06\x36\x86\xca\x30\x6e\x73\xa8\x0c\xa3\x37\x84\xca\xc4\xb8\x58\x43\x65\x6a\x87\xca\x57\x09\xd4\x1c\x2a\x33\xf0\x56\x06\xb7\x84\xca\x30\x7e\x5b\xa8\x0c\x93\x30\x86\xca\xc4\xd2\x58\x42\x65\x6a\x92\xca\x57\x09\xd0\x14\x2a\x33\xe0\x56\x06\x36\x86\xca\x30\x6e\x73\xa8\x0c\xa3\x37\x84\xca\xfc\xf0\xb5\x39\x54\x66\x10\xdc\x3e\x8f\x08\x95\xfb\x1a\xad\x5a\xc3\x18\x2a\x5b\xa8\x98\x43\x65\x0b\xa1\x91\xa1\x72\x77\x5e\x20\x77\xdb\xcc\x1c\x2b\xb7\x19\x8b\x6b\x05\x20\x30\x56\x6e\xf9\x6e\x58\x11\x10\x8e\x95\x41\x9c\x86\x58\x19\x44\x0b\xc5\xca\x6d\x36\x10\x2b\xb7\x19\x8b\x66\x05\x48\x73\xac\xdc\xf2\xed\xb1\x22\xb4\x25\x56\x06\xb1\xdb\x62\x65\x90\x80\x31\x56\x6e\x33\x7b\xac\xdc\x66\x2c\x9e\x15\x00\x8d\xb1\x72\xcb\xf7\xce\x8a\xc0\xe6\x58\x19\xc4\x6d\x89\x95\x41\xf4\xa6\x58\xb9\xcd\xac\xb1\x72\x9b\xb1\x88\x56\x80\x33\xc5\xca\x2d\xdf\x58\x2b\xc2\x1a\x63\x65\x10\xb3\x39\x56\x06\x91\x1b\x62\xe5\x36\x1b\x88\x95\xdb\x8c\x45\xb3\x02\xa4\x39\x56\x6e\xf9\x7e\x5b\x11\xda\x12\x2b\x83\xd8\x6d\xb1\x32\x48\xc0\x18\x2b\xb7\x99\x35\x56\x6e\x33\x16\xd1\x0a\x70\xa6\x58\xb9\xe5\xdb\x71\x45\x58\x63\xac\x0c\x62\x36\xc7\xca\x20\x72\x43\xac\x4c\x8c\x8b\x29\x56\xa6\x26\xa8\x7c\x95\xa0\xc0\x58\xb9\xe5\xbb\x75\x25\x48\x38\x56\x86\xb1\x1a\x62\x65\x18\x31\x14\x2b\x13\x6b\x62\x8d\x95\xa9\xe1\x29\x5f\x25\x50\x73\xac\xdc\xf2\xed\xbb\x12\xb8\x25\x56\x86\xf1\xdb\x62\x65\x98\x84\x31\x56\x26\x66\xc5\x16\x2b\x53\x03\x54\xbe\x4a\x90\xc6\x58\xb9\xe5\x7b\x7b\x25\x68\x73\xac\x0c\x63\xb7\xc4\xca\x30\x01\x53\xac\x4c\xec\x8b\x25\x56\xa6\x86\xa8\x7c\x95\x00\x4d\xb1\x72\xcb\x37\xfe\x4a\xc0\xc6\x58\x19\xc6\x6d\x8e\x95\x61\xf4\x86\x58\x99\x18\x17\x6b\xac\x4c\xed\x50\xf9\x2a\x81\x9a\x63\xe5\x96\xef\x07\x96\xc0\x2d\xb1\x32\x8c\xdf\x16\x2b\xc3\x24\x8c\xb1\x32\xb1\x34\x96\x58\x99\x9a\xa4\xf2\x55\x02\x34\xc5\xca\x2d\xdf\x2e\x2c\x01\x1b\x63\x65\x18\xb7\x39\x56\x86\xd1\x1b\x62\x65\x7e\xd6\xdc\x1c\x2b\xb7\x59\x1f\xc5\xca\xd0\xa6\x58\xb9\x15\xf6\x0f\x2b\x35\x8c\xb1\xb2\x85\x8a\x39\x56\xb6\x10\x02\x63\xe5\x79\x83\xda\xc6\x65\x79\x06\x2e\xe4\x07\x3d\xa1\xad\xa5\x1e\x60\xa0\x2c\xe3\x8b\x7e\x5a\x51\x83\x6c\xaa\xf3\x29\x0e\x1b\x74\x51\x0f\x3f\x92\xb7\x5d\x21\xca\xb2\xb4\xac\xd3\x1a\x38\x00\xc9\x10\x91\xb3\xbd\x02\x6b\xea\x01\x5f\xf2\x8a\x1e\xee\x15\xa0\xb4\x13\xbe\xe4\x1d\x3b\x48\x2f\xc0\x69\x67\xe4\xcd\xfb\xb7\x49\x2d\x7e\x0a\x7c\x88\xa1\xee\x24\xf8\x20\x4f\x7d\x52\x7a\x1b\x5b\x96\xcd\x32\xa4\x1a\x3f\x15\x3e\xc4\x57\x77\x32\x7c\x90\xaf\x3e\xe1\xe9\x44\xbe\xf8\x97\x09\x2a\xbd\xc3\x38\xbe\xba\x93\xe2\x83\x7c\xf5\xc9\xb4\x26\xf2\xd5\x4d\x03\x49\x3d\x7e\x6a\x7c\x88\xb1\xee\xe4\xf8\x20\x63\x7d\xa2\x06\x2b\x63\xac\x15\xc5\x0b\xaa\xe2\xb0\x46\x17\x36\x5a\xc2\x53\xbd\x2f\xaa\x7c\xd7\xbd\xd0\xf0\x9f\xcb\x12\xae\xd2\xbd\xd0\xf5\x3d\x2c\xd3\x26\xcc\xd2\x7f\x6a\x75\xfa\x37\x62\xa5\x7d\x71\x6a\xdc\x17\x72\x12\xd5\xcd\xe8\x79\xf9\xbe\x64\xb7\xf0\x3c\x13\x30\x3d\xef\x2b\x41\x2f\xcd\xd0\x51\x91\x25\x12\xec\x06\x80\x25\xec\xc5\x14\xac\x6e\x5e\x33\xb4\xa3\x25\x5a\x23\x89\xe5\xb8\xc4\x45\x56\x54\xbb\x0f\xfb\xfd\x5e\x03\x28\xab\x34\x0f\xab\x57\x0e\xe2\x79\x9b\x48\x82\x0a\x25\x30\x7a\x1e\x7a\xa6\x14\x1e\xb1\xc5\xea\x31\xac\x83\x58\x67\xa4\x46\x71\x71\x4a\x04\x4a\xdb\xf5\x16\x3d\xad\x75\x4a\x1d\xa0\x4c\xab\x2f\x96\xa8\xad\xe3\xcd\x6a\x93\xe8\xd4\xce\x71\x8c\xea\x9a\x43\x05\xdb\x70\xb3\x5c\x01\xb4\x28\x98\x42\x89\x15\x4a\x74\x7c\xb4\x41\x8b\xa5\x46\x27\x3d\xed\x8b\x0e\x64\x13\x06\xd1\x56\x27\x82\x61\x64\x0a\xa4\x44\x46\xef\x6f\xc2\x6d\xa4\x4b\x2f\xac\x4e\xe9\xe9\xd0\xcb\x2f\xf6\xbd\x8d\x4e\x81\x81\xc9\x44\x78\xa1\x44\x27\x59\x3c\x21\x59\x9d\x08\x6c\x12\x9e\x0e\x02\x50\xbc\x58\x41\xbd\x45\xa1\x64\x2a\xac\x4c\x22\x12\x25\x81\xbf\xd0\x89\xd0\x31\xc3\x9b\xb2\xdd\x3f\xed\x43\x9d\x06\x01\x92\x49\xd0\x22\xb9\x19\x21\xf2\xd0\x0a\x68\x46\xf5\x8d\x83\x2c\x96\x8b\x70\xe9\x41\x8d\xa8\xbe\xa9\x4d\xa8\xbe\x29\xd2\x48\x02\x3f\xd0\x85\x9d\x9f\x1b\x94\x18\xd5\x97\xc2\x1c\xd3\x04\x91\x61\xb9\xf3\x3e\x7b\x4e\xf8\x4c\xa1\x89\x69\x29\xc3\x0a\x9d\x1a\xea\xe2\xeb\x63\x98\x14\x2f\xf4\xc0\x7f\x14\xc6\xdf\x0e\x24\x81\x90\xab\x43\xf7\x59\x0b\x58\x4e\x81\x0b\xf9\x9b\x66\x69\xf3\xca\xd3\x0c\x88\x4c\xa4\x27\x00\x8e\x06\x17\x02\xd8\xff\xf8\xfc\x87\x0f\x4e\x5d\x9c\xab\x18\xfd\x12\x96\x65\x7a\x3a\xfc\xd7\x7f\xfe\xed\x4b\x54\x14\x4d\xdd\x54\x61\x39\xcf\xd3\xd3\x3c\xae\xeb\x79\x1e\x96\xce\x1f\x3e\xff\xff\x01\x00\x00\xff\xff\xc4\xaa\x95\x3d\x6f\xf1\x01\x00")
-
-func resBootstrapMinCssBytes() ([]byte, error) {
-	return bindataRead(
-		_resBootstrapMinCss,
-		"res/bootstrap.min.css",
-	)
-}
-
-func resBootstrapMinCss() (*asset, error) {
-	bytes, err := resBootstrapMinCssBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "res/bootstrap.min.css", size: 127343, mode: os.FileMode(420), modTime: time.Unix(1510060966, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resBootstrapMinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\xbd\x6b\x77\xe3\xb6\x76\x30\xfc\xbd\xbf\x42\xe2\xe9\xab\x21\x6a\x98\x23\x27\xa7\x3d\x3d\xd4\x70\xbc\x3c\xb6\x92\xf8\x8d\xc7\x76\x6d\x39\x69\xea\xa3\x7a\xd1\x22\x24\x21\x43\x01\x2a\x09\x8d\xc7\xb5\xd8\xdf\xfe\x2c\x5c\x09\x90\xa0\x6c\x4f\x92\xf3\x74\x3d\x5f\x66\x2c\x60\x13\xd7\x8d\x7d\xc3\xde\x1b\x6f\xff\xa9\xff\x0f\xbd\xde\x3f\xf5\x3e\x50\xca\x4a\x56\xa4\xeb\xde\xe7\x3f\x47\xc3\x68\xb8\x7f\x8f\x58\x1a\x7d\xd3\x0b\x97\x8c\xad\xcb\xf8\xed\xdb\x05\x62\xf7\x1a\x26\x9a\xd1\x15\x10\x9f\x1d\xd3\xf5\x63\x81\x17\x4b\xd6\xfb\x66\x78\x70\xb0\xff\xcd\xf0\xe0\x2f\xbd\xc9\x12\x59\xcd\x1d\x6d\xd8\x92\x16\xa5\xd5\x10\x66\xcb\xcd\x3d\x6f\xe2\x2d\x7b\xb8\x2f\xdf\x9a\x56\xdf\x2e\x8a\x74\xbd\x2c\xdf\xce\x28\x61\x05\xbe\xdf\x30\x5a\x94\xb2\x97\x33\x3c\x43\xa4\x44\x59\x6f\x43\x32\x54\xf4\x3e\x9e\x4e\x5e\xd2\xdc\x7d\x4e\xef\xdf\xae\xd2\x92\xa1\xe2\xed\xd9\xe9\xf1\xf8\xfc\x7a\x2c\x9a\x7b\xfb\x0f\x9f\xd3\xa2\x67\xe0\x92\xf9\x86\xcc\x18\xa6\x24\x64\x10\x41\x02\x9e\x82\x4d\x89\x7a\x25\x2b\xf0\x8c\x05\x23\x5d\xd9\xc3\xbc\x1a\x3c\xcd\x69\x11\xf2\xcf\x49\x32\x1c\x91\x77\x28\xca\x11\x59\xb0\xe5\x88\xec\xed\x81\x27\x5e\x8e\x13\x74\x4b\xa6\x23\x1c\x21\xb2\x59\xa1\x22\xbd\xcf\x51\x62\xff\xd8\x6e\xfb\x07\x10\x47\x33\x4a\xe6\x78\xb1\x91\xf5\xfd\x21\x0c\x3e\xa7\xf9\x06\x05\x98\xf4\xf0\x60\x10\xe2\xe8\xa1\xc0\x4c\xd5\x01\x78\x71\xff\x2b\x9a\xb1\x28\x43\x73\x4c\xd0\x65\x41\xd7\xa8\x60\x8f\x21\x83\x38\xfa\x84\x1e\x21\x06\x55\x85\x12\x34\x18\xa0\x68\x99\x96\x17\x0f\xc4\x40\x04\x19\x9a\xa7\x9b\x9c\x05\xe0\x10\x45\xea\xef\x18\x41\x92\x90\xc1\x80\xec\x00\x26\x06\x98\x8c\xf8\x9c\xca\x7a\x89\xc0\x93\x59\x10\x16\x32\xf0\x54\x20\xb6\x29\xc8\x53\x15\x31\x7a\xcd\x0a\x4c\x16\xd1\x2c\xcd\xf3\x90\x81\x68\x95\xb2\xd9\x32\x7c\xfb\xb7\x32\xbc\x4d\xf7\xff\xfb\x68\xff\x3f\xa6\x7b\xe0\x2d\xb8\x3d\x98\x46\x8c\x9e\xd1\x07\x54\x1c\xa7\x25\x0a\x41\x65\xda\xe3\x8d\xab\xe6\xee\x31\xc9\x26\x8f\x6b\x14\x17\x11\x22\x19\xcc\x50\x8e\x16\x29\x43\x56\xd1\x32\x25\x59\x8e\xe2\x7a\xeb\xc0\x13\x9e\x87\x28\x64\x11\x4b\x8b\x05\x62\x20\xc2\x65\xc8\x96\xb8\x04\x40\xb6\xd9\x63\x91\xfc\xe6\xe2\xfe\x57\xf5\x57\x11\xa5\xeb\x75\xfe\x28\xc0\x60\x5a\x2c\x36\x2b\x44\x58\x09\xaa\xaa\xb2\x36\x5d\xb4\xfb\x80\x49\x46\x1f\xa2\x7f\xbb\x21\x98\xa9\xf6\xfa\x07\x62\x69\x58\x92\xd1\x99\xf8\x30\x9a\x15\x28\x65\x68\x9c\x23\xfe\x2b\x0c\x0c\x7e\x05\x60\xa4\x91\x06\xf5\x30\xe9\x51\x80\xe7\x61\xc0\x31\x99\x6f\x67\x16\xf4\x13\xf6\xb8\x46\x74\xde\x63\x51\xc9\x1e\x73\x74\x8b\xa6\xaa\x8f\x27\x44\xb2\x98\xde\xa2\x69\x35\xd2\x9d\xd6\x43\x2b\xf9\x9c\x25\x26\x8a\x09\xe0\xa4\x7f\xa0\xc0\x7a\x48\x4e\x3d\xa2\x04\x85\x69\x34\xb9\x3a\x3a\xbf\x3e\x9d\x9c\x5e\x9c\xdf\x8d\xcf\x4f\xa0\xb5\x97\x38\xe9\x0f\x2b\x00\x4b\xc4\x26\x78\x85\xe8\x86\x85\x76\xe5\x76\x9b\x46\xac\xc0\x8b\x05\x2a\x26\x45\x4a\x4a\xcc\x2b\xc6\x24\x0b\x09\xa8\x20\x03\x90\xf7\x51\xf1\x11\x14\x49\xff\x00\xd2\xe4\xe9\x67\x74\xff\x09\xb3\x1a\x36\x0e\x1e\x1a\x25\x63\x92\x05\xf0\x23\xfd\x6f\x1b\x86\x99\xbf\x11\xaf\xbd\xb0\xeb\xa8\xf3\x69\x8f\x36\x60\x59\x67\x33\x15\x4c\x93\x27\x77\xe2\x71\x70\x5f\x36\x46\xb2\x40\xec\xe6\xf4\xc4\xc1\xa2\x8c\x3e\xb1\xbd\xe4\x7f\xfe\x27\x3c\x40\xff\xf2\x4f\x1f\x53\xb6\x8c\x8a\x94\x64\x74\x15\x02\x50\x3d\x2c\x71\x8e\x42\xb3\xe1\x0b\xc4\xd4\x6e\x7f\x78\x3c\xcd\x42\x06\x80\x5e\x7f\x56\xf1\xa6\xaf\x51\x8e\x66\x8c\x16\xdf\x15\x74\xa5\x00\x9d\xae\xd4\xe6\xf1\x76\x8e\x98\xa4\x78\x28\x0c\xb2\x94\xa5\xfb\x12\x89\x03\x30\x22\x83\x41\xf0\xa7\xa0\x9f\x24\x64\xbb\x0d\xdb\xc0\xcb\x02\xcd\x03\xb0\xdd\x06\x01\x18\xb1\xe2\xf1\xc9\xec\xbf\x1e\x24\x88\xe6\x58\xec\x98\x22\x54\xef\x87\x87\x24\x26\x9b\x3c\xaf\x66\xe2\x7c\x9a\x23\xdc\x13\x85\x15\x2c\xd0\x3c\xa7\x0f\xce\x38\xcd\x01\xa2\xf3\x79\x89\xd8\x0f\x88\xd3\xfb\x0a\xfa\x50\xc3\xf9\x0e\x71\x32\xa0\xa0\x42\x71\x6c\x41\x05\xcb\xcd\x7a\x4d\x0b\x56\x76\x7c\x66\x7a\xfb\x40\x69\x8e\x52\x12\x16\xa0\x82\xb8\xf4\x2d\x9f\x04\x0c\xd9\xed\x70\xba\xdd\x32\x10\x11\x9a\x09\x0a\x51\x41\x7e\x9e\x8e\x97\x68\xf6\xe9\x58\x50\xd9\xfa\x2b\x04\x09\xc4\x35\x0d\x2f\xf9\x71\xc4\xfc\x38\x2a\x0a\xbb\x2e\x28\xa3\xfc\xeb\x06\x71\x94\x44\x0d\xc3\x12\xc8\x5d\x2b\x12\x7c\x5b\x4e\x21\x4d\x08\xff\x2f\x4f\xe8\x60\x90\x46\x66\x94\x21\x05\x87\x01\x92\x7f\x07\x31\xff\x39\xc2\xf3\xb0\x4f\xd0\x43\xef\x0a\x2d\xc6\x5f\xd6\x61\x01\x22\x86\x4a\x16\xe6\x00\xb0\x65\x41\x1f\x7a\xbc\x6e\x5c\x14\xb4\x08\x51\xc4\xe8\xcd\x7a\xad\xc9\xe3\xde\x9b\xb8\x77\xb1\x16\x07\x3e\x78\xb3\x57\xee\xbd\x09\x7a\xeb\x82\x7e\xc6\x19\xca\x7a\x7c\xa0\xbc\x34\xe7\xa5\xf7\x1b\xd6\x43\x5f\xd6\x68\xc6\xac\x9a\x62\xef\x4d\x10\xbd\xe1\x14\x4d\x63\x66\x91\xe0\x10\x40\x14\xcd\x49\x84\x56\x9b\x9c\x13\x55\x7b\x23\x92\x12\xa6\x91\x77\x8b\x42\x30\x18\x84\x28\x42\x9f\x39\xe6\x97\x6b\x34\xc3\x69\x7e\xdb\xa4\x2d\xd3\x84\x84\x00\xc0\xb4\x0a\x01\x2c\x5a\x7c\x55\x23\xe7\x60\x80\x43\x6b\xad\x21\x02\x90\x88\x32\x48\x00\x64\x15\xa4\xce\x97\xe0\xc9\x82\x4d\xd4\x46\x49\x5a\x1b\xa2\xba\x06\x40\x7b\xfb\x66\x94\x94\xac\xd8\xf0\xd3\x97\x30\xc8\xa2\xbb\x3b\x51\x77\x77\x97\x20\x4e\x19\x2c\x74\x93\x44\x3c\x48\x73\x54\xb0\x00\x92\x84\x2f\xcd\x2d\x9b\x42\x9c\x3c\x1d\x9f\x5d\x5c\x8f\xe3\x60\x96\xd3\x12\x45\xf7\x65\xa4\x60\x44\xf1\x89\x2a\xcf\x9c\x8a\xd3\xe3\x1f\xef\x4e\x8e\x26\x47\x77\x47\x97\xa7\x1c\x00\xcf\x3e\x99\xfa\x48\x1c\xec\x74\x8d\x03\x3e\xc3\xa7\xa3\xb3\xf1\xd5\x24\xd6\xfd\x7e\x77\x74\x32\x8e\x83\x79\x9a\xa1\x00\x5e\xff\x70\xf1\x73\x1c\x94\x4b\xfa\x10\x34\xc6\xea\xb2\x5e\x4e\x7e\xa3\x3b\x85\x67\x09\xab\x34\x39\x31\xab\xa0\xf7\x9c\x44\x62\xa8\x89\x7d\x78\x58\xc2\xb6\x5b\xa7\x05\xc1\xce\x50\x22\xcb\x16\x88\x5d\x51\xaa\xc9\x5b\xc8\xc0\x48\x96\xab\xd3\x7c\xcc\xdb\x1b\x73\x54\x08\x11\xe7\xb4\x27\x52\x5c\xb8\x2c\x04\x7a\xa0\x2c\x04\xba\xf1\x02\xad\xe8\x67\xc3\x14\x11\xa8\x20\x89\x32\x5c\xae\x9d\xf1\x80\x27\x14\x49\xc0\x93\x94\xa5\xa1\x33\x2c\x18\x98\x05\x96\x1c\xa7\x9e\xb2\xa0\x58\x90\x34\x47\x9b\xb4\x69\x6c\x19\xf9\xc9\x71\xc8\x80\xc3\x37\x09\x97\xbd\x12\x14\x12\x70\x3b\x9c\x02\x88\xb7\x5b\xf1\x93\x01\xb9\x82\x25\x0b\x83\x28\xd8\xa3\x91\xd8\x3c\x05\x23\x46\xd0\x5a\x17\xcf\x20\x50\x24\x57\x0c\x47\x02\x83\x80\xc5\xac\x6b\x32\x49\x00\x24\xa2\x45\x67\xe1\x3c\xad\xf1\xa5\x18\x89\x4f\x25\xe4\x71\x9e\x96\x65\x48\x23\x8e\x3d\x00\x96\xdd\x87\x58\x7c\xb3\x4c\x4b\xfd\x01\x47\x3d\x70\x28\x4a\xb9\xb8\x50\x76\x8a\x0b\xa8\x66\x15\xd1\x5d\x86\x4a\x56\xd0\x47\xb3\x8e\x10\x81\x0a\x78\x49\x4a\x78\xf0\xcf\x43\x10\xcb\x9d\x6b\x7e\x25\xd0\xa1\x51\x9a\xb4\x58\x48\x86\x58\x3a\x5b\x86\xf5\x22\xa9\x15\x3c\xd1\x73\x0f\xb9\x3c\x12\xdd\xfd\xfa\x6f\x1b\x54\x3c\x9e\x12\x86\x8a\x79\x3a\xb3\xf0\xab\xa6\x3d\x62\x18\x88\xb7\xd6\x20\x01\x62\x9b\xb9\xcc\x04\xcb\x04\x8b\xa3\x1a\x5a\x88\x37\x2a\xb7\xdb\xb0\x4c\x38\x95\x66\x0a\xac\x05\xc4\x99\x03\x94\x44\x21\x48\x12\x2e\x62\x97\xb7\x64\x2a\xa1\x2b\x39\x40\x29\x76\x9e\xe0\x72\x85\xcb\x32\xf1\xb0\x58\x7b\xad\x85\x40\xbf\x96\x27\x4a\x9d\xaf\x90\x13\x39\xd1\x83\x6a\xb6\x82\x05\xa7\x9a\x9b\x3c\x87\xb7\x4f\x9f\xd0\x63\x1c\xfc\x34\xbe\xba\x3e\xbd\x38\x17\xa2\x4d\x9b\xab\x06\xb6\x6a\x17\x54\xd5\x94\xd3\xdb\xd0\xc2\xc4\x5a\x6c\xa0\x44\x2c\xb3\x4d\xd1\xe0\xd3\xc9\xe9\xf5\xc7\xd3\xeb\xeb\xf8\xcd\xad\x20\x66\x99\x9a\x89\xa2\x62\xd3\x37\x55\xa4\x20\x60\xda\x98\x6d\xc8\xd7\x2e\x05\x92\xf5\xdc\xb2\x69\x92\xb6\xf6\x4b\x57\x45\xc7\x16\xf1\x4e\x4d\x29\xa1\x9c\x9b\xe7\x78\xc6\x92\xb6\xb4\xa0\x5b\x25\xb0\xdd\x6e\x25\xf9\x51\xee\xa1\xfa\xf7\x1b\xc6\x28\x69\x90\xfd\xa3\xe3\xc9\xe9\x4f\xe3\x38\x48\x67\x0c\x7f\x46\x01\xfc\x70\x33\x99\x5c\x9c\xc7\xc1\x3d\x23\x01\xfc\xee\xe2\xf8\xe6\x3a\x0e\xe6\x74\xb6\x29\x83\x0a\x96\xc9\x93\x58\x9d\xc9\xc5\xf7\xdf\x9f\x8d\xef\x8e\x8f\xae\xae\x2e\x26\x7a\x79\x18\x5d\x2c\x72\xf4\x9f\xa6\x9b\xe9\x1b\x68\x01\xbb\x50\x1a\xa8\xe4\x50\xa7\xe7\x97\x37\x93\x38\xc0\x64\xbd\x61\x01\xd4\xe3\x89\x9a\x03\x8a\xf8\x88\x04\x33\xe9\xe4\x3c\xb2\xd1\x9a\xf5\xc8\xf1\xdf\x7d\x38\xbb\xb9\xb2\xc0\xc5\x6c\x3c\xe0\xbd\xfb\x7c\x53\xf8\x9a\xf9\xbd\xf8\x92\x9a\x7b\x6b\x63\xfa\x43\x48\xf8\x3f\x85\x3a\x95\xa6\xb9\x9a\x10\x97\x91\xb5\x96\x9c\x14\x73\x19\xab\x90\x0d\xd0\xf6\x67\x42\x0a\x2e\x23\xb1\xb2\x1a\x9a\x0a\xdd\x2e\x28\xd2\x0c\x53\x7e\x64\x69\x24\x04\x09\x5e\x13\xcd\xb8\xf8\x88\x32\x41\x2e\xdd\x96\x0c\xe9\xc4\x91\xdc\x19\x00\x18\x67\x21\x28\x2f\x91\xe8\x3d\x4d\x10\x17\xf0\x54\x8f\x0a\x86\x77\x99\xf2\xd6\x52\x97\x60\x9b\x46\x2a\x3c\x57\x4a\x2c\xe5\x5d\xd8\x0a\x01\x2e\xd3\xfb\x1c\x65\x5c\xce\x2f\x76\xd4\xd1\x68\xc6\x9b\x3c\xc3\x25\xe3\xf2\x0f\x4b\x31\x29\x9b\x5f\xef\x86\x50\x6a\xe7\xc8\x4c\x3f\xe9\xbf\x60\xfa\x10\x85\xb4\xa6\xcd\xc1\x6c\x99\x92\x05\x0a\x40\x45\x23\x81\x57\x21\xe0\x7b\x79\x50\x55\x64\x30\x70\xda\x8a\x4a\x47\x95\x49\x0b\x9c\xee\xaf\x0b\x54\x96\x28\x0b\xe0\x4b\x3a\x06\x90\x79\xf6\x47\xe2\x54\x73\x75\xbf\x4a\xec\x50\xe7\xd6\x2f\x77\xfc\x5e\x0c\xa7\xe6\x23\xba\xbb\x91\x94\x3b\x6c\x6e\xd3\x05\x0b\x31\x67\x3a\x72\xca\x8a\xeb\x60\xce\x75\x04\xc7\xf9\x23\x79\x03\x6d\xf2\x06\xe7\x3c\x2a\x42\x08\x1d\x89\xb3\xc5\xcc\x46\x9a\x32\x48\x6d\x77\xc4\xe5\x2e\x7b\x9b\x25\x9d\x03\x42\xf1\x15\x75\xf5\xd9\x57\x55\xc0\x43\xee\xa5\xae\xc6\xe1\xcd\xc2\x70\xd1\x44\x8c\xd9\x43\xfd\x9e\x1f\xb8\x92\xde\x2c\xd3\x52\x6b\x1c\xfc\x74\x8b\x11\xba\xc8\x27\xba\x83\x6f\xff\x53\x9e\x03\x4c\xc0\xe1\x3f\xbe\x95\x8a\x1f\x93\xa4\x06\x54\xff\x17\x19\xe2\xd2\xc3\x10\x67\x69\x41\x37\x25\xca\x39\x4b\xe4\x68\x56\xff\xc6\x09\x17\x7d\x09\xa4\x86\x55\xa6\xc9\x13\xe6\x4d\x7e\x4e\xf3\xf8\x9f\xd1\xb7\xf0\x13\x7a\xbc\xa7\x69\x91\xc5\xfd\x21\x2c\x73\x9c\xa1\xb8\x7f\x00\xd7\xe9\xa6\x44\x71\xb0\xa4\x9f\x51\x11\xc0\x87\x22\x5d\xc7\xfd\x61\x05\x73\xeb\xdb\x20\x24\x9b\xd5\x3d\x2a\xb6\xf7\x52\xe5\x07\x41\xdd\x54\xa0\xca\x02\xd5\x62\x10\xaa\x82\x6d\x29\xac\x8d\x20\xd0\x3d\x84\xb2\xc0\x6a\x44\x74\x66\x1a\xa8\xe0\x32\x79\x3a\x1f\xff\xfb\x24\x0e\x08\xfa\xc2\x02\x78\x79\x35\xfe\x29\x0e\x38\x4e\x06\xf0\x6c\xfc\xdd\x24\x0e\x72\x34\x67\x01\xbc\x3a\xfd\xfe\x87\x49\x1c\x08\xa3\x76\x50\xc1\x59\xf2\x74\x7d\x76\xca\xb5\x33\x31\x82\x60\x0f\x43\xfe\x5b\xfe\xe4\xbf\x7e\x1c\xff\x72\x72\xf1\xf3\x79\x1c\x7c\x42\x8f\x19\x7d\x20\xbc\xec\xe3\xc5\xcd\xf5\x78\x7c\x3e\x19\x5f\xc5\xc1\x8a\x2f\x20\xd7\x8a\x0a\x53\x73\x36\x3e\xe2\x0c\x5d\xd4\xe4\x28\xfd\x2c\x5a\x9d\x5c\xdc\x1c\xff\x20\xcc\x54\x8c\x6e\x66\x4b\x44\x44\xeb\x67\x17\x47\x27\x16\xa7\xce\x69\x2a\x34\x4e\xbd\x2f\x16\x67\xef\x94\x01\xda\xb0\x15\xdc\x24\x4f\xc7\x47\x57\x62\x2c\xb1\xb5\xe9\x4d\xd1\xc7\x99\xb9\x5e\x19\x0d\xbe\x8f\x19\x5a\xed\xcb\x75\x52\x2b\xe8\x56\xc9\xf5\x94\x6b\xee\xd6\xd8\x3b\xe0\xd6\xc8\xfd\x38\x9d\x8c\x3f\x36\x6a\x82\x0a\x66\xb5\x6c\x66\x64\x21\x59\x70\x27\x3f\x50\xa5\x91\xfb\xa1\x6a\xad\x59\xca\xc7\x75\x27\x87\x10\xb5\x47\x07\x7b\x91\x77\x60\xe7\x27\xa7\xc7\x47\x93\x8b\xab\x6b\xe7\x2b\x92\xe1\x59\xca\x68\x51\x06\x52\xc2\x53\xeb\x26\x05\x3c\xb1\x7a\x53\xd8\xb3\x7e\xed\x33\x3a\x55\xa0\x57\x1c\x52\x89\x82\x05\xce\x90\x75\x06\xa7\x6f\x2a\x38\xf7\xca\x59\x54\xd8\x4a\x94\xa4\xc5\x87\x57\x0a\xa6\xa4\xf8\x94\x3e\x59\x76\x99\x5c\x99\xb1\xc5\xc1\x34\x70\x79\xc9\x0f\x50\x96\xf4\x0f\x4c\xc9\x75\x8e\x33\x4c\x16\xa6\x48\x20\xa4\xb2\x10\xdb\xdf\xca\x4b\x8c\xda\x64\x20\xcd\x6d\x9c\xf2\xba\x0c\x93\x6b\x71\xb7\xc3\xa9\x19\x9e\x5e\xad\x71\x5d\xef\x91\xd6\xb2\xa8\x5e\x6d\xeb\xf3\x34\xcb\x84\x1e\xcd\xa5\x18\x44\x50\x51\x86\x40\x88\x98\xf3\x84\xb6\x45\xcc\x79\xc4\x37\xd3\x5e\xc3\xc6\x1c\xb5\x9d\x42\x6c\x4b\xb8\x8c\x38\x56\x80\x0a\xca\xef\x7e\x5e\x22\xf2\x13\x2e\xf1\xbd\x2b\xa3\xf6\x8d\x05\x78\x89\xb3\x0c\x11\x8f\x10\x82\xcb\x30\x88\x3f\xcb\x4f\x03\x30\x18\x04\x12\x32\xe8\x27\x1e\x89\xb6\x2c\xc3\x40\xc0\xe2\x1c\xb3\x47\x0e\x2e\x20\xf8\x08\x42\x31\x16\x8e\x7b\xaf\x98\x03\x47\x6a\xf9\x1d\xdf\x59\xd7\xec\xb3\xdd\x86\xcd\x7d\x1f\x6a\xf1\xa2\xbd\x01\xe6\x8c\xf0\xf5\x1f\x0c\x76\x58\x15\xc2\xd2\x7f\x55\xe0\xb6\x2b\xb7\x70\xf6\x38\xcb\x51\xd8\x1f\x02\x00\x67\x39\x4a\x8b\x53\x85\xaf\xa1\x8b\xbe\xc0\x87\xce\x7c\x5a\xe2\xfb\x67\xa7\x75\xd0\xfc\x7e\x30\x08\x5f\xdd\x1d\x70\x30\x3d\xaa\x9b\xea\xbb\xdd\x0d\x06\x8d\xd6\x92\x12\x31\xd3\x51\x7d\x65\x50\x6f\xf3\x35\x4b\x19\x3a\x34\x1b\x6d\xa1\x5a\x6c\x0a\x41\x74\x8f\xd5\x1a\x76\x0c\x04\x88\x7d\x66\xb4\xcb\x40\xe4\x3b\xfc\x5d\x9b\x6d\x91\x52\x21\xd0\x48\x29\xd5\x1c\xee\x53\x86\x56\xa7\x24\x43\x5f\x42\x4f\xa3\xd2\xc2\x1d\xb2\xf7\x16\x49\x52\x37\x0e\xfb\x07\xdb\x2d\x7b\x37\x04\x5c\xaf\x6a\x20\x2e\x68\x0d\x85\x12\x14\xce\x22\x4e\x3e\x61\x5b\xa6\xe1\xfa\x62\xc8\x40\x05\xa4\xae\x85\xe7\x21\x4e\x92\x84\x01\x5b\xd4\x16\x28\x1f\x02\xf8\x99\xe2\xac\x67\x61\x1b\x50\x17\x99\xec\x3d\x3e\x94\xa7\x3c\x96\x07\x65\x64\x9f\x9d\x12\x5a\xe3\xbf\x65\x53\x50\xf1\xe5\xf5\x29\x0e\xad\x81\xcf\xe7\x21\xe6\xc2\x5c\xa7\x42\x61\xa8\x62\x8b\x5e\x2b\x32\x6a\x95\x20\x0f\x9d\xf6\x10\x75\x83\xec\x4e\x99\xa6\xde\x2f\xa2\xfe\x2d\x5a\xac\x0f\x59\x4d\xcf\x7d\x6a\x0d\x49\x50\x84\xbe\x30\x44\xb2\xf0\xa9\x82\x29\x9f\x5c\x19\x35\xae\x5d\x38\x8f\x82\xb9\xb0\x67\xce\x3d\x64\xbb\x2d\x7d\x5a\x18\xab\xd0\x5c\xcb\x81\x1e\x0a\x4b\x49\x38\x8b\x94\xec\xe5\xb3\x51\xb2\xe8\x4e\x09\x64\x21\xe2\x62\xb6\x12\x41\x39\xc2\xd8\x3d\x08\x7c\x19\x0c\x42\x7f\xfb\xb5\x1c\xe7\xef\x42\x62\x1b\x52\xfa\x85\x82\x17\xd2\x9d\x1f\x5e\xe2\xa2\x1c\x0f\x25\x82\xad\x96\x2c\x2d\x58\x80\x49\xcf\xd0\x08\xfd\x87\xda\x91\xae\xb9\x6b\x79\xd1\x3e\x28\xcc\xe0\x3f\x73\x98\xf6\x60\x20\x28\x9f\xbe\xe4\x75\x2b\x9b\xc0\x89\xef\x3a\xd8\x3b\x0b\xf8\xcf\xc3\xe1\x1e\x6b\x13\xa5\x4a\x92\x25\xbd\x01\x49\xe3\x96\xbe\xff\x56\x58\xb6\xb6\x0c\x7d\x61\x69\x81\xd2\xb7\xd8\x28\x45\x42\xc1\x8a\x58\xba\x38\x4f\x57\x08\x80\xf2\x01\x8b\x4b\xca\xe8\x61\x89\x67\x4b\xf0\x34\x4b\x4b\xd4\xfb\xf6\x2f\x71\x5b\x97\x94\xf8\xcc\x4b\x43\x30\xba\x2f\x50\xfa\x69\x24\x81\xff\xda\x09\x2c\xf9\xab\x02\xd6\x0e\x0f\x72\x92\x95\x3e\x01\x86\xe8\xf9\xac\xb5\xf6\x71\x46\xd1\x2a\xfd\x84\x8e\x8a\x22\x7d\x0c\x85\xd1\x7a\x9d\x16\x88\xb0\xb0\x96\x65\x38\x59\x75\x48\x40\x84\x79\xc3\x17\x73\x61\x06\xaf\xbb\xfb\xf0\x78\x82\x0b\x24\xfa\x6a\xdc\x83\x29\xba\x9e\x24\x89\xa4\x60\x10\xab\x1f\x9c\x8e\xc1\xd2\x47\xaa\x11\x80\x45\xe2\xa3\xc9\x9c\x5e\x87\x78\x30\x18\x26\x49\x52\x6e\xb7\x64\x30\x28\x93\x24\x29\x80\xe1\x6c\x6a\x4f\xb9\x12\xa5\x09\x2c\x1a\x49\xe3\x5a\x58\xee\x85\x75\xc7\x87\xfb\x07\xf1\x01\x00\xff\x5f\xbb\x17\x25\x84\xed\x1f\x24\x49\x42\x0f\x1d\xca\xea\x19\xd1\x34\xb6\x21\xe8\x54\xac\x89\x92\x29\x38\x49\x6b\xdd\xab\x70\x09\xb8\x9b\x47\x81\x8e\x05\x79\x19\xf3\xe3\xcb\xa6\xef\x6b\x24\x3f\x1a\xc3\xa7\x02\xe5\x29\x43\xd9\x44\x20\x69\xcc\x60\xa6\xf7\x29\x26\x70\x5e\xd0\x55\x5c\x42\x46\x63\x5c\x01\xd7\x0d\xc3\xb6\x4d\xe9\xcb\x70\x00\x0b\x31\xbf\x12\xb1\x23\x41\x9b\x4f\x35\x21\xf6\xdd\x82\xd4\x9c\xb3\x49\xae\x6b\x4e\xd4\xae\x72\xa7\xe6\x9a\x1d\x37\xba\x74\x54\x0b\x0b\x9e\x36\xa2\xd9\x12\xe7\x59\x81\xc8\xad\x77\x89\xa7\x23\x21\xfb\x12\x10\xa5\x59\xd6\x68\x58\x1e\x21\xc1\x56\x7d\x7b\x06\x0b\x48\x61\x2a\x3d\x57\xf2\x97\x8a\x24\x70\xee\xdb\xd2\x1c\xc0\xbb\x84\x6c\xb7\xb9\xb6\x2f\xb6\x0f\x52\xc8\x38\x23\x5a\xf8\xbe\xbe\x03\x70\x95\x68\x67\x83\x86\x44\xc8\x0f\x49\x7d\xdc\x0e\x43\x9c\x6c\x22\xae\xe8\xc2\x22\xd9\xc8\x13\x48\x93\xa5\x28\x01\xb1\xa8\x14\x1a\xb2\xa8\x15\x47\x92\xd7\x8a\x22\x00\xef\xf8\x42\xdd\x59\xe6\xad\x4d\x6d\x3d\x6e\x69\x5d\x42\xbe\xe9\x71\x4a\xe9\xdc\xba\xd6\xa7\x20\xbc\x83\xd4\x7f\xef\x3a\x18\xe4\x83\xc1\x5d\x4b\x45\x48\xfa\x43\xb8\x52\xeb\x63\x58\x84\x14\x7c\xba\x30\x30\xbc\x93\xc8\xb1\x6e\x1c\x84\xe6\x39\xb8\xb3\xce\x01\x95\xe7\x60\xce\xcf\xc1\xa2\x02\xa3\xdd\xd7\x90\x1d\xf6\xdd\x8d\x3c\x6f\xe0\x30\x14\x2b\x66\x50\xab\xe0\x32\x86\x74\x4f\xe1\xbb\x86\xc2\xdc\xaa\xe4\xb2\x97\x03\x8d\x15\xc4\xee\x3b\x4d\x7e\x7e\xee\x1a\x16\xf9\xbd\xa0\x17\xec\x15\x3e\x9c\x96\x2d\xfa\xce\x91\xfc\x46\xfc\x8b\x85\x51\xd2\x51\xa2\xfd\xfe\x55\x86\x48\xa4\x1e\x0a\xb1\x06\x15\x1c\x76\x5e\xa9\xfe\xcb\x70\x08\x40\x1c\x76\x0e\xa7\xb9\x18\x75\xb9\x47\xc5\xef\xa4\x52\x6b\x00\x0c\xd2\x28\x29\xba\xaa\x20\xdd\x61\xf7\x66\xaf\xb6\x7b\x73\xc1\xb1\x21\x4b\x3a\xf5\x00\x8c\x02\x2a\x7c\x3e\x82\xc4\xf8\xc9\x0d\x06\xe6\x8b\x12\x32\x89\xa6\x45\x12\x48\x0b\xa0\x05\x77\xc8\xe2\x32\x12\x24\x88\x9f\xe4\xda\xaa\x4e\xa5\xcb\x5f\xd3\xae\x4e\xa4\x35\x5d\x5a\x23\xad\x66\x00\x96\x8a\x87\x39\x96\xad\x9e\x0a\x79\x93\x54\xfb\xf4\x99\x1a\x7c\x5b\x4c\x5b\x3e\x3e\x6f\xce\x69\x6f\x85\xd8\x92\x66\x3d\x92\xae\x50\xa6\x5d\x75\xde\x80\x11\x87\x0f\x41\x25\x7a\x2a\x2d\x7d\x33\xc4\xe6\xdc\x62\xbd\x19\xc2\xc8\x4f\xa3\x3b\x3e\xf8\xa3\x35\x3e\xce\xf1\xec\xd3\x0f\xd2\xb3\xb1\xa5\x0f\xe2\x1d\x3e\x10\x7c\x05\xc4\x02\x69\xe7\x26\x14\x62\x73\xa3\xc6\x4f\x6a\xe1\x9c\x4e\x6d\x38\x54\xbe\x50\xa9\xb3\x7d\x02\x58\xee\x5c\x73\x23\x61\x2e\xe9\xaf\xc7\xdd\x4d\x1b\xc5\x02\x30\xe2\x73\x4d\xcd\xc4\x85\x1a\xdf\xc6\x38\x6d\xe3\x2f\x00\x4c\x01\xcc\xd5\x18\x15\x42\xf1\xcd\xca\xa5\x3b\x90\x2b\xf6\x55\xe2\xbe\x9c\xfe\x86\x3b\x11\x28\x3f\x52\x2d\x76\x7c\xd4\x4b\xc5\xe5\x09\xed\xbe\x3c\x99\x35\x2f\x4f\xb2\xa8\x36\x1b\x72\xb6\xe9\xd9\x51\xbe\x9c\xd2\x0d\x55\x35\xe1\x58\x86\x5d\x8a\xa6\x9a\xbb\xe2\x54\xd4\x7b\x08\xb5\x11\x00\x8c\xe6\x1d\x6b\xcb\x20\xd3\xfb\x56\xd9\xb7\x14\x6d\x78\xef\x2d\xc5\xfc\x75\xb7\x14\x14\xb6\xdb\xad\xe0\xbc\x0a\x01\x9c\xf9\x6e\x29\x68\x9e\xa7\xeb\x12\x99\x5b\x0a\xf3\x1b\x9b\xdb\x09\x9a\x3c\xc9\xcb\x98\xb8\x3f\x84\x52\x18\x8f\x03\xe9\x16\xaa\xca\xeb\xbb\x05\x5d\xad\xef\x10\x34\x25\x0c\xc4\x45\x45\xed\x8f\x15\x39\x7d\xf1\xf2\x73\x59\x41\xdc\x9a\x1f\x84\xf5\x77\x89\x33\xd4\x2a\x3f\x19\x9f\xc7\xca\x18\xe8\xd6\x75\x9b\xef\x15\x88\x6d\xbe\x5f\xda\xa3\x0a\xe0\xf1\xc5\xd9\xd9\xd1\xa5\xf0\x55\xab\xdb\x93\x65\xa7\xe7\xdf\x9b\x52\x4e\xb2\x0c\xec\x49\x0d\x9c\xc9\x6b\x8e\x9f\x4f\x4f\x26\x3f\xc4\xc1\x03\xce\xd8\x32\x80\x3f\x8c\xa5\xb5\x7f\x89\xd4\x45\xc8\x46\x5b\xdf\xaf\xe3\x20\xe2\xfd\xc2\x5e\x64\x37\xbc\xc3\xb5\xc1\x8c\x6a\xfa\xa6\x82\x99\xd7\xa2\x8d\x1d\x8b\xb6\x25\x2e\xd8\x76\xe8\xda\xa9\xe0\x85\x06\x68\xc5\xc9\x84\x5a\xd6\x50\xd1\x3a\x87\x78\xbb\x2c\xd0\x3c\x09\xfe\xf4\x66\x8f\x45\x38\xdb\x7b\x13\x4c\x61\x27\xa8\xe5\xab\xeb\x7c\xf1\x06\xd4\x3e\xdf\x9c\xe1\x6d\x1c\x67\x05\x58\x24\xc3\x51\xf1\x0e\x1b\x35\x49\xc7\x0e\xd0\x84\x93\x7f\x98\x76\xd3\x6a\x0a\x46\x9c\x7a\xf5\x93\x44\x3b\x13\xcc\x71\xce\x50\xc1\x35\x4e\xed\xe0\xab\xc5\x60\x7b\xf2\xd1\x7a\x53\x2e\x43\x0a\x2a\x59\x25\x11\xbe\x69\x09\xe1\x65\x87\x66\x2d\x2f\x95\x02\x1b\xb7\x2c\x55\x0a\x54\x9b\x9d\xd3\x2c\x3b\x2a\x70\x7a\x44\xb2\x63\x8d\x50\x92\x55\xb8\xf6\xaf\xf6\xa0\x1a\x36\x4d\xb9\xbe\x6a\xf4\xf2\x87\x32\xed\x67\x09\x6e\x9b\xf6\x33\x8f\xf7\x48\xb7\x50\xb9\x94\x8e\x71\x72\x7a\xfc\x64\x86\xca\x27\x8d\x63\x72\x08\x2a\x98\x89\xbf\x3a\x8c\x52\xb5\x28\xde\x40\xcd\xc1\x60\x87\xa3\x82\xea\x53\x71\x55\x48\x47\x46\x8f\x93\x0b\x38\x18\x84\x61\xd1\xb4\x1c\x58\xf5\xc0\xa8\x5f\xa1\xf5\xa7\x96\xe5\xae\x01\xd0\x7b\xbe\xdd\x86\x85\x34\x57\x03\xd8\xe7\x2c\x3b\xa4\x89\xcd\x11\xc1\x60\x40\x5b\x43\xb7\xd8\xb7\x14\xef\x73\x39\xdc\x91\x8c\xa3\xf0\x8b\x84\x29\x80\xfd\xd4\xab\x78\x80\xa7\x42\x48\x29\x3b\x58\xb5\xa0\x88\x01\x80\x74\xbb\xb5\x46\x07\xe5\xc0\x85\x10\x37\xab\x8f\xf2\x09\x5e\x21\x52\x8a\x9d\x18\xb5\x86\x63\xcb\xbc\xcb\x48\x93\x34\x4b\xe0\x35\x85\xa7\xe7\xdf\x37\x6e\xa3\x54\x90\xc5\x6c\x9a\x0c\x3d\x38\xa9\x56\xb4\xd6\x4f\x1c\x84\xed\xe8\xf8\x04\x44\x29\x63\x85\xf2\x61\x41\x5f\xd6\x29\xc9\x84\x13\xcb\x50\xf5\xcd\x15\x00\x7b\xed\xc3\xfe\x50\x4e\x38\x6b\x60\xef\x73\x73\xe4\xd3\xf1\xcc\xd2\x9d\xba\x74\x01\x65\x9e\x19\x07\x01\x64\x9e\xc1\x1c\x08\x59\xcd\xb3\xdd\x12\x25\xce\x41\xc5\x91\xa2\x53\x99\x93\x88\x34\x4f\x82\x72\x56\xd0\x3c\x0f\xf6\xc2\xd9\xed\x70\xda\xf0\x63\x9f\x71\x41\x7c\x86\xc2\x03\xe0\xd9\x4f\xaf\x92\x96\xed\x50\x7e\xba\xb6\xd4\x29\xbe\x9d\x4f\xf7\x82\xf5\x97\x40\x4a\xd3\x99\x10\x00\xf9\x41\x5f\x3a\x36\x89\x97\x1e\xf4\x17\x9e\x73\x62\x9d\x27\x2e\x07\xec\x3e\x4f\x04\xc0\x3e\xe9\x38\x4f\x0d\xdb\x96\x7d\x20\x0c\x21\x71\x17\x00\x37\x16\x80\x73\x91\x0f\x74\x43\xb8\xa6\x77\x9c\x63\x44\xd8\x15\x9a\xb1\x10\xdc\x62\xb9\x30\xb5\x3e\xdd\xb8\xa6\x6b\x8d\xd7\x7f\xb0\x3a\xcf\xa1\x5b\xae\xf0\xb1\xeb\xac\x01\xcd\x29\x25\x5b\xec\x84\xb3\xf9\x64\x1b\xe8\x35\x8c\x33\x14\x9c\xb3\xb9\x7f\x9c\x2a\x51\xef\xe9\xea\x3c\xdf\x07\x40\x71\xd4\xce\x03\xee\x88\xaf\x2f\x3a\x7b\xaf\x3c\xf7\xf5\x51\x95\xd2\x25\xa8\x46\x1d\xa8\x11\x04\xdd\x6e\xe1\x87\x2f\x3b\x92\xb3\x1d\x47\x32\x9e\x49\x0d\x2b\x6b\x4d\xd2\xbd\xaa\xf5\x4a\x78\x8c\x7f\xf7\x3a\x07\x3d\x02\x3a\xaf\xcf\x94\x78\xb3\xfb\x42\xcd\x91\x0d\x9d\x0b\x34\x77\x68\xf2\x42\x2c\x7b\xd5\x85\x18\xe5\xa3\x33\xee\xad\xda\xba\xa8\x0b\xba\xee\xca\x52\x71\x57\x96\xb9\xa7\xdd\xa7\x35\x75\x52\xa2\x59\x24\x84\x78\x70\xa8\xfe\x88\x67\x91\x94\xe3\x75\xb3\x52\xa8\xf3\x13\x3f\x48\xc4\x5c\x47\xa5\x15\xc9\xe4\x11\xfb\xc0\x61\x48\x7c\x92\x23\xf4\x86\x34\xb6\xe1\xa2\x5f\xff\x8b\x0b\x08\x83\x81\xbf\x99\xdb\xe1\x14\x80\x98\x18\x93\xb0\xdb\xb7\xbe\x97\x2e\x92\x6e\xf9\x5d\x94\x2b\x14\x08\xde\xec\x79\x9a\x11\x22\x7a\xad\x9b\x13\x65\x6f\x2e\x9a\xca\xb2\x88\x5a\x62\x3b\x24\x5c\x2c\x16\x55\x1a\x42\x6d\x6a\x43\x00\xbc\x25\x53\xae\x37\xcb\x0d\xed\x6a\xa0\x61\x19\x97\x2e\xc0\xc6\x4a\xd6\x26\x51\x23\x62\xc9\x27\x0d\x77\x43\x8b\x5c\xc1\x3e\xee\xa0\x58\x18\x54\x15\xec\x18\xf5\xeb\xe2\x66\x8c\x13\xf7\xa1\x0a\x97\x91\x51\x85\xb0\x2d\x01\xbe\xca\x3c\x58\x9a\x38\x8c\x22\x29\x8d\x89\x30\x6d\x9c\xae\x52\x9b\x98\x7c\xb6\x41\xe5\x8c\x50\x0c\x06\xa9\xd1\x29\xde\x72\xe1\x7e\xcb\x19\xbf\x76\xc0\x04\xc2\xce\xa4\xd0\x87\x53\xe1\x42\x4a\xd1\xe8\x81\xeb\xa2\x22\x22\x18\xe8\x7e\x08\x2c\x00\x80\x6d\xfb\x62\xa7\xd5\xaf\xb8\x65\x2f\xb3\xfa\x31\x69\xf5\xe3\xf0\x9c\x7a\x0a\xa7\x5d\xfc\x87\x1b\xa8\xa8\x30\x50\xe1\x6e\x03\x55\xde\x34\x50\x39\x0a\xac\xe3\x1d\x1b\x1c\x89\x3b\xf5\x68\xb6\x29\xf8\xd1\x9a\x38\x37\xb8\x83\x41\x87\xd7\x2f\x76\xb6\x79\xa7\x61\x12\xb5\xcf\x65\xc3\x88\x05\xcb\x84\x69\x54\x39\xd4\x3e\xbf\xb1\x0a\xd0\x01\xa3\xac\xd3\xc4\x55\xba\xb6\xad\x36\xa0\xd7\xb6\x95\xbd\xce\xb6\x85\x61\xbb\xdd\x0a\x66\x55\x08\xe0\xc6\xfe\xac\x03\x95\x48\x0b\x8f\x82\x3a\xb7\x42\x56\xd0\x75\x46\x1f\x48\xaf\x40\xff\xb5\xc1\x05\xea\x5d\x52\x2e\x72\x47\xbf\x5a\xe9\x16\xd6\xba\x28\xa2\xc5\x02\x04\x40\x85\xad\x07\xfa\x5b\xe1\xda\x7b\x5f\x46\xf5\x6f\x2a\x5c\x7d\xb1\x38\x75\xd2\x98\x96\x27\x56\xf0\x6a\xf0\xed\xbf\x6e\xff\x3c\xdc\x7e\xf3\x97\x00\xc0\x65\xf2\x54\x1b\xbb\x82\x3d\xda\x30\x71\xf1\x12\xcb\x50\xa5\x7e\x69\xa3\x19\xff\x2d\xf0\x4c\x59\xbb\xcc\x6f\x9f\x19\x4c\x0f\xcf\xf2\x78\x55\xde\x1f\x16\xb4\x72\x38\xe8\x86\xbf\xb9\x74\xa1\x37\x6b\x3f\xac\x30\x88\x9d\x9c\x5e\x1f\x7d\x38\x1b\x9f\xc4\x75\x88\x84\x3d\x1b\x78\x72\x75\x71\x79\x73\x19\x8b\x95\xdc\xac\x03\xf8\x71\x7c\x7e\xa3\x7c\x64\x75\x8b\xfb\x2b\x44\x36\xda\x47\x96\xd7\x4b\x3f\x59\xb7\x5a\xf8\xc9\x0a\x03\xdb\x0e\x1b\x9a\xd9\x9e\xe9\x1b\xf8\xdd\xc5\xd5\xc7\xbb\xe3\x1f\x4e\xcf\x4e\xe2\xc0\x0c\xbe\x37\xa7\xc5\x4a\x76\x62\x95\x8a\x1e\x02\x78\x7e\xf4\xd3\x87\xa3\xab\xbb\xf3\xa3\x9f\xe2\x20\x22\xe9\xe7\xfb\xb4\xd8\x27\xe9\xe7\x00\xfe\x74\x7a\x7d\xfa\xe1\x4c\x5e\xb1\x5e\x37\xbf\xeb\xd5\x3f\x31\x43\xab\x98\x50\x16\x46\x7a\x2d\x80\xf4\xc8\x9d\x5c\x5c\xc6\x01\xa3\xeb\x7d\xe9\xc8\x02\x27\x17\x97\xca\x89\x79\xbd\x2f\x62\xf4\x3f\x5c\x4c\x26\x17\x1f\xe3\xe0\x9e\x32\x46\x57\x1a\x4c\x96\xca\xa8\x7c\x59\x21\xa3\xf6\xe7\xc9\x93\x8c\x32\x8f\x87\x70\x9e\x63\xe9\x2d\x7e\x67\x0a\x8d\xaf\xb8\xb2\xd4\xea\xf3\x03\x02\x09\x6d\xb9\x7b\x2f\xbc\x76\xc6\x54\x85\x14\x7b\xcd\x89\xf2\xa8\xbc\xc0\xc3\x15\x69\xd9\x93\xaf\x52\x5d\xfb\x11\x91\x8d\x26\x5e\xb5\x3b\xe1\xb9\x58\x6e\x05\x95\x21\x86\x66\x4c\x16\x19\x98\x2e\x97\xd6\x45\x92\xb6\xed\x5e\x0b\x8f\xdd\xab\x56\x5b\xb5\xd4\xaf\x77\x69\xa7\x81\x6a\x16\x69\x14\x07\x9a\xa4\xa6\x96\x98\xd8\xa4\xc5\x96\x82\x88\x8d\x7c\xc6\x57\xc0\x69\xd2\x18\x91\xd2\xe8\x4e\x78\x1d\xf1\x55\x29\x43\xc0\x85\x12\xc5\xe2\x9b\x9e\x14\x76\xdb\x95\xe5\x79\x21\xa5\x1e\x58\x6a\x1d\xda\x71\xa0\xe8\x17\x3b\xf4\x66\xea\x2a\xc2\x23\x57\x9a\x9a\x45\xf2\xe8\x02\xcb\xf1\xab\x35\x0f\x7d\x5a\x85\x5e\xb8\x03\x46\x5e\xf0\x03\x61\x74\xd3\x1e\xae\x06\x93\xd0\x43\x8f\x84\xd4\x42\x16\x58\xdb\x57\x05\x88\x42\x28\xf0\x2a\x87\xb0\xbe\x13\x92\xbc\x89\xea\xd3\x0d\x2c\xf9\x30\xb8\xa7\xd9\x63\xe0\x18\x11\x29\x09\x65\xd4\x81\x0c\xc7\x10\x88\x8e\x22\x42\xe9\xba\x69\x51\xd1\xa1\x5b\x0d\x5d\xb2\x1d\xb1\xe5\x5a\xbb\xdc\x95\xb2\xc5\x53\x85\x18\x50\x6e\x63\xbb\xc2\x6c\xad\xbb\xf9\xe7\xb0\x04\xd2\x6e\xb3\x78\xa5\x7e\x88\x3d\x66\x0c\x3a\x9f\x87\xd4\x17\xce\x65\x1f\x67\xf1\x5b\x19\x0b\xec\xdd\xd4\x76\x75\xc5\x50\x55\xd4\x72\xd8\xdc\x72\x21\x05\x2f\xa2\xcd\x3a\x4b\x19\xf2\x78\x6d\xef\xa4\x07\xcf\x77\x5b\xce\x96\x28\xdb\xe4\xe8\x46\xb4\x1f\x02\xde\xd7\x8b\x9d\x2b\x7d\x9e\x84\x4b\x29\xeb\x39\x0e\x8b\x9e\xc0\x63\x14\x95\x8c\xae\x2f\x0b\xba\x4e\x17\xa9\x6c\x59\xf8\x0d\x2a\x33\xbd\x1c\xc7\x2b\x54\x64\xe9\x55\x50\x8b\x55\x91\xea\xaa\xbd\x69\x4a\xd4\xef\x74\x31\xed\x6a\x69\x22\xb2\x42\x10\x3d\x30\x8b\x38\xfb\x49\xa7\xc0\xd9\x57\x91\xc1\x91\x85\x35\x02\xaf\x85\x1a\xb9\x11\x64\x81\xab\x44\x95\xe3\x28\xc8\xc1\xf4\x60\x2e\xf3\x74\xd6\x1a\x8a\x2d\xd4\x5a\xf3\xd7\x9e\x84\x90\x24\x59\x24\xf9\xe6\xc8\xca\x69\xd4\xa4\x69\x5c\x3d\xcf\xa2\xc9\xc5\x25\x7c\x01\xd9\x12\x5a\xb8\x80\x1e\x9f\x9f\x00\x10\xbf\xfc\x13\xc3\xc0\xf5\x12\xdb\x98\xfc\x12\xa3\x45\x9d\x51\x41\xca\x23\x41\x7d\x83\x65\x56\xc9\x22\x91\x9d\x36\x8b\xa7\x6a\x14\xe8\x3a\x4b\x37\xb3\xb5\x7e\x29\x3c\x1c\x92\x68\x4e\xbc\x88\xa9\x00\x5c\x47\x14\x53\x0a\x59\xa3\xa1\xd0\x54\x81\xed\xf6\x49\x28\xf9\xb1\x2e\x4a\x3c\x1d\x2b\x8d\xe7\x69\xad\x37\x3d\xae\x99\x80\x2e\x0a\x01\x5c\xd1\x0c\xcf\x31\x2a\xca\x58\x4b\x3b\x44\x0a\x35\x4f\x88\x08\x5e\x1e\x3b\x6d\xf3\x2a\x2b\xb1\x8b\x4b\x5d\xc4\x55\x8c\x69\x50\xe6\xb9\xba\x66\x8f\x39\x4a\x4c\x63\x7d\xf7\x8b\x4a\xa4\xb2\x68\x07\x0b\xbe\x4a\x7b\x27\xae\x73\x0f\xe6\xaa\x59\x5b\x45\x3f\x64\xc2\x56\xc0\x99\xba\xcc\x6a\x24\x72\x03\xf8\x7d\x72\x30\x24\xaf\x53\xbd\xc9\x2b\x55\x6f\x52\xab\xde\x8e\xc0\xd2\x72\x5a\x66\xdb\xed\xb7\x9c\x34\x4b\x5f\xe4\xc1\x20\x94\xfa\x43\x20\xca\x78\xe7\xdb\xed\x5f\x13\x53\x0f\x40\x9d\x28\xce\xbd\xe1\x73\xef\x82\xf9\x0a\x0d\x47\xe5\x3b\x6d\xd8\x19\x95\xda\xc8\x5d\x74\x12\x21\x72\x5b\x4e\x01\xa4\x09\x92\x7f\x99\xb5\xce\x9b\x82\x15\xaf\xae\x54\xa4\xbb\xbc\x6d\xa2\xf2\x68\x4b\x79\xaa\x68\xcb\x6d\x83\x41\x3f\x64\x7c\x6e\x52\x21\x4b\xf4\xdc\x06\x83\x97\xfa\x6c\x6f\xb7\x6a\x5d\xac\x6f\xed\x75\x19\x0c\x50\x1d\x7b\x5e\x40\x13\x54\x6b\x6e\x91\x6a\xfe\xcf\x35\x4b\x98\x2b\x0b\x80\x16\x0f\xe6\x00\xce\x3b\x32\xce\x84\xaf\xf2\xa9\xf7\x89\x47\xf3\x79\xb7\x7c\xc4\x17\x73\xb7\x00\x14\xcc\xd3\xbc\x44\x01\xc7\xe0\xcc\xb5\xe3\xd7\xc2\x4f\xe1\xad\xf0\x08\x3f\x52\x91\x86\x39\x50\xe2\x4f\x07\x32\xb4\x8d\x76\x70\x97\xab\x97\x31\xf8\x60\x41\xc6\x95\xa3\x17\x80\x64\xbb\x65\x8a\xd1\x9c\xd3\x0c\x89\xee\x94\x13\xd2\x8f\x52\xa7\xf6\x39\x96\x89\x98\x9f\x7e\xae\x31\x41\x6e\xf0\x76\xfb\x56\x06\xa7\x77\xa2\xc8\x60\xf0\xed\x37\x35\x46\x6c\xb7\x2f\x47\xad\xd0\xe7\xcb\xef\x13\x4c\x38\x81\xd2\x2a\x90\x96\xdf\x3b\x14\x1f\x73\x6f\xb7\x8b\xe7\x4b\x8d\x87\xf8\x15\x9d\x10\x6f\xb7\xdf\xfc\x25\xb1\x68\x83\x3d\x3f\xce\x30\xfb\x02\xa2\xef\x40\xf4\x2d\x5a\x61\x4c\x9f\xc4\xc8\x10\x8e\x62\x0e\xf8\x86\xca\x7b\x3f\xed\x30\xaf\x89\x84\x15\x46\xa0\x8f\xd2\xe8\xdb\x7f\xb5\x07\x53\xbc\x1f\x0e\x06\xc5\xfe\x3e\xfc\xf3\xd0\x29\x7e\x57\xfb\xde\x0f\x06\xc5\xde\x1e\x2c\xde\x0d\x07\x83\xb0\x48\x86\x00\x96\xb7\xc5\x54\xeb\x00\x55\xa5\xc3\xad\xec\x49\xd6\x99\x36\xea\x31\x37\x33\x72\xb8\x89\x21\x64\xce\x14\x50\xe9\xdd\xa8\x33\x46\x08\x5a\xa3\xdc\xf3\xd2\x3f\xdc\xfa\x39\x6f\x02\x72\x41\xb1\x0b\xf8\x4e\x98\x4a\xd3\x6e\x53\xe9\x32\x6a\x9a\xa1\x1a\xc6\xd2\x45\xc7\x49\xea\xfe\x9a\xcb\x5a\xcf\x7d\xe6\xda\xc9\x84\xe3\xb1\x68\xcb\x32\x70\xf1\x26\x6a\x7e\xe6\xfd\x6e\x87\x5d\xf7\x85\x27\x6d\xd1\xe9\xf0\x21\x8f\x4d\x23\x05\x83\x67\x00\xb5\x31\xab\xd1\x7f\xab\x33\xcb\x54\xdb\xee\xd6\x6b\xaa\x5d\xbc\xce\x54\x9b\x7a\xa6\x53\xc1\x45\x15\x02\xd7\x6b\x4d\x59\x50\x57\x34\x4b\x65\xa6\x84\xe8\xbe\x8c\xd4\x2f\xc7\x03\xf1\x3e\x9d\x7d\xca\x0a\xba\x8e\xfb\x43\x27\x3d\x82\x38\x0a\x22\x4f\xc2\x92\x3e\x08\x13\x57\x6a\x01\x7b\x92\x1c\x78\x12\x22\xc8\x36\xac\x04\x09\xbc\x29\xcb\xfe\x95\xdb\xa6\x59\x6b\x80\x6d\x27\x44\x55\xe1\xfa\x39\x5a\x85\xb6\x93\xa3\x2a\x16\x99\x2d\x4e\xcf\x55\xea\x20\x6c\x57\x5d\x8d\xaf\x4f\xff\x63\x1c\x07\x05\x2a\xf1\x7f\xdb\x1d\xab\x9d\x57\x29\xa4\x94\x65\x57\xa5\x90\xb2\xc0\xcc\x81\xd0\x80\xda\xa8\xdb\x06\x15\xe1\x76\x1c\xe3\x35\xa8\xe0\xdd\x9b\x75\x17\xa8\xdb\xae\x00\xee\x68\xb9\xd3\x0c\x2d\xea\x9b\xae\x98\xc7\x57\x17\x67\x67\x1f\x8e\xae\xee\x3e\x8e\x8f\xae\x6f\xae\x64\xd6\x87\x2c\xcd\xf7\xa5\x43\xcc\x7d\x5a\xec\xaf\x50\x5a\x6e\x0a\x14\xc0\x0f\x47\xc7\x3f\x72\x4d\x4d\x83\xe8\x5d\x0f\x20\xd7\xc1\x74\x29\x5d\x23\xb2\x23\x2b\xa0\x30\x4e\x1f\x9d\x5d\x7c\x1f\x07\x72\x44\xfb\x19\x4e\x73\xba\xdb\x0b\x53\x4e\x4d\x27\xa1\xea\x4a\xe5\x65\xa0\xbe\x3b\xfd\xf7\xf1\xc9\xdd\xf1\xc5\xf9\x64\x7c\x3e\x89\x83\x68\x8e\xbf\xa0\x6c\x9f\xd1\x35\xec\xa9\xbf\xa5\xe1\x16\xf6\x22\x5c\xee\x8b\x12\xd8\x8b\x4a\x86\x67\x9f\x1e\x39\x58\x00\xaf\x27\xa7\xc7\x3f\xfe\x62\x35\x61\x57\x2a\x9b\x95\x1c\xea\x55\x6d\x95\x96\xa3\x2d\x84\x3d\xfc\x59\x47\xd1\x97\x26\x20\xd0\x06\x5e\xb9\x4a\x96\xae\xce\x05\x01\xbe\x8e\x56\x7e\x01\xbd\x21\x8d\xa0\x5a\x7e\x00\xec\x1c\x09\x1f\x68\xf6\x78\xf1\x19\x15\xf3\x9c\x3e\x38\x3e\xaa\x78\x41\x68\x81\x3e\xa8\x56\x84\x0b\x77\x5d\x49\x0b\xbc\xc0\x24\xcd\xf9\xd7\x97\x69\x26\x42\x30\xb4\x1b\x9a\x41\x96\x9f\x71\xc6\x96\xc9\x50\x98\x80\x37\x3e\xd7\xc7\x4d\xcb\x04\xdc\x0a\x4a\x94\x03\xee\x70\x77\x64\xa0\x82\x9b\x86\xbf\xa3\x1b\x37\xbe\xcb\xe1\xd1\xe9\x01\x3c\x7d\x55\x8c\xcf\x52\xa6\x1d\xac\xc3\xe6\x9b\x1e\xbf\x43\x73\x4f\xe8\x78\x27\xb6\x82\xf0\x2a\x8f\x37\x99\x49\x13\x08\xdc\xed\xdb\x6e\x71\x97\xe6\xd0\xd8\x66\xbd\x25\x22\x0d\xd6\xb5\xde\x17\x3b\x66\xaa\x5d\x98\x66\xbf\x6e\x4a\x76\x22\x30\x4c\x04\x3f\x18\xe5\x83\x2b\x1a\x8e\xf7\x0e\x3f\xe9\x56\x5b\xe3\x72\x96\xae\x55\x60\x97\x55\x7c\x25\x08\xa8\x2e\xf6\x99\xee\xcc\x35\xad\x4a\xba\x37\x8b\xec\xa3\x0d\x3d\xd8\x41\x24\x32\x30\xc1\x4b\x43\xfb\x50\xa8\x06\x5b\x74\xd2\x8d\x2c\x20\x0d\x0f\x21\xf5\x41\x4d\x83\x61\x23\x7b\xa3\x9d\x8e\xdb\xfa\x98\x6b\x1f\x1d\x47\x65\x28\x2f\x65\xd5\x32\x2c\xe9\x83\xae\xf7\xc4\x4e\x11\x09\x61\xe5\x93\xe4\xda\x12\xdc\x34\x5c\xfc\x5a\xb8\xcd\x7c\x17\xd3\xb0\x0b\xe3\x1b\x08\xdf\xc4\xcb\xe7\xbd\xfc\x9a\x88\x38\x18\xf4\xfd\x98\x68\xc5\xed\x29\x7a\x33\xd2\x52\xfe\xd7\x1f\xb2\x51\xb1\xeb\x98\xbd\x1a\x0d\x2d\xe9\x77\x3e\x0f\xf3\x48\x89\x02\x1e\x0c\xf5\x79\x01\x7a\x4d\xf2\x0d\x3c\xf6\xa0\xa6\x00\x6a\xe1\x26\x80\xc5\x0b\x1d\xd7\xbc\x47\xe1\x8e\x63\xc9\x47\xce\xef\x04\xe6\xf8\x5d\xdb\xbe\x1d\x9a\xec\xa5\x35\xb8\xd0\x55\x36\x5f\x91\x51\x4e\x72\xd7\x3a\x96\x07\xea\xd5\x74\x19\x56\x83\x15\xc9\x05\xd8\xe1\xf0\xe6\xb9\xca\x50\xac\xce\x2a\xd9\xc5\xd8\x9c\xa2\x26\x6b\xb3\x2b\x3d\x27\xd6\xaa\x6e\xb0\x30\x79\x17\xb2\x51\xe9\xf6\x6f\x3a\x6e\x44\x5c\xc2\xc9\xe1\x5f\xed\x6a\xb7\xcb\x9f\x6e\xe3\xd0\x88\x0e\xa2\x20\x8c\x26\xbf\xe1\x88\xb9\xd7\x64\xb5\x21\xa5\x99\xf2\xb0\xae\x31\xe9\xca\x93\x24\x11\xbf\xc7\x67\xe3\x8f\xe3\xf3\xc9\xdd\xf9\xc5\xc9\x78\xbb\x75\x98\x47\x94\xae\xd7\x88\x64\xc7\x4b\x9c\xfb\x33\xea\xb8\xce\x9e\x02\x2f\xf3\xf4\x31\x09\xee\x73\x3a\xfb\x14\x34\x60\x24\x82\x36\xcd\x58\xca\x61\xa3\xd5\xa0\xd8\xd1\x09\x5d\x27\x43\x88\x07\x83\xaf\x71\x18\xb6\xdd\x7f\xb5\xed\x9a\x6b\x0d\x66\x6d\xc8\x9c\x16\x33\xf4\x9d\xb4\x36\x28\x82\xe7\xb2\xfd\x73\x0f\xdf\xb7\xd3\x84\x83\x27\xd2\x6c\x9b\xb4\xef\x32\x89\x37\xb2\xc8\x65\x6a\xf5\x05\x73\x35\xc2\x87\x6d\x16\xe9\xa1\x2d\x74\x17\xe5\xa0\x0a\xa3\xed\x49\x76\x5e\xce\x75\x91\x56\xc9\x9a\xd5\x2f\x68\x1f\x09\xfd\x45\x92\x24\x44\xb1\xda\xed\xb6\xf6\x26\x76\x8a\x5d\x37\xe3\x65\xca\x19\xb2\xe6\xce\x3a\x8e\x84\xb5\x56\xad\x92\xe3\x77\xf9\xc3\xce\xdc\x2d\x86\xc9\x39\x5b\xae\x35\x58\x1f\xc1\x0e\xf3\xa8\xa1\xf5\x39\xb7\x91\xc2\xec\x84\x8c\xdd\xdd\x9b\x15\x59\xca\xb8\xa0\xd2\xb4\xda\x88\x7c\x1d\x0c\xa7\xd1\x9f\x99\xa4\xc5\xed\x5e\x32\xc9\x43\x27\x28\x33\x8f\xa4\xf2\xeb\x4f\xfe\x62\x53\x41\x91\x03\x26\xb6\x3e\x16\x83\x92\x5f\xcb\xb1\x18\x66\xb3\x73\x18\x1d\xe7\x9e\x50\x82\x9a\xc7\xde\x63\xba\x56\x67\xbe\x8e\x53\xe9\x8e\xbc\xeb\x12\xc4\x5a\x62\xae\xcb\xf4\x95\xa4\x1b\xdd\x15\x88\xf7\x2f\x28\x3d\x07\x16\xae\x05\xaa\xd4\x16\xa5\xbb\xc2\x50\xb4\x6f\xbb\x5c\x1b\xd9\x87\x1e\x4e\x9b\xa3\x68\x4e\x67\xb9\x77\xd4\xac\x54\xa7\x0c\xf7\x71\x45\x60\xd8\x45\xbb\xf1\x26\xbf\x78\x96\x29\x1c\xca\xff\xe3\x20\x18\xd9\xa9\xaf\x3c\x67\xc3\x0c\xee\xe9\x59\x69\x0f\xd7\x6d\x99\x91\x77\xbd\x40\x93\xe1\xcf\x41\x73\x9a\x32\x03\xf0\x79\xba\x42\xc9\x32\xd2\xb6\x08\x4e\xd9\xdb\x0b\xe5\xa6\x52\x68\xd5\x0a\x96\x34\xa1\x8d\xfd\x7f\x91\x9e\x62\xaf\xa9\x5f\x0d\x38\xec\xd2\x0e\x0e\x62\xad\x52\xb4\xbd\x4e\x07\x83\x30\x28\x59\xca\xf0\x4c\xa4\xc2\x6d\x2d\xef\x61\x9b\x27\xc4\xa4\xa6\x1c\xb0\x68\xf1\x37\x33\x5d\xdf\x02\x34\x39\x5c\x5f\x67\x22\x93\xee\xc7\x3a\x6b\x8e\xcc\x44\x66\x45\xb1\x59\x52\x9d\x8f\x9d\xb0\x1d\x69\xf4\xab\x46\x62\x90\x06\x3a\xd5\x78\xd4\x85\xf6\xae\x37\xb9\xb4\xe0\xbb\x5c\xd4\x3d\x5b\xa1\xc8\xb1\xcc\x42\x50\x7d\x5d\x2e\x8f\x65\xfd\xbc\xc0\x4b\xe6\xde\xc5\x4a\xc5\x13\x02\x54\xe7\x45\x50\x23\xe2\x47\xd5\x96\x1d\xfd\x84\xb2\x29\xca\xc8\xd7\x6b\xde\x77\xdd\x0c\x46\x33\x11\x27\x25\xa1\x46\xfd\x0e\x91\x78\x30\x60\x46\xa7\x72\x49\xf0\x5a\x1a\x75\xce\xd0\x5c\x77\xef\xca\xc4\x22\xee\x0a\x74\x89\xda\x83\x41\xff\x99\x86\xaf\xf8\xb8\x76\xb4\xac\xe8\xa3\x4b\x6c\xdb\x14\xb2\x7b\xd0\x41\x8b\x6f\xb4\xbb\x0f\x02\xd1\x8d\x6b\x1d\x69\x2f\xbf\x2b\xc3\x76\xc4\xa2\x8d\xba\xb4\x0e\x16\xe5\x68\xce\xf6\x58\x24\xbc\x67\xdf\xa9\x27\xb8\x30\x21\x48\xce\xd7\xaf\x72\x18\x53\xe0\xb5\x53\x1e\x1a\x06\xbf\x63\xbc\xc6\x3a\xe0\x1f\x11\x3f\x55\xb3\xc8\x31\x8b\x36\x1d\xd3\xc5\xeb\x42\xfa\x2e\x4f\x5c\xad\x7a\x96\x0f\xca\x04\x1b\x32\xef\xa7\xaa\x50\x2e\xc2\x9c\x48\x60\x9d\xe5\xdb\xad\x82\xa5\xf7\x0b\xb8\x4e\x8b\x12\x7d\x97\xd3\x94\x85\x05\xd8\x63\x1d\x58\xc1\x09\xd8\x2c\x72\x0d\xb2\x2f\x1e\xfc\x2a\x2d\x16\x98\xb4\xc7\x2e\xcb\x7d\x43\x77\x6a\xcc\xc8\xdd\x52\x67\xe0\xfb\xbb\x07\xee\x1a\x8b\xff\x37\x0d\xbc\x7b\xc5\x55\x66\x2d\xf7\x10\x78\xb0\x81\x4b\x12\xc6\x17\xc1\x8f\x12\xba\xda\x8b\x17\xe4\x59\xbc\xc0\x60\x6f\x07\xc1\xa8\x29\x86\xf7\x68\x3c\x8b\xf3\x56\x1e\x36\x71\x17\xec\x1b\x24\x18\xf9\x82\xc3\xb0\x8a\x62\xf2\x0d\x1f\x03\xdb\x90\xd2\x6c\xce\x8b\xcf\x7b\x01\xec\x05\x7b\xcf\x21\x8b\x7f\xb8\x0d\x8c\x78\x76\xb4\x2e\x46\x34\x06\xeb\x36\x56\xe9\x88\x8b\xdd\xfb\xe8\xef\xd4\xf5\x54\xf1\xac\x13\xdb\xbd\x4e\xca\x98\xe2\x12\xc3\x1d\x94\xda\x27\x41\x8e\x98\x23\x34\xb6\x6f\xbb\xe0\x0e\x63\x05\xd0\xcf\x4a\x75\xd1\xff\x48\xe4\x1b\xd9\xd7\x8c\x57\x0c\xd0\xa4\x78\x70\xda\x55\x12\x8c\x6a\x17\xa2\x67\x62\xcd\xe0\x0b\x1e\x61\x28\xdb\x8f\x30\x68\x0b\x5d\xe1\x58\x99\x70\xc3\x3d\x15\x3c\x1f\x80\x56\x3f\x19\xa4\xe2\xc9\x0a\xcf\x33\x0e\xea\xba\xb1\x7c\x9d\x8f\x5b\xf9\x4a\x1f\x37\x0e\x1f\x12\x25\x3d\x15\xe2\xe2\x47\x24\x73\x5e\xd2\x07\x5e\xfc\xbf\x36\xea\x6c\xd6\xe9\x9d\xa0\x3d\x9d\xe4\xc2\x3e\x17\x42\x56\x28\x67\xa7\x42\x38\x3b\x8d\x2c\xc7\x95\xe6\xb6\xd7\xe1\x63\x6e\x52\x2b\xd2\x95\xd4\x6a\x14\x1c\x05\xda\x61\xdb\x04\xbd\x05\x47\x57\xe3\x56\xb1\xf0\xb0\xf2\xc5\xc2\xa5\x72\x28\xf2\x3a\x45\x5c\x72\xb9\x7e\x10\xfe\x2b\xab\x54\x7d\xa0\xfc\xc4\x1c\x8a\x8d\xdb\x09\xc8\xb1\x65\xcf\x01\xa3\xcd\xae\x57\x33\x64\xb8\x82\xed\x6d\xd1\x06\xf7\x7a\x5b\x6c\x5e\x1b\x18\xd7\x6e\xb7\x82\x9b\x2a\x04\x6e\xd6\xfb\xaf\x09\x8c\x63\x94\xe6\x0c\xaf\xcb\xaf\x09\x8c\x53\xdf\xca\x27\x2f\xee\xcb\xc8\xfc\xb6\x9f\xbe\xb0\xe3\xe1\xc2\xff\xdc\xfe\xed\x6f\x25\xb8\x2f\xf7\x15\xe8\xdf\xfe\x76\xbd\x17\xc0\x60\x11\x08\x9f\xcc\x94\xe0\x95\xf0\x60\xb1\x9c\x35\x18\x5a\xad\xb9\x9a\x13\xeb\x53\x0f\x19\x66\x39\x6a\xa5\xa0\xb2\x03\x9c\x94\x35\xa4\xfe\x24\x43\x79\xfa\x58\x87\x44\x49\x4a\x04\x02\xb8\x64\xab\xdc\x76\x0c\x51\xe7\xc2\xf7\x46\x46\xed\x92\x6c\x2a\xad\x1e\xfd\x41\x57\x20\x80\xca\x81\x13\x15\xed\x01\xd7\x6d\xcf\x53\xce\x72\x66\x9f\x2e\xdb\x7d\xa4\x22\x9b\x8a\xf4\x9e\x38\xba\x99\x5c\xc4\x41\xba\x61\x54\x04\x8f\x89\xc8\xb1\xc6\x2b\x1c\x8d\x18\x32\xe7\xbd\x0e\xe1\x0c\x51\x2f\x71\x7f\x58\xaf\xed\x9b\x77\x19\xfe\xdc\x13\xbc\xab\xde\xd5\x5e\x41\x73\x54\xff\x7c\x6f\xc3\xa4\x45\x41\x1f\x82\xf7\xef\xde\x66\xf8\xf3\x7b\xcf\xc7\xfb\x42\xfb\x30\x00\xe2\xdf\x37\xf5\xbe\x88\x0c\xd3\x3d\xe9\xe6\xa6\xf7\x53\xef\xd2\x50\xee\x8a\x48\x06\xa9\xb6\xa3\x7f\x60\x2f\xbf\x98\xb5\x09\x7d\xab\x17\xb8\x7f\xe0\x5b\xc8\x79\x8e\xd7\x32\x64\xd0\x0e\x48\xbc\xb8\x99\xc4\x01\xdd\x30\x19\x99\x67\x07\x66\xe2\x56\x60\x26\x76\x02\x33\x71\x23\x30\x13\xc3\xd3\xf3\xeb\xf1\xd5\x64\x7c\x12\x07\x98\x94\xa8\x60\x48\x3c\x47\xe2\x86\x6b\xe2\x96\x1f\x90\x29\x13\x43\x11\x85\x7c\x3c\x5f\xf7\x24\x8a\x88\x06\xec\xf4\x81\xb9\x4b\x9e\x26\x17\x17\x67\x93\xd3\xcb\x38\xa8\xcf\xa8\x2a\xba\x3b\x3d\x3f\x17\x5e\x25\xee\xce\xc1\xa3\xab\x2b\xde\x44\x24\x77\xba\x82\x8b\xe4\xe9\x87\x8b\x9f\x38\xa4\x7a\xa1\xc6\x79\xdf\xcd\x9d\x2e\xfc\x78\x74\x7e\x73\x74\x16\x07\xab\x94\x6c\xd2\x3c\xa8\xe0\xaa\xf3\x71\x0e\x54\xdf\xe4\x8e\xa5\xbf\x7d\xed\x54\xc0\x54\x9a\x6d\xfd\x5b\xf4\x2c\x9e\x03\xa8\x95\x6f\x99\xb0\x7d\x22\x31\x2b\xd1\xe1\x32\xed\x00\xc5\x86\x83\xcb\x33\xe1\x8a\x0c\x3b\x97\x7f\x25\x6a\x45\x1c\xae\x7c\x8f\x68\xac\x22\x19\x33\xe0\x7b\x82\xa2\x9e\x5d\x05\x57\xda\xe5\x76\x27\xe0\x01\x07\x94\x5c\x56\x97\xed\x02\x6f\x14\xd4\x1f\x77\x64\x48\xd6\x80\xa0\x4e\xa6\xa0\xf2\x4c\x38\x21\x42\x5c\xae\xf8\x71\xfc\x8b\xb4\xe3\xba\x66\x44\x93\x36\xcb\x7e\x87\xab\xd1\x40\xf3\x9b\x3a\xac\xef\x44\xbd\x55\x5e\x07\xf6\x75\x76\x20\xd2\x8d\xe2\xc6\x66\x47\x33\x69\xe8\xf4\x97\x73\x70\x5c\xfe\x8c\xd9\xf2\xc8\xae\x0c\xc1\x21\x8e\xee\xc4\x89\x0a\xc5\x06\x63\x10\xe3\xe8\x4e\x1c\x24\x5d\x60\xbc\x78\x95\x2d\x6e\x81\xd8\x04\xaf\x4d\xb8\xaa\x65\xb4\x9b\xab\x7c\x46\x8e\x01\x53\xcc\xcf\x6a\x50\x4a\x56\xfa\x06\xcf\x74\xcc\xd4\xbb\x94\x2b\xdf\xe5\xb8\x9b\x84\xde\x3e\x0c\xbe\x97\x13\x9c\xbb\x70\xdf\xe6\x19\xab\xac\x73\xb7\xd3\x02\x1f\xff\x34\x3e\x9f\xf8\xe1\xeb\x18\x24\x25\xff\xc9\x10\x00\xd7\x63\xb2\x3e\x3c\xc6\xd2\xc9\x70\xfb\x22\xa1\xc6\x59\x3b\xc9\x4c\xfb\xd1\x1c\xeb\xb8\xb7\x5e\x68\xd0\x07\xfe\xb7\x87\x20\xba\xe4\xa1\x2e\x69\xf9\x0e\x68\x9a\xc0\xb7\x6c\x77\x06\x3c\x79\xab\x94\x98\x17\x6c\xdc\x07\x6c\xd4\xdd\x53\xd0\x7e\xd1\x3a\xb8\xcc\x51\x5a\xa2\xde\xa6\x44\x3d\xde\x43\x8f\x92\x9e\x92\x4c\x7b\xaa\x8d\x32\x68\xba\x7b\xb5\x77\x51\x3c\x3c\x6d\x7c\xee\x45\xbd\x3c\x09\xc7\x94\x30\x81\xc2\xb5\xa7\x8e\xa6\x01\x4f\xcd\xa1\xd6\x1e\x39\xe6\x9e\xd9\x04\xa3\xd8\x90\x11\x7d\x20\xa8\x38\xe9\x30\x12\x3b\x8b\x2b\x53\xe7\x76\x48\xe9\xe6\x1a\x40\x49\xfa\xbe\x93\x07\x73\xa9\xbf\xdc\x9c\x9e\xb4\xa7\x7d\x7e\xf4\x71\x0c\x46\x69\xe3\xf6\x0e\x67\x01\xd4\x6f\xd3\xec\xb8\xdf\xcb\x50\x39\x2b\xf0\x3d\xca\xee\x1f\x6b\xf8\x52\xb0\x05\x66\x79\xf9\xa8\x6b\x12\x23\x44\xa9\xcc\x92\xe6\x82\x63\xae\x9c\x1c\xf8\x14\x96\x49\x57\xb0\x9d\xce\xb0\xa3\x85\x94\x43\x6f\xa9\x4a\xbd\x21\xf2\xab\xb8\xcb\x18\x7b\xe1\xa1\x95\x12\xf0\x88\xb1\x74\xb6\x14\x8b\xb6\x54\xa4\x27\xcd\xb2\xba\x54\x05\x66\xe8\xcc\x7a\xfd\x03\xfd\xd8\x87\x6a\xd4\x08\x55\x87\x8e\x61\x41\x07\x3d\x36\xa1\xc0\x48\x2c\x43\x66\x08\x92\x97\x81\x30\xf9\x3e\xe3\xd7\xa3\x11\xa7\x25\xdb\xad\x5c\x71\x73\xa7\xd6\x26\x55\x1a\x73\x3b\x0e\x86\x16\xd7\xbc\x01\xe8\x0e\x39\x4d\xa1\x15\x88\x38\xf3\x44\x1d\xea\xff\xed\x45\x91\x45\x95\x8a\x47\xbc\x47\xcb\xf4\x33\xa6\x85\x03\xd2\x92\x52\x2b\x28\xa4\xac\xf8\x49\xf5\x1c\xdf\x45\x42\xfc\xaa\x2a\x48\xc9\xb1\x30\x34\xc5\x6e\xd0\xb1\xf6\x8e\x35\x4d\xf4\x93\x04\xd5\xb8\x30\x18\x98\x37\x94\xa5\x3a\x67\xe0\x8e\xc5\x9b\xa3\xe2\x49\x12\x4a\xe4\x0d\xbb\xd3\xf4\xf3\xdf\x09\x8b\xa2\x8b\xf4\xf2\x56\xef\x37\xc7\x94\x75\x87\xdc\x8f\x64\x8e\x07\x27\x41\x5c\xfb\x2c\xb2\xe8\x6e\x8e\xbf\xd4\xf7\x61\xd6\xbb\x99\x16\x2f\x19\xb1\x36\x67\xe1\x82\x47\x1b\x7f\x3a\xa8\xea\x39\x80\x24\x49\x92\x4d\x74\x71\x33\x11\x9d\xda\xbc\xfe\x45\xd7\x7f\x82\x29\x5a\x42\x84\x73\xf3\x27\x2a\xbd\x77\x7e\x8b\x8e\x3b\x3f\x1a\xdd\x59\x90\x27\x37\x57\x47\xfc\x0f\x10\x2f\x42\x29\x5f\xec\x70\xf1\x54\x86\xa1\x16\xa1\x2d\x9e\x63\x2f\xc2\x95\xb3\xe5\x58\x54\xaf\x6b\x9f\x2f\x10\x5f\xad\xc1\x00\x3b\x5e\x5e\xf6\x2f\xc7\x50\x89\x85\xdb\x11\x17\x7c\xc8\x04\xaf\xe5\xd2\xf0\xa2\xdd\xce\x58\x36\xe1\x06\xc2\x43\xa9\x9d\x39\xd2\x3f\xfc\x93\xf1\xb9\xc9\x22\x40\x6a\xb1\x81\xf8\x64\x06\x75\xb1\xdb\x45\x69\x0a\x00\xfd\x29\x36\xb6\xdb\x50\xd8\x98\xec\x2b\xe5\xdf\xed\xb8\xec\x0a\xc1\xf4\x88\x4a\xb7\x0b\x69\x34\x9c\xd6\xae\x2a\xcd\x7a\xa1\xd2\xed\xa8\x17\x0a\xa0\xa8\xff\x7a\x1c\xc7\x5f\x79\xa1\xed\x53\x01\x01\xc7\xee\x76\xfa\x88\xaf\x49\x0d\xb1\x72\x45\x24\x8f\x31\xce\x79\xf9\x44\x9c\x17\x26\x9f\x16\x80\x2b\x0f\x73\x6d\xbe\xcd\xef\xd5\x23\x0c\x09\x0d\x6a\x8b\xd8\x7e\xb0\xc7\x44\x9b\x0e\xb4\x67\x3c\x46\x26\xd5\x7f\x18\x0f\x2e\x9d\x25\x5a\x59\x77\xea\x88\x0d\x86\xd7\x42\x78\x35\x72\x4d\x67\x4e\x85\xe6\x50\x47\x5a\x20\x52\x45\x5a\x2e\x62\x32\x38\xe4\x2e\x72\xac\x09\x6a\xb3\xac\x45\x82\xac\x71\x06\x38\x3a\x88\x58\x3c\x75\x1c\xd4\xb8\xdc\xe6\xbb\x9f\x30\x52\x53\x5c\xb2\x55\xde\x7e\xf5\x82\x08\x9f\x79\xed\x35\xba\xdd\x12\x95\x48\x11\x1c\x62\x91\x82\xaf\x7e\x71\x0a\x97\x21\x03\xdb\x2d\x8b\xd0\x6a\xcd\x1e\x43\x2d\x57\x84\x04\xc4\x2c\x62\xe8\x0b\x0b\x65\xf2\x40\xf1\xfe\x15\x88\xd9\x2d\x3e\x0c\x78\x9f\x41\x1c\xf0\xb2\x40\xdc\x15\xe8\xbd\x62\xbe\xf7\xd9\x1d\x19\xc7\xf3\x9e\x83\x66\xe4\xfb\xc2\x0a\x16\x18\x83\xbf\x78\xa7\xf1\x39\x09\x52\x7c\x73\xd8\x2a\xa9\x25\x47\xbf\xc8\x28\x80\x00\x64\x7c\xe4\xae\xb4\xe8\x0b\x93\x59\xde\x32\x37\x41\xf1\x54\x7c\x67\x9b\x44\x76\x39\xde\xe9\x3e\x95\x6e\x5e\xae\x73\xcc\xc2\xa0\x17\x80\x68\x4e\x8b\xb1\x7b\x3b\x2d\x2d\xd8\x26\xa0\x9e\x00\x87\x2f\xf3\x51\x79\x48\xb9\xcc\xc9\x62\xe4\x01\x6d\x34\xf4\x7b\x16\xaa\x2c\x2c\x48\x3f\x8d\xd8\xc3\xf3\x90\xf4\x93\x64\x11\x49\x93\x95\x46\x32\xce\xe0\x15\xbd\x3b\xf4\x75\x6a\xd9\xe8\x7c\xd5\xda\x01\xb5\x7c\x59\x43\xd2\xa4\xd7\xd9\xd0\xc5\xcd\x64\xd4\x5c\x09\xfc\xd2\x19\x2b\x93\x83\x7e\x79\xaf\x7c\xf1\x77\x52\xaa\xe1\xdf\x55\x4e\xe7\x1e\x4b\x00\x69\x1a\x02\x3c\x4f\x52\x6a\xc7\xd3\x3a\x64\xa5\x31\x0c\x1b\x8f\xbd\xf9\x6f\xe6\x78\x01\x9f\x8c\x09\x59\x99\x16\x2d\x9b\x7d\x60\x5c\x5a\xb9\x14\x28\x09\x8f\x40\x55\xfd\xd3\x83\xa6\xd6\x99\x7a\xcd\x21\x0d\x77\x7c\xa1\x60\xb6\x5b\x7d\xfb\xc0\x59\x91\x89\xdf\xf2\xeb\xa0\xbe\x5e\xe0\x8b\xfa\x08\x76\xea\xb6\xaa\xa5\x20\x90\x6c\x4a\xe2\xc2\x4e\xaa\xda\x52\xdb\x46\x21\x49\xa4\x73\xb0\xd7\x34\x87\x01\x30\x89\x49\xfe\x08\xd3\x9f\xcc\x6a\xc2\x64\x14\x94\x2b\x8f\x18\x4b\xba\xc9\x9f\x71\xa8\x44\x98\xb8\x16\x55\x86\x52\x28\x7c\xd6\x84\xc7\xb9\x84\x2d\x5c\x68\xf1\xf5\xd0\x2d\x96\x85\x71\xe8\xd8\xe7\x88\x65\x9c\xf3\x81\x43\xa2\x71\x5d\xdc\x70\x70\x19\xd3\xfe\x2d\x8c\x49\x87\x75\x23\xde\x57\x25\x1b\xe2\x75\x52\x8b\xd7\xc4\x3c\xcc\xe0\x69\x16\xc4\xba\x5a\x22\x80\x38\xd4\xff\x6f\x21\x00\xdd\xb0\x9d\x18\x70\xa0\xc2\x19\x3c\xa6\xe0\xed\xf6\xe5\x1b\x79\x71\x33\x79\x6e\x1f\x39\x81\xfb\x9a\x7d\x14\x7a\xa4\x76\xaf\x6d\x6d\x23\x2f\xb6\xbd\x6f\xf9\x36\x7a\x66\xe3\x5c\xb0\xa8\x84\x3a\xac\x87\x75\x98\xab\xb3\x72\xf5\x03\xc3\xee\x82\xb2\xa9\xb2\xc1\xf5\x87\x4a\x0e\x91\x77\x10\x3b\xa3\x8c\x9a\xaf\x80\x85\xaf\xcb\x5e\xd6\x4e\x5e\x06\xf4\xf2\x86\x44\xfe\x95\x3c\x89\x88\x7d\xf5\x0b\xf2\x85\xd0\x3f\x2a\xcf\x2b\x64\x44\x0a\x38\xe2\x7b\xf1\x57\xa2\xfe\x8f\x18\xbd\x16\x24\x39\xf4\x3d\x5e\x26\x96\x9d\xc9\xc7\x46\xcc\xdf\x89\xf9\xcb\xf9\xf8\x2b\xd3\xab\xad\x3c\xb8\xdf\x66\x4a\x4f\x95\x31\xdb\x4a\x44\x30\x09\x92\x90\xd9\x4f\x55\xd1\xd5\xe3\x2d\x9a\xf6\x1d\x8b\xde\x2d\x9a\x72\x0e\x74\x8b\xa6\x8d\xd2\x5a\xe0\x14\xc3\x73\xd4\xef\x17\x6b\x07\x90\x24\x4c\x65\xd1\x16\xd7\xc0\x01\x88\x56\x29\x9b\x2d\xc3\xd4\xbc\x25\x40\x38\x86\x5b\xef\xee\x38\xea\x00\x89\x7e\xa5\x98\x84\x86\x4d\xed\xb0\x40\x79\x32\xe4\x37\x4d\x06\x5d\xe6\x4e\x9f\x75\x94\xd5\x36\x33\x50\x0b\x0b\x46\x01\xed\x90\xe7\x9b\xd6\x12\x92\x78\xad\xc3\x62\xee\x82\x34\xb9\xdc\xfb\xcb\xbe\xe9\x34\x50\x89\x35\x9b\x26\x02\xa1\x2d\xfb\x6d\xce\x46\x3d\x97\x04\x41\x99\xaa\x05\x8d\xef\xf8\x80\x80\xdf\xfc\x16\x21\x69\xbb\x7f\xe9\x8b\x64\x00\xb1\x27\x73\xd9\x60\xc0\x44\xc2\x1f\xb2\xdd\xf6\xdf\xaa\x0b\x2e\x37\xab\xb8\x88\x6c\x36\xac\x43\x3d\x34\x88\x3d\x9e\x5f\xe6\xc6\xba\x23\xbf\xd9\x1f\x91\xe0\xec\xef\xf1\xf8\xdd\xcc\x00\x9e\x1f\x7d\x1c\x77\x41\xb1\xba\x39\xc5\x90\xbb\x06\x61\x2d\x95\xf9\x46\x68\x11\x5d\x4d\x67\x35\x98\xbe\xf8\xeb\x02\xc5\xaf\xc9\x04\x94\xb7\x5f\xf5\x53\x8e\x4c\xab\x97\xb9\x47\xad\x5e\xfb\x26\x5e\xbb\xdd\x0a\xae\xaa\x10\xc0\x3b\x4f\x32\x9a\x35\x5d\x2b\x8b\x99\xf4\x5a\x32\xbf\xeb\x84\x34\x65\x87\xd7\x92\x02\xb5\xbc\x96\xdc\x7c\xfb\x73\xc3\xdd\xac\x9b\x03\xed\x9a\x63\x54\x17\xe5\x24\xa1\x18\x4b\x1c\x04\x1d\x8e\x38\x7a\x60\x2f\x77\xc4\x59\x7e\xdb\xf8\x76\x7f\x89\xd2\x4c\x3a\xe2\x2c\xbf\x7d\xef\x69\x7c\x5f\x18\x16\x5d\x3f\x9d\x0a\xc0\xdc\x3f\x2d\xbe\xeb\xf0\xc9\x0c\xbc\xdb\x05\xab\x12\x09\xcf\x77\x65\x65\x99\x9c\x4e\xce\xc6\x71\x10\x35\x06\x0a\xeb\x1c\x28\xce\x08\x85\x07\x8f\xed\xa7\x43\x5a\x7e\x3a\xc4\xf1\xd3\x21\x0d\x3f\x1d\xe2\xf5\xd3\x21\x0d\x3f\x1d\xe2\xf1\xd3\x21\x5e\x3f\x1d\xd2\xe9\xa7\x43\x3a\xfd\x74\x88\xf3\xa6\x1f\xb6\x3c\x61\x32\xeb\xa8\xc9\x1c\x94\xea\xe6\xaf\x58\x08\x53\x70\x09\xe4\x1b\x72\x15\x0d\x33\xa8\xae\x64\xe7\x49\xd6\xf6\x40\x99\x3f\x6b\xc7\x6c\x98\xe6\xf4\xdb\x74\x0b\xeb\xb2\x93\xef\xf8\xef\x61\xd2\xd4\x3b\x28\x4d\x9a\xf3\x3f\xcc\xa4\x39\xff\x03\x4c\x9a\xb3\x48\x60\xa8\xcf\x94\xf9\xcc\x77\xda\xfb\x1f\xb6\x17\xb6\x69\x07\x5d\xd6\x76\xd0\xa5\xb6\x83\xce\xed\x4f\xba\x16\x69\x87\xb1\x42\x9d\xce\x40\xa4\x79\xdc\x6d\x3f\x54\xa0\x87\x9e\xb2\xe7\x6c\x88\x0a\x4c\x8e\xf7\x77\x96\x1a\xcb\xaf\x90\x1a\xdb\x2f\x47\xfc\x56\xf9\x46\x53\x5f\xbf\x7c\x63\x65\x66\x55\x32\x8e\xbc\x2d\xda\x25\xe3\x64\xdd\x32\x4e\xcd\x93\xfe\x9e\x32\x4e\xf6\xf7\x78\xe0\xf7\xf7\x96\x71\xf4\x52\xbd\x4c\xc6\xd9\xbc\x5c\xc6\x21\xaf\x97\x71\xb2\x2a\x9c\xb7\x84\x9c\xbf\xfb\xe3\x28\x1e\x1d\x52\x3d\x30\x58\xae\x1f\xb9\x98\xa3\xc5\x1a\x6c\x5e\x87\x38\x18\x42\x89\x20\xda\x9f\x58\x46\x0a\x8b\x77\x7f\x69\xfd\x86\x84\xd2\x91\x0d\xac\x71\xc1\x56\xd0\x9e\x67\x80\x53\xf5\xf8\xed\xd1\x64\x1c\x07\xc2\xbc\x90\x32\x61\x19\xb6\x46\x24\x03\x63\x62\x35\xc8\x46\xa5\xf3\x5a\x73\x1c\xe4\x34\xcd\x1c\x08\x3b\xa5\x5c\x9e\x3c\x9d\x5c\x5d\x5c\x8a\xfc\x04\xa7\x93\xf1\x47\xeb\xbd\x10\xcc\xd0\x4a\x3e\x3c\x22\x6a\xe5\x63\x1f\x8d\xb7\x3e\xe4\xf3\xa0\x6a\x98\x48\x3a\x59\xcb\xe7\xa5\x2f\x7f\xd1\xe9\xde\xca\xf5\xa3\x79\xae\x71\xfa\xc6\x7c\x12\xa9\x6f\xe0\xf9\xd1\x4f\x77\x67\xa7\xd7\x93\xbb\xef\xaf\x2e\x6e\x2e\x65\x76\x36\xd8\x8b\x72\x5c\xb2\xfd\x45\x41\x37\x6b\x0d\x72\xfe\xe3\xb5\xac\xdd\xcf\x31\xf9\x24\x4b\xf5\x5b\x22\xbc\x54\x0e\x58\x34\xa5\x8b\xeb\x46\x1a\xd3\xb1\x9e\x1f\xb1\xe6\xd8\x7a\x99\xa4\xb1\x06\x2a\xd9\x9d\x05\xa0\x42\x38\x84\x40\x76\xf1\xdd\x77\xd7\xe3\x49\x1c\xc8\xbd\x0f\xe0\xe5\x85\xbc\x9d\x8d\x83\x35\x95\xda\x70\x67\xa6\xb9\x96\x21\xd1\xcd\xb9\x60\x92\xca\xc9\x65\xd4\xfc\x3f\xf8\x70\x71\xf2\x8b\x32\xdd\xc9\xc0\x8f\x43\x19\xba\x1a\xbf\xf4\xd1\x62\x6d\xfb\x77\x53\x89\x4b\xe4\x54\x4c\xd5\xac\x3d\x17\xd8\xf7\xba\xe1\xea\x75\x7f\x06\xd0\x5d\x6d\x9d\xac\x4e\x65\x45\xbf\xd5\xa9\xf1\xe4\x27\x56\x81\x32\xb4\xc9\x78\xfc\x56\x1e\x20\x19\x44\x9d\x0c\x4d\xf0\xbc\xb3\x52\xe2\x86\x25\x55\xe1\x64\xbe\x04\x4d\x38\xba\x5b\x17\x74\x86\xca\x52\xe6\x2b\x13\x6d\x14\x68\x5e\xa0\x72\x59\xbb\x3c\x2a\x08\xa0\xb2\xe5\x11\x5f\xb6\x3c\xf5\x51\x67\x0a\x79\xcf\xe8\xcc\x4d\xbb\x53\x1a\xc9\xad\x3c\x9c\x45\x06\x8f\x66\x91\x44\x31\xce\x4f\x05\xd1\xd1\x4e\x67\x7a\x9d\x25\x95\x39\x24\xb1\xa7\x14\x16\x09\x4e\x92\xa4\x6e\xae\x7e\x7e\xf9\x5a\x27\xde\x09\x41\x3c\x1c\xbd\x74\x43\x9c\x85\x6f\xb4\x25\x4b\xc5\x53\x0e\xed\x17\x87\x35\xd2\x01\x2e\xae\x58\x19\x3e\x4c\xac\x15\xdd\x99\x55\x1a\xcf\x43\xaa\x82\xac\xa8\xca\x28\xad\x9f\x17\x26\x9d\x91\xdf\xe2\x8d\x18\x11\xfe\xb7\xdd\xa6\x91\x7c\x68\x5c\x99\x6a\x6f\xc5\xa3\x72\x78\x1a\x82\x88\xd1\xf5\x5e\x01\xa9\x79\x4c\x41\x38\xab\x9a\x77\xaf\x7d\xe2\x50\x05\xa2\x92\x16\xcc\x8e\xf9\xb4\xee\x00\x6f\x87\xd3\x7d\x74\x3b\x9c\x56\x9e\xbb\x5a\xe9\x2a\xa6\x96\x59\xbe\x99\x8d\xc4\x6c\x98\x59\x6b\x5d\x7a\x30\x55\xd9\x48\x5e\x9f\x04\xac\x66\x0b\xa0\xf3\x68\xcc\xe7\x61\x10\x35\x60\x5d\xf2\xd3\x3a\x6e\xe3\x76\x45\x3b\x53\x98\x21\x2e\x56\x99\x46\x2b\xdb\x6f\x59\x21\x56\xdb\x43\x79\xf7\x59\xd7\x19\xbf\x3a\x6c\xeb\x5c\xdc\x33\xf7\x87\x5e\xc3\xba\xb8\x18\xd1\x69\x89\x54\x3a\x14\x64\x25\x2a\x92\x92\x35\xce\x02\x30\x92\x0f\xf6\xd5\xae\xb3\xf2\x4e\xac\x05\x28\xde\xee\xd3\xe5\x49\xf0\xa7\x60\xaf\xd0\xa8\xe4\x37\x78\x53\x9d\x36\xcc\x3e\x85\x5d\x2a\x4b\x63\xed\x93\x44\x51\x08\x1f\xf1\x58\xa7\x0b\xf4\xcb\x85\xe5\x5c\xd9\xa8\x37\xb9\xb6\xdc\xde\xd5\xd2\xbe\x68\x00\x4e\x92\x8b\xed\xf6\x63\xca\x96\xd1\x2a\xfd\x12\x36\xa2\xd0\x2d\x20\xd8\x99\x09\xc3\x86\x32\x91\xc4\x72\xf8\xaf\x19\x52\xbd\x26\x76\x0a\x07\xd9\x82\x77\x19\xba\x02\x85\x25\x81\x10\x03\x51\x54\xbf\x2b\xe3\x87\x4b\x3e\x5d\xb6\x27\x91\x1d\xa2\x4e\xea\x48\x7c\x4f\x78\xec\xa1\x7d\x03\x6f\x2f\x81\xfd\x16\xb3\xbd\x5e\xfd\x24\xd1\xb9\xdf\x2c\x8e\xf5\x3e\x71\x2f\x26\xf5\x31\xbb\x75\x7e\x99\xac\xec\xd3\x51\xfb\xe4\xf5\x93\x04\x6b\xb7\x2f\x2d\x87\x86\x56\x70\x47\xfb\x8b\xc1\x80\xbd\x73\xce\xf9\xed\x70\xaa\x5b\xa8\x4b\xde\x0f\x81\xb3\x85\xed\xd3\x6e\x85\x81\x88\xeb\xc5\x10\x8c\xf4\x0d\x4e\x99\x38\xcd\x99\xa7\x2c\xf6\xf7\x47\xc0\x3b\x05\x77\xf6\x25\x1f\xcf\x7b\xb7\x0d\x51\xe8\xd5\x0d\x1b\x60\x7b\x07\xd3\xed\xb6\x39\x43\x5e\x0a\x5a\xcb\xd4\xec\x55\xe5\x3e\xd0\xf5\x9e\x4b\x18\x67\x15\x8c\xfc\xa6\x66\x6f\x85\x16\x19\xb2\xaa\x3d\x81\x60\x00\x46\x24\x21\x2e\x2f\xad\x99\x10\xda\xd3\xb9\x99\x15\x55\x92\x9a\xec\x14\xbe\xd9\xe3\x55\xcb\x02\xcd\x4d\xd9\x9b\xaa\x7e\x32\x53\x1b\x07\x60\x00\xc0\x08\xd7\xd7\xfd\xb9\x2b\xc0\x81\xc3\x10\x1b\x8f\x96\x5a\xb8\x53\x39\x8f\x2d\x69\x4f\xe5\xea\xaf\xed\x5b\x79\x24\x55\x01\x00\xb1\xa7\x10\xc4\xa1\xaf\x18\x6a\xef\xd7\x32\xd4\xa2\xa9\xd6\x1c\x80\x08\x79\x0e\x5d\x89\xb5\x21\x9a\x76\x74\xff\xa2\x26\x55\x03\xc6\x81\xd4\xea\xc8\xd7\x6c\x27\xbf\xd5\x3e\xaf\x69\xa4\xd5\x3c\x4f\xc6\x41\x99\xf4\x86\xef\x7e\xe3\xd5\x7e\x57\x82\xd2\xa2\xc9\x52\xf7\xea\xd8\x77\xcc\x58\x2a\x48\x7e\x9b\x75\x07\xb7\xad\x3b\xb6\xb4\xe0\x7b\x79\x47\xdd\x5f\xd5\x71\x6d\xc4\xff\xea\x8e\xdb\x96\x88\x53\x7b\x45\x7a\x02\xfc\x4a\xeb\x0d\xb6\xad\x37\xe4\x0f\xb7\xde\x60\x61\xe4\x20\x4e\x1e\x02\x2b\x89\x5f\x1a\x39\xba\x3a\xf4\x79\x20\x34\x9e\xf4\x59\x46\x5a\xbf\x56\xf6\x3f\x45\x00\x09\x27\x80\xf5\x5e\xdd\x92\x69\x77\xe8\x3d\x86\xfa\xc1\x5a\x19\x8c\xf0\x87\x86\xde\x93\xee\xd0\xfb\x95\xc7\xd2\x19\xcd\xb9\xfc\x74\x0f\x49\xf3\x49\x01\x96\xde\xfb\x1e\x14\x10\xc5\xee\x73\x02\xa6\xc8\x7e\x4c\x40\x14\x76\xa6\xda\x67\xe9\xbd\x6d\x15\xc1\x96\x55\xe4\x25\x76\x0f\xe8\x7b\xbe\xb5\xf3\xee\x86\xd6\xad\x3b\x66\x88\x17\x18\x42\x5a\xc6\x13\x59\x70\x77\x73\x16\x07\xef\x7b\x39\xee\xbd\xef\x99\xaa\x1d\xe9\xf9\xf9\x62\x4c\x61\xcf\x2d\x5c\xe3\x3c\x6f\x97\xf2\xde\x45\x22\xff\x67\x6d\x20\x35\x88\x1a\x94\x7a\x35\xf6\x7d\xaf\xf9\xd2\xab\xb1\x16\xa5\x5e\x63\x08\xab\x99\xa2\xb1\x7f\x54\x2a\xa6\xad\xad\x72\xa7\xcf\x84\x12\xf6\x1b\x29\xce\x7e\x73\xc2\xdc\x5d\x39\xe8\xb0\xa6\xb8\x9e\x64\xa0\x16\x90\xf5\x22\x90\xd4\x3b\x60\x0a\xf3\x76\x96\x47\xcd\x56\x69\x93\x2d\xdd\x0e\xa7\x70\xb9\x3b\x13\x89\xf5\x72\x20\x9e\x87\xb9\xec\x67\x96\x04\x37\x67\x41\x92\x24\xb9\x98\xa1\xb0\x18\xd1\xa8\xc6\x21\xfd\xf7\x28\x6d\x50\x9d\x5c\xbf\x61\x00\x00\x4c\x93\xf4\x36\xad\x05\x47\x65\x0c\xd1\xd1\x2e\x44\x3e\xa5\xb5\xf3\xd9\x55\x00\x33\x0b\xde\x97\x6b\x3f\xad\xa4\xca\xae\xc2\x03\x35\xcb\xdc\x78\x72\x3e\xea\xba\x0c\xc0\x7e\xe6\x8d\x21\x19\x0c\xfa\x9b\x8e\x14\xe8\x4b\xf1\x08\x11\x0a\x97\xd2\x90\xe0\x15\xe2\xb4\x3a\x9d\xeb\x4b\xc3\x36\x6f\xb4\xe7\x7e\x32\x6e\x67\x10\xb6\xa7\x5e\x36\xa6\xde\x82\x4e\x2b\x15\x0d\x68\xa5\x74\xf7\xe7\x2a\x2d\x41\x35\x52\x5e\xbf\xf5\xa0\x0b\x58\x58\x98\x0c\xe7\x20\x9e\xcb\x1c\xe2\xe9\x57\x98\x0f\x38\xa1\xf0\x19\x03\xc4\xa3\x5d\x1e\x61\x16\x12\x58\x28\x63\x0c\xcc\xa5\xc9\x6b\x99\x84\xa9\x46\x3b\x52\xa3\x5d\xfd\x96\x93\x85\x80\x20\x96\x69\xaf\xb4\x88\x45\x8d\x30\xc5\x31\x7e\x96\x88\xac\x99\x9d\x71\x33\xe2\x25\xda\xa5\x73\xd0\xa4\x17\xd0\xc6\xc3\x9e\xf2\xe8\x8e\x99\x06\x8e\xe9\x6a\x9d\x23\xbe\xe3\x70\x09\x67\xb0\x00\xd5\x68\x39\x18\xcc\x0e\x45\x73\xde\x60\x9b\xcd\xae\x60\x9b\x4d\x08\xa0\x1e\x8c\x2d\x8f\x61\x7d\x43\x99\xfa\x3a\x6f\x2c\x23\x4c\x85\xd0\x43\xc4\x53\x08\xcd\x76\xd4\xb2\x08\x84\xcc\x85\xb0\x5e\x6f\xb9\x59\x56\x2f\x3d\x16\x8f\x65\xe5\x7c\x70\x79\x47\xa3\x50\xb0\x07\xb1\x5b\xee\xe5\x68\x41\x65\x7e\x1f\xe2\x8b\xff\x95\x22\xa9\x78\x9b\xf7\x00\x54\xfa\x0d\xe5\x3a\xaf\x6b\xab\xf5\xe6\xd5\xab\x6e\xdd\x1b\x5d\x6c\xb5\x3e\x04\xb0\x38\x0c\xeb\xe4\xa9\xea\x99\x5f\xab\x27\x99\x51\x20\x6e\x39\x85\x69\x74\x70\x79\x00\x3f\x5a\xf6\xe2\xd9\x64\xda\x96\x01\x14\xa9\x5e\x26\xce\x83\xc8\xf5\x2a\x8b\x85\xd5\xbb\xde\xdc\x81\x96\xf2\x53\xaf\xc7\xf3\x2f\x1d\x57\xe9\x60\x90\x86\xa0\x82\x6c\x87\x10\xff\x82\x0c\x64\x46\x88\x87\x65\x82\x2d\xef\x30\x7e\xc6\xdd\x2c\x62\xe6\x21\x3a\x17\xca\x9f\x3b\x8c\xec\xc8\x1d\x46\x5e\x26\x9c\x13\x9d\x3b\x8c\xd4\xc2\x39\xfb\x0d\xc2\x39\x27\xe5\xdd\xb9\xbf\x48\x33\xf7\x17\x7d\xcd\xcb\x64\xed\x67\x4b\x1b\xaf\x90\x09\x29\x4f\xa7\xb4\xe2\xeb\x96\xb4\x3f\x31\x75\x8e\x64\x9d\xd6\xc5\xcf\x8a\xd6\xa2\x61\xe6\x19\x4d\xe5\xbc\x20\xf7\x7c\x6e\x2b\xb4\x23\xb7\xd5\x9b\xb2\xf7\xff\xa7\x9f\xd3\xeb\x59\x81\xd7\x4c\xe7\xb7\x2a\x7b\xb2\xc7\x48\xfd\xdf\x5b\x6d\x4a\xd6\xbb\x47\x3d\x4c\x66\xf9\x26\x43\x59\xef\x1e\xcd\x69\x81\x7a\xfe\x66\x22\x93\xfd\x4a\x4c\x43\x06\x96\x59\x51\x4d\x22\xa5\xa6\xfc\x15\x49\xbc\x64\xb7\xc3\xe9\xbb\x6f\x06\x03\x76\x7b\x30\x7d\xf7\xd7\xed\x56\xc4\xda\x0b\xcb\x92\x78\xec\xf3\xf6\x60\xca\xeb\xbe\x99\xbe\x3b\xd8\x6e\x79\xf9\xfb\xe4\xcf\xaf\x9f\x53\xca\x7a\x39\x4a\x4b\xa6\x27\xf5\xf9\x20\xfa\x6b\x74\xd0\xbb\xdf\xf0\xf2\xb2\xec\xb1\x65\x4a\x7a\x9f\x05\x9e\x05\xa0\x12\x29\xc5\x6f\x18\xce\x93\x12\xb2\xe8\x28\x47\x05\x4b\x52\xc8\xa2\x0f\xe2\x35\xca\x24\x87\x2c\x3a\x4e\x0b\xe1\xb8\x94\x2c\xf9\x0f\x9a\xe7\xe9\xba\x44\xc9\x0c\xb2\xe8\x44\x49\xc3\xc9\x06\xb2\x48\xe6\x5d\xcf\x20\x8b\x2e\xe5\xb5\x7c\x72\x07\x59\x74\xad\x15\xe2\x64\x01\x59\x34\x49\xef\x93\x15\xff\x5f\xfa\xaf\x25\x73\x8e\xdd\x4f\x15\xfc\x47\x28\x3d\x7c\xc1\xe8\x1f\xde\xbe\xfd\x53\xaf\xa4\x9b\x62\x86\x3e\xa6\xeb\x35\x26\x8b\x9b\xab\xb3\xe4\x5e\xcf\x39\x5a\x61\x12\xfd\x5a\x46\xab\x74\xfd\x7f\x02\x00\x00\xff\xff\x83\xb8\xb6\x8d\x84\xc5\x00\x00")
-
-func resBootstrapMinJsBytes() ([]byte, error) {
-	return bindataRead(
-		_resBootstrapMinJs,
-		"res/bootstrap.min.js",
-	)
-}
-
-func resBootstrapMinJs() (*asset, error) {
-	bytes, err := resBootstrapMinJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "res/bootstrap.min.js", size: 50564, mode: os.FileMode(420), modTime: time.Unix(1510060966, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xef\x6e\xdb\x36\x10\xff\xbc\x3c\xc5\x95\x4b\x80\x06\x30\x2d\x39\x5b\xb6\x21\x95\xfc\x21\x19\x06\x6c\x1f\x36\xa0\xdd\x0b\x9c\x44\x4a\x62\x43\x93\x2a\x49\xcb\x09\x04\xbf\xfb\x40\xd1\x49\x24\xf9\x6f\x83\x00\xdb\x80\x0a\x88\x65\xde\x7f\x1e\xef\x7e\xf4\x25\x79\xc7\x74\xee\x1e\x6b\x0e\x95\x5b\xc8\xf9\x59\xe2\x5f\x20\x51\x95\x29\xe1\x8a\xcc\xcf\x00\x00\x92\x8a\x23\x0b\x5f\xbb\xa5\x13\x4e\xf2\xf9\x9d\x6e\xb8\xc1\x92\x83\xe1\xb5\x36\x2e\x89\x02\xf9\x45\xec\x1d\xa5\xf0\x91\x7f\x59\x0a\xc3\x19\x2c\xb8\x43\x70\x58\x5a\xa0\xb4\x27\xd3\x91\xf3\x0a\x8d\xe5\x2e\x25\x4b\x57\xd0\x5f\xc8\x98\xad\x70\xc1\x53\xd2\x08\xbe\xf2\x7e\x08\xe4\x5a\x39\xae\x5c\x4a\x56\x82\xb9\x2a\x65\xbc\x11\x39\xa7\xdd\x62\x02\x42\x09\x27\x50\x52\x9b\xa3\xe4\xe9\x6c\x02\xb6\x32\x42\xdd\x53\xa7\x69\x21\x5c\xaa\x34\x99\x9f\x0d\x43\xbc\xd5\xda\x59\x67\xb0\x86\xbb\x4f\x9f\x86\xd1\x59\xf7\x28\x39\xf8\xf4\xa4\xc4\xf1\x07\x17\xe5\xd6\xf6\xc2\x83\xb6\x85\x69\xf6\xa4\xee\xb5\xd7\xeb\x21\xb3\x36\xc2\x2e\xc6\x8c\x4c\xb3\x47\x68\x5f\xd6\xfe\xa9\x91\x31\xa1\x4a\xea\x74\x7d\x03\xd7\x7c\xf1\xe1\x85\xdd\xd3\x9c\x4a\xa1\x38\xad\x44\x59\x49\x51\x56\x6e\x6c\x24\xc3\xfc\xbe\x34\x7a\xa9\xd8\x0d\x54\x56\xe2\xfb\x78\x02\xb3\x38\xbe\x98\xc0\x75\x7c\x31\x99\xfe\x70\x7d\xf9\xe1\xec\xbb\x3d\xf2\xde\x32\x1a\x5a\x1a\x64\x82\x2b\xf7\xde\x69\x30\xde\xc7\x64\x8f\x25\xf8\xd9\xaf\x3a\xde\xd5\x8f\x13\xb8\x7a\xe2\xc5\x97\x97\x3b\x63\x4f\xa2\x2e\x99\x9b\x82\x8a\x5e\x2a\x2a\xf1\xe9\xe8\xe5\x5c\x61\x03\xb9\x44\x6b\x53\xa2\xb0\xc9\xd0\x40\x78\x51\x86\xe6\x1e\xb2\x32\xbc\x0b\xf1\xc0\x99\xcf\x56\xff\x38\xba\x23\xab\x51\x0d\xf5\x69\x66\x50\x31\x58\x64\x34\x86\x6a\x46\xe6\x77\x9a\x71\xc8\xc7\xc5\xeb\xf5\x8e\x9b\xf2\x45\x00\xfe\x83\x0a\x55\xe8\x91\x6f\xff\xfc\xad\x1d\xca\x67\xeb\x37\x90\x64\xf3\xb6\x85\xda\x08\xe5\x0a\x20\x17\xd3\xab\x82\xc0\xd4\x79\xa1\x3b\xdd\xc0\x7a\x7d\x91\x44\xd9\xc8\xed\x28\x94\x24\x52\xd8\xf4\x5b\x02\x85\x02\xa3\x25\x4f\x89\xff\x3a\xde\x3f\x13\xcf\xe9\xf3\x7d\x82\x42\x71\xb3\x23\xce\xc4\x61\x26\xf9\x93\x64\xb7\xd8\x21\x15\x24\x87\x07\xb4\xcd\x37\xfb\x99\x41\xa0\x02\x9b\x6b\xdf\x43\x46\xaf\xf6\x78\x19\x28\x64\xf3\x8f\xdd\xa9\x84\x6c\x6e\x65\x68\x4b\x3e\x72\xd5\xb1\x10\x18\x74\xe5\x97\x92\x85\x50\x01\x2a\x6e\xe0\x2a\x8e\xeb\x87\x53\xe2\xe9\xe5\xb4\x36\xba\x34\x7c\x88\x02\x47\x54\x4f\x12\xf4\xcf\xc8\x05\xf5\xb5\xdf\xb6\x20\x0a\x90\xae\x57\x34\xb3\x38\x9e\xc6\x31\xac\xd7\xbe\x19\x56\x68\x94\x50\xa5\x97\xe3\xd2\xf2\x0d\xd5\x2e\xf3\x9c\x5b\xdb\x51\x15\x83\xf5\x9a\x9c\x1c\x44\xa8\xac\xa7\x18\x32\x34\xa7\xab\x6e\x32\xbc\xc9\xee\xe1\xb2\x3f\xdd\x2a\x1a\x81\xb4\x41\xb9\xe4\x4a\xaf\x52\x72\xd0\xec\x6b\xac\x2e\x84\x4a\x49\xfc\x2a\x4d\x7c\x48\xc9\x2c\x8e\xc9\xd1\x16\x67\xa2\x39\xa1\xcc\x8e\x8b\x25\x91\x63\x07\x3a\x31\xda\xd7\x8a\x49\xb4\xa7\x89\x93\xa8\x6b\xfd\x31\x02\x75\x81\xbc\x0e\x57\x7a\x72\x28\xb9\x71\xd0\x7d\x06\xb8\xdc\x14\x57\x47\xd9\xd3\x40\xbf\x09\xc9\x2d\xfc\xd5\x70\xe3\xef\xfb\x1d\xf1\xee\xcc\xd1\xdb\xa1\x59\xdb\x82\x41\x55\x72\x38\xbf\x9f\xc0\x79\x03\x37\x29\x4c\x19\x3a\x9c\x86\xc0\xfa\xd7\xf8\x96\xe5\xaf\xc3\x41\x10\x2c\x25\x85\x90\x9c\xb6\x2d\x9c\x37\xd3\xdf\x7f\xf5\x05\x0c\xde\x19\xd5\x45\xd1\xfd\x1e\xfa\x29\x3e\x05\x9e\x10\x2a\xc3\x8b\x94\x7c\x6f\x79\x3e\x30\x36\x0f\x8b\x3f\x71\xe1\x81\x21\x89\xf0\x1b\x8e\xfa\x7c\x3c\xff\x70\xfd\xbf\x22\x69\x7f\x0f\x6f\x8a\xa5\x43\xc3\xff\x19\x34\x1d\xed\xf7\xdf\xc7\x53\x08\x48\x11\x2a\xe2\x0d\x10\x17\x5e\x85\x3c\xfd\x7e\x32\x7a\x05\xb5\xa3\xd7\x01\x56\xb6\x80\x60\x77\x8c\x03\x4c\x97\x41\xff\x40\x3a\x86\xee\x8e\x74\xee\xc8\x38\x9d\xc5\x5b\x70\x74\xfc\x78\x46\x36\xae\xbe\x0a\x0d\xb7\xb0\xf5\x94\xa2\xdc\x78\x2b\xa4\x46\x47\xbb\x01\x08\x32\xa7\xfc\x1f\xd5\x4b\xd7\x8d\x5f\xfe\x2a\xeb\x08\x76\x41\xe6\xb7\x98\xdf\x9f\x00\xac\x07\xb7\x7a\x84\x1d\xb6\x70\xeb\x87\xc6\xbd\xc5\xb6\xfb\x56\xdc\x4d\xde\x5d\xb8\x23\xe1\x24\xf2\xc3\x45\x7f\x14\xce\x8d\xa8\x5d\x7f\x16\xfe\x8c\x0d\x06\xea\x78\x24\xfe\xfc\x65\x7b\x10\xd6\x75\xcd\xcd\x16\xf9\x79\x78\xfe\x63\xcf\xec\x3c\xa4\x27\x51\x70\xf8\x34\x45\x86\x16\x4b\xa2\xf0\xbf\x8b\x7f\x02\x00\x00\xff\xff\x6a\x30\x74\x5d\xcc\x10\x00\x00")
-
-func resIndexHtmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resIndexHtml,
-		"res/index.html",
-	)
-}
-
-func resIndexHtml() (*asset, error) {
-	bytes, err := resIndexHtmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "res/index.html", size: 4300, mode: os.FileMode(420), modTime: time.Unix(1510070644, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resJquery321SlimMinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\xfd\x79\x93\xdb\x36\xb6\x38\x0c\xff\xff\xfb\x14\x2d\x8c\x2f\x43\x58\x90\x5a\x72\x92\xf9\xdd\x50\x0d\xb3\x1c\x3b\x9e\xc9\x4c\xb6\x89\x9d\x65\x2e\x45\xa7\x40\x12\xa4\xa8\xa6\x48\x35\x45\x75\xb7\x23\x72\x3e\xfb\x5b\x38\x00\x48\x90\xa2\xec\xcc\xbd\xef\xad\xe7\xa9\xa7\x5c\x6e\x71\x01\xb1\x1e\x9c\x0d\x67\xb9\x7e\x3a\xb9\xda\xfe\xe3\xc8\xcb\xf7\x57\xf7\x9f\xce\x9f\xcd\x97\x57\x33\xb6\x65\x8f\x04\xfe\x5e\x6f\x0f\x45\xbe\x57\xd7\x59\xc1\x22\x75\xb9\x67\xe5\x81\xff\xfa\xed\x37\xea\xf6\x10\x96\xe9\xbe\x52\x37\xf7\xac\xbc\xce\x8a\x90\x55\x69\x91\x1b\x8f\xf2\x22\x0f\xb9\x71\x5f\xde\x89\x36\xd5\x83\xc7\x4d\x49\x66\x3b\x96\xa7\xfb\x63\x06\x1f\x5e\xff\xc6\xef\x59\xf6\x53\x99\x91\x19\xbf\xe7\x79\x75\x2d\xfb\xc4\xe3\x98\x87\xd5\xa1\xbd\xb8\x7e\xfb\xc0\x79\xde\xdd\xb2\x3c\xdd\xb1\x8a\x47\x6f\x78\xc6\xc3\xaa\x28\xaf\xea\x2b\x3b\xc4\x57\x7f\x7b\x73\xf5\xba\x38\xe6\x11\x54\x7d\xc5\xf2\xe8\xaa\xa8\x36\xbc\xbc\x0a\x8b\xbc\x2a\xd3\xe0\x58\x15\xe5\xe1\xaa\xbe\xda\x42\x97\xe6\x45\x99\x5c\x67\x69\xc8\xf3\x03\xbf\x7a\x7a\xfd\x7f\x26\xf1\x31\x0f\xc5\x87\x36\x23\x01\x3e\xa1\xe3\x81\x5f\x1d\xaa\x32\x0d\x2b\xb4\x42\x45\xb0\xe5\x61\x85\x28\xad\xde\xef\x79\x11\x5f\xed\x8a\xe8\x98\x71\xcb\xba\xf0\x62\xce\x1f\xf7\x45\x59\x1d\xdc\xfe\x2d\x65\xf3\xa8\x08\x8f\x3b\x9e\x57\x6e\x60\x33\x32\x59\x60\xa7\x6b\x15\x9f\xd2\xd8\x9e\x74\x45\x70\xb5\x29\x8b\x87\xab\x9c\x3f\x5c\x7d\x55\x96\x45\x69\x23\xb5\x7e\x25\xbf\x3b\xa6\x25\x3f\x5c\xb1\xab\x87\x34\x8f\x8a\x87\xab\x87\xb4\xda\x5c\xb1\x2b\xfd\x25\xc2\xab\x92\x57\xc7\x32\xbf\x0a\x6c\x86\x1b\x07\xfe\xda\xe8\x98\x47\x3c\x4e\x73\x1e\xa1\x89\xee\xae\xfc\xde\x95\x3f\x4e\xb5\x49\x0f\xe4\x03\xd3\x70\xcf\xca\xab\x90\x7a\x3e\x89\x8c\x91\x10\x4e\xbf\x87\x49\x98\x27\xbc\xfa\xa1\x2c\xaa\x42\xd4\xfd\x7d\x4c\x62\x1a\xce\x0f\x62\x82\x49\x42\xc3\x79\x58\xe4\x21\xab\xc8\x86\x86\xf3\xfd\xf1\xb0\x21\x29\x0d\xe7\x69\x1e\xf1\xc7\xef\x63\xb2\xa5\xa7\x86\xdc\xd2\xed\xbc\x2a\xde\x54\x65\x9a\x27\x24\xa3\xdb\xf9\x86\x1d\xbe\x7f\xc8\x7f\x28\x8b\x3d\x2f\xab\xf7\x64\x47\xb3\xee\x7d\x4e\x77\xf3\x90\x65\x99\x2d\x9b\xc6\xa4\xa0\xa7\x66\xa5\xbb\x7e\xb5\x97\x9d\x0f\x68\x50\xd7\x91\xea\x76\x30\x0f\x4b\xce\x2a\xfe\x55\xc6\x45\xb7\x6d\x24\xa1\x19\xe1\x55\x38\xaf\xf8\x63\x45\x19\x09\xe6\x1b\xce\xa2\x39\xdb\xef\x79\x1e\xbd\xdc\xa4\x59\x64\x87\x78\xbe\x67\x25\xcf\xab\xef\x8a\x88\xcf\x4b\xbe\x2b\xee\xb9\x7e\xd3\x88\x8a\xef\x28\xfa\xff\xdc\x76\x42\xa4\xa4\x7d\x30\x50\xe0\x24\x60\xb1\x9c\xc7\xf9\x3c\xcd\xd3\x0a\xde\x34\xe4\x40\xaf\xdf\x79\xeb\xc3\xfa\xf8\xfa\xab\xd7\xaf\xd7\x8f\x2f\x16\xfe\xb4\x1e\xdc\x3f\xb9\x4e\x48\x45\xaf\xdf\xcd\x76\x87\xd9\x35\x39\xd2\xeb\x99\xed\xb1\xd9\xef\x3e\xbe\x4e\xc8\xfd\x78\x4b\xc1\xbc\x2a\x7e\xda\xef\x79\xf9\x92\x1d\xb8\x8d\x9b\x95\x68\x96\x96\xf3\xbd\x06\x30\x7a\x92\xdb\xd8\xb9\x23\x61\x91\x1f\xaa\xf2\x28\x7a\xee\x94\x24\xe3\x79\x52\x6d\x9c\x05\xa9\x8a\x17\x65\xc9\xde\x77\x3b\xac\xad\x3c\x96\xb0\x23\xc0\x1d\x37\x24\xe1\x55\x6f\x17\xea\xb1\x1e\xb3\x8c\x52\xe6\x9a\x85\x1d\x76\xb3\x70\xc5\x95\xc7\xa6\xe2\x67\x2e\x1b\xf3\x1d\xf9\xcc\x6f\x88\x80\xed\x37\x15\x0b\x6f\x7b\x55\x0a\x40\x09\x68\x39\xdf\xf1\x32\xe1\x50\xd5\xdc\xe8\xb4\x8d\x09\xeb\x76\xec\x7c\x5f\xf2\x7b\x09\xd6\x14\x36\x64\xd0\x10\xce\xc2\xcd\x58\x1f\xcb\xb9\x78\x03\x15\x12\x86\x1b\xb2\x63\xfb\xb1\x62\xd0\x60\xdb\x33\xbb\x9c\xef\xd8\xde\xee\x6f\xf6\x80\x84\x6d\x71\x26\x47\x1c\x90\x50\x2c\x30\x16\x6b\x2c\xb6\xf1\xc8\x44\x0e\x2a\x8e\xc5\xd6\xc9\xde\xab\xfe\x94\x09\xe0\x87\x83\xa8\x20\x4e\xcb\x43\x75\xa9\x02\x7e\x67\x2f\x70\x43\x32\xf6\xc1\x22\xb3\x25\x6e\x08\xbf\x1b\x99\x57\x63\x25\x48\x48\xa7\x6c\x6a\x8b\x65\x0a\x9c\x45\x3b\xa9\x83\x7e\x86\xcf\xe9\xc2\xb2\xc2\x9b\xc0\xf5\x60\xe1\x42\xdf\x77\x3c\x5f\x54\x9f\x47\x17\x47\xd9\xae\x4a\x5d\x9f\x2f\xa0\x5c\x78\x67\x43\x0e\x45\x59\x39\xe1\x5c\xfc\x90\xc3\x1e\xa6\x2d\x9c\xcb\x8b\x86\x94\x73\xfe\x58\xf1\x3c\xa2\xb0\x87\xd4\xb5\xd1\x9e\x18\x0e\x23\x62\xde\x23\xc2\x49\x4c\x12\xda\x4e\xa2\xb7\xf0\xeb\xfa\xd4\x90\x0d\x5d\x92\xb4\x7b\xac\x87\xbd\xa5\x93\xe5\x2a\x16\x34\x22\x28\x8a\x8c\xb3\xbc\xa3\x48\x89\x65\xd9\x5b\x9a\xf4\x2a\xdb\xa8\xca\xa6\x53\x4c\xce\x48\x58\x52\xd7\xe5\x3c\x3d\xbc\xd6\xfd\x4a\x70\x5d\xdb\x09\x3d\x35\x98\x6c\x28\xa5\xa9\x65\xd9\x89\x84\xcc\xcd\x6c\x86\x57\x9b\x9b\x74\x25\x2a\x4a\x63\x5b\x6c\x99\x09\xb5\x59\xaf\x25\x8c\x45\xbf\x82\xab\x34\xbf\x62\x38\xa4\x89\x17\x00\xfd\x10\x3f\xc9\x84\xd2\x48\x74\xcf\xb2\xc4\x8f\x68\xf5\x87\x8c\xa5\xb9\x9c\x67\x3b\x12\x0d\x73\x0a\xbb\x78\x9e\x1e\xe0\xd7\x8e\x30\xc6\xae\xcd\x5d\x9b\xd3\xc9\x52\xd0\x18\xcb\xea\x17\x08\xb1\x1b\x8a\xd5\x74\xe0\xdd\xb0\x4e\x78\x7b\x6a\x88\xe8\x06\xd5\xeb\x61\x6f\x49\x4c\x22\x8c\x9d\xfb\x22\x8d\xae\x16\xaa\x57\x50\x24\xc2\x2d\x10\x25\xdd\x02\xda\x27\xfe\xb8\x67\x79\x54\x38\x8a\x26\xa3\xa9\x7d\x37\xfd\x96\x55\x9b\x79\x29\x1e\xef\x6c\x8c\xe7\x25\xdf\x67\x2c\xe4\xf6\xf5\xfa\xd5\x75\x42\x10\xc2\x24\x3d\xfc\xc8\x59\xf4\xde\x99\x2c\x08\x17\x14\xbd\x07\xcb\x43\x6a\x2f\xf6\x73\x5e\x14\x7b\x13\x20\x1b\xd2\xad\xcb\xc8\x46\x47\xfa\x11\xa2\x94\x96\x73\xb1\x9e\x50\x4d\x7a\xf8\x45\x12\xf8\x0b\x68\x6e\x42\x99\x65\x31\x4a\x29\x9b\x4b\x4e\x40\x7c\xf2\xdd\x71\xc7\xcb\x34\x1c\xc5\x63\xaa\x66\x35\x33\x36\xca\x8f\xbb\x80\x97\xa2\xd5\xa0\xae\xd1\x01\x68\x34\xdc\x61\xcb\x9a\xa4\x87\xef\xd8\x77\x36\x9b\x01\xf5\x7b\x9d\x15\xac\xb2\x19\x86\x5e\x19\x0b\x73\xde\x0c\x09\x55\xf5\x13\x7b\xc2\xea\x1a\x79\x12\x4e\xaf\x64\x79\x1f\x4d\x28\xbd\x95\x88\x8a\x61\x6c\x59\xf6\xc4\x0e\xa8\xe8\x94\x00\x9a\x90\x66\x1a\x87\x21\x63\x9f\x22\x6c\x59\x81\xb9\x71\x89\x39\x63\x0a\xfc\x43\xcb\x52\xbc\x45\x88\x29\xa5\xb9\xec\xea\x57\xbb\x7d\xf5\xfe\x52\x57\x57\x06\x84\xab\x3e\x2f\x75\xe7\x17\x0d\x11\x15\x7f\x88\xc2\xb0\x29\x42\xce\xd9\x36\x14\x63\x3e\xef\x1d\x73\xb7\x5e\x3b\x6c\xbf\xae\xf5\x67\x8e\x7e\xdf\x90\x24\x2b\x02\x96\x7d\x75\xcf\xb2\x5e\xa3\x7b\x00\x85\x90\xed\x78\x26\x68\xea\x58\x87\x58\x0b\xb3\x15\x41\xbb\xc3\x0c\x75\x40\x7c\x24\xf7\xf8\x8c\x0e\x09\x7a\x0d\xcc\x15\x89\xe8\x62\x95\xc6\xf6\x83\x98\xff\x93\x98\x8d\x90\x32\x85\x9b\x56\xd1\x4d\xb8\x8a\x24\x82\x08\x54\xcf\xbd\xc8\x27\x11\x11\x3f\x62\x8a\x27\x4b\x1c\x94\x9c\xdd\x36\x3c\x3b\xf0\x2b\xf1\x75\x24\xe7\xf2\xa3\x5f\xe8\xad\xc9\x1a\x52\x95\xe9\xee\x43\x93\x8c\x90\x63\x8b\x89\xee\x86\x74\x10\x7b\x52\xd0\xcc\x5b\x3e\x60\x14\xba\x81\x09\x78\xf6\xfc\xd5\x70\xaf\xd8\x0f\x8a\xf1\x14\xe3\x75\x35\x5d\x0f\x49\x07\xfa\xed\x72\x79\xcc\x77\x18\x76\x36\x0a\xa4\x08\xc3\x98\x84\x0d\x49\xf3\xf3\x36\x0d\x12\x2c\x7b\x1d\xb8\xb3\xa5\x93\x6a\x48\x66\x24\x14\xdd\x15\x4d\x0d\xba\x2a\xa6\x4c\x76\x77\x1a\x68\x8a\x10\xd1\x05\xe1\xe7\x8b\xc0\x3c\x3e\x9d\xfa\x34\xf0\xa2\x76\x54\xba\x0c\xe5\x44\x40\x4f\xc9\xf7\x67\xbd\xd2\x0d\x44\x84\x0b\xf6\x3f\xa6\x0b\x41\x4e\x74\x53\x1b\x3a\x09\x57\xf1\x4d\xb2\x8a\xa7\x53\x1c\xd1\x49\x60\x33\x2f\xf6\x49\x8c\x49\x34\xa1\x74\x63\x59\x1c\x68\x2f\x3c\x6d\xb1\x29\x1f\x72\x2b\xb2\x25\xd5\x0a\x34\xb1\xa1\x9e\xdf\x42\x15\x80\x45\x37\x9e\xf8\x26\x82\xe6\x38\xd5\xad\x91\x10\x13\xb9\x40\xdc\xb2\x36\xb2\x45\x8e\x57\x2d\x4c\xc5\x12\xa6\x3e\xfa\x81\xc6\xf6\x8a\x97\xf1\x7c\xb2\x11\x4c\xe2\x31\x8d\x9c\x25\xd9\x97\xc5\xe3\x28\xa0\x08\x8a\x2d\xfa\x7a\x06\x01\x81\x65\x89\xcd\x20\xc8\x5d\x40\x19\x61\x34\xc4\xa4\x47\x5e\x19\x56\x38\xe3\x2a\xa2\x8a\xd7\x6c\xc9\x27\x79\x86\x09\xa7\xe7\x1c\x09\x53\x9d\x0b\x24\x2f\x42\x22\x25\x5e\xd9\xc3\x0a\xb0\xc0\x5e\x7c\x2e\x7a\x4f\x99\xf9\x23\x68\xbc\xf8\x9d\x4e\x09\x17\x74\xe6\xc1\x79\xc5\x2a\x3e\xcf\x8b\x07\x72\x38\xee\x85\xb4\xea\x14\x0d\x1e\xc3\x8f\x6f\xde\xef\x82\x22\x03\x72\x1d\xe7\x9e\xbc\x9b\xa7\x15\x2f\x59\x55\x94\x3e\x0d\xcf\x1e\x89\xf1\x02\x9b\x8a\xbe\x94\xbc\xc9\xd5\x77\x40\x2e\xae\xa4\x2c\x77\xa5\x67\xe2\x0a\x76\xc4\x95\xe8\xc7\xd5\x8f\x3c\xf9\xea\x71\xaf\x70\xbd\x24\x88\xaa\x61\x04\xdc\x54\x65\xa3\x2b\x84\x07\xb2\xea\xd6\x6b\x89\x04\x9a\x06\x53\xe4\x23\x9f\x0a\x39\xe2\x9b\xe2\xa1\x95\x23\x70\x27\x24\x3e\x74\x14\x6d\x32\x61\x96\x85\x24\x68\x21\x01\x26\x96\xc5\x3a\x8e\x72\x48\xef\xba\x49\x99\x50\xc1\x63\x4c\xc4\x7a\x4a\x0a\x6b\x33\x41\x8a\x10\x13\x23\x11\x04\x30\xac\xeb\x85\xa2\x8a\x2d\x8d\xec\x00\x23\x78\xbe\xb0\xac\x60\xb6\x94\x90\x09\xe2\xe4\x23\x1d\x21\x84\x9a\x1d\x24\x1b\x92\x92\x2d\xb9\x25\x19\xd9\x91\x9c\x14\x64\x4f\xee\x48\x49\x0e\xa4\x22\x47\x8a\x0e\xe9\xef\xbf\x67\x1c\x4d\x97\x4f\x05\x13\x21\xa6\x91\xdc\x9b\x72\xfa\x03\x5d\x90\x47\xba\x20\xef\xe9\x86\xd9\x98\xfc\x2e\x7f\x5e\xc8\x9f\x2f\xc7\xc5\x30\xc1\x14\x08\x08\xce\xe8\x64\x81\xc9\xa2\x21\x2f\xe9\xa9\x19\x4a\xe6\xaf\x04\x4e\xf8\x8a\xbe\x9a\xef\x8b\x3d\x79\x2d\x7e\x85\x80\xff\x17\x7d\xf1\x57\xfa\x4a\xe9\x01\xbe\xa6\x97\xf0\xd6\x82\x18\x7b\x3b\xbc\x89\x56\xa1\x24\x18\xcc\x0b\x7d\xe0\x23\x54\x7f\x34\x4f\x30\x5b\x36\xe4\x6f\x14\x85\x1b\x1e\xde\xf2\xa8\x3e\x80\xe0\xca\xa3\x9a\x1d\xde\xe7\x61\xcd\x8e\x55\x11\x17\xe1\xf1\x00\x57\xfb\x8c\xbd\xaf\x41\x05\x54\x64\x87\x3a\xe2\x31\x2f\xeb\x28\x3d\xb0\x20\xe3\x51\xbd\x49\xa3\x88\xe7\x75\x7a\xd8\xb1\x7d\x9d\x15\xc5\xbe\xde\x1d\xb3\x2a\xdd\x67\xbc\x2e\xf6\x3c\xaf\x4b\xce\xa2\x22\xcf\xde\xd7\x4a\xe9\x12\xd5\x87\xb0\xd8\xf3\x08\x91\xbf\x53\xe4\xad\xd7\x8f\xcf\x16\xeb\x75\xb5\x5e\x97\xeb\x75\xbe\x5e\xc7\x3e\x22\xdf\x50\x64\xbb\xce\x7a\xbd\x5e\xcf\x6b\x6f\xbd\x7e\x98\xf9\xb5\xf7\x6e\xbd\x98\xad\xd7\x8f\x6c\xe1\xe3\x29\x22\xdf\x52\xb4\x5e\x7b\x68\xfa\xf7\x29\x7a\x6a\xa3\xe9\x37\x53\x84\x6d\xd7\x51\xf7\xde\xd3\x77\x4f\xea\xc9\xbf\x7c\x97\x62\xf5\xc4\x75\x3e\xb1\xbb\x1a\xdf\x89\xdf\x4f\x7c\xfc\x14\x7f\x52\xaf\xd1\xf0\xc5\x1a\x89\x37\x6b\x54\xab\x7a\x71\xad\x6a\x59\xaf\x7d\x44\xbe\xa3\xc8\xe9\x1a\x5c\xaf\x6d\xdb\xfe\xf7\xab\xc6\xf5\xf0\x8d\x8d\xbd\xf5\xda\xf7\x6b\x34\xfd\x76\x8a\xf0\x53\x5c\xcf\x9f\xe2\xf5\x5a\x34\x4d\xbe\xa7\x02\x16\xe5\x6e\xb6\xff\x3e\x45\x53\x44\x50\x82\x30\xf9\xc1\x7c\x8e\xde\x41\x1f\xa7\x50\xf1\x3b\x55\xa9\x8f\x75\x2b\xf8\xa9\x1c\xc3\xf4\x89\xfa\xf8\x1f\x23\x1f\x3f\x25\xf2\x07\x61\xf2\xe3\xd8\x6b\xdb\x7b\x3e\xfd\x97\xe8\xe2\xdf\xa7\x08\xb7\x45\xdf\xf4\x8a\x52\x5d\xf4\xdd\x7a\xed\x7f\xb2\x46\xfe\x53\xd7\x9c\x3d\x68\xfb\xad\xf9\xc5\x77\x98\xfc\x34\x6c\xec\x9b\x29\x7a\x82\x30\xf9\x99\x9e\xbe\x7e\xe5\xf4\xde\xfd\x49\x4d\x3d\xc2\xe4\xe5\x37\x2f\xde\xbc\xe9\xbf\x5d\xaf\xe7\xdd\xfb\xb7\x2f\xfe\xd2\x7f\x2b\x5f\xd5\xde\x53\x5f\xbc\x7e\xf1\xf6\xed\x8f\xce\xa0\xdd\x6f\x31\xf9\xe1\xcd\x57\x3f\xbd\xfa\x7e\xf8\xe2\x3b\x4c\x5e\xfe\xf5\xeb\x6f\x06\x9d\x71\x6c\x80\x6a\x90\xcb\x6b\x21\x79\xd7\x79\xb5\x11\xff\x67\xe2\x06\xcf\xec\x70\x93\x66\x51\x5d\xc4\x33\x81\xae\x14\xb8\xa8\xf9\xe1\xf7\x3c\xaf\x8b\x28\xaa\x6d\xdb\x9b\xce\xfc\x1a\xdb\xeb\x75\xf4\x14\xe7\x75\x07\xb1\xea\x85\xba\x5f\xaf\xa3\x29\xae\x71\x3b\x99\x00\x1a\x28\x45\x98\x08\x69\x75\x30\x52\xb1\x13\xfe\x36\x45\xf8\x89\x2a\x92\x73\x1e\x1d\x5e\x16\x79\xc5\x1f\xab\xe1\xd8\x44\x75\x72\x61\x9d\xae\x57\xfc\xae\x4e\xaa\x3a\x93\x23\xea\x06\xd8\x1f\x83\xed\x3a\xb3\xf5\x3a\xc2\x2e\x74\xdd\xe8\x98\xed\x52\xef\xdd\xcc\xaf\x9f\xa8\x2e\x36\xe4\x17\x7a\x2d\x7a\x95\xe6\xfb\x63\xa5\x30\x4d\x2d\x3a\xc3\x4a\xce\xea\xe0\x58\x55\x45\x8e\x9f\x5c\xa7\xe4\x57\x7a\xfd\x6e\xb3\x8e\xc4\xe5\x3f\xe9\xf5\x3b\xef\xdd\xc9\x9f\xae\x4f\xeb\xc3\xd3\xb5\x97\xb3\x2a\xbd\xe7\x57\xeb\x87\x6b\xf2\x5f\xb2\xb6\x3f\xd9\x9e\x40\x0d\x53\x5c\xdb\xeb\x87\x29\xae\xd7\x73\xfd\x00\x3f\xb9\x26\x4f\xe8\xb5\x37\xfd\x97\x7f\x4d\x7e\xeb\x81\x17\x6c\x36\x6f\xbd\x8e\xd8\x2c\xf6\x4f\x4b\xf2\xe7\x06\x3a\xee\xd6\x72\x54\xb8\x9e\x43\xa7\x05\x9c\x32\x46\x47\x59\x2b\x8a\x16\x8f\x68\x1a\xcc\xfe\xfc\xf9\xe7\x9f\xfe\x59\xf3\x3a\x82\x4d\x8b\xea\x3a\x74\x03\x27\xba\x59\xb8\x92\x2e\xcf\xe3\xb2\xd8\xbd\xdc\xb0\xf2\x65\x11\x71\x3b\x9a\xc2\x17\xd8\x19\x7d\xf9\xfc\xf9\x72\x51\x7f\xfe\xf9\xb3\x2f\xfe\x4c\x96\x8b\x67\x9f\x5a\x51\xfd\xf9\x9f\x3f\x7d\xb6\xc0\x0d\x09\x18\xbd\xb6\x3d\x81\xfe\x1e\x97\xf1\xfa\xf1\xff\xc6\x7e\xfd\x6e\xe6\xae\x23\x5c\xbf\x9b\x3d\x51\x88\x51\xbd\x99\xad\x8f\xaf\x5f\xbf\x7e\x2d\x66\xe1\x3a\x21\x21\xbb\xa0\x18\x74\xd1\x7a\x21\x48\x2b\x73\xd1\xfa\x18\xc7\x71\x84\x1c\x26\xe9\x8b\xbd\x20\xb3\x25\x9e\xa2\xf5\x1a\x4d\xd9\x3c\x54\xbd\x7b\x51\xd9\x9a\xb2\xcc\x96\xb8\x55\x21\xdb\xcb\x3f\xe3\x29\xba\x42\x8e\x2c\xde\x90\x88\x99\x8c\xd6\xce\x06\x71\x87\x56\xcc\x1e\x15\x94\x34\x01\x11\xc2\xc8\x42\x90\xfc\xb8\x28\x77\xc0\x3a\xd4\x35\xca\x58\xc0\x33\x24\x89\x3a\x39\x45\x69\xe9\xa0\x4e\x89\x8c\x48\x2e\x20\x19\x65\x3c\xe1\x79\x84\x1a\xbc\xaa\xca\xf7\xa7\xbf\x28\x8e\xee\x15\xfd\xab\x64\xe1\xee\xe7\xb0\x03\xc5\x17\x07\x4c\xfa\x77\xaf\x3c\xf3\x5e\x2b\x21\xe7\x79\x11\xf1\xb7\xef\xf7\xbc\x09\x59\x15\x6e\xec\x98\xe1\xd3\x5f\xe8\x09\xea\x75\x5e\xa9\x52\x6e\x7f\x52\x5f\xab\x66\x19\x51\xcd\x06\x18\x37\xa3\x82\x10\x33\x04\x8b\xd5\xc3\x26\xcd\xb8\x20\xce\x4a\x96\x98\x4e\x7d\xbc\x6a\xe5\x88\x70\xb6\x6c\x9a\xa6\xe5\xb3\x12\x06\x10\x18\x11\x2e\xeb\x8a\xc9\x46\x31\x31\x85\x60\x5e\x04\x7f\x11\xcc\x8b\x87\x9c\x97\xaf\x3a\x56\x25\x70\x83\x76\x3c\xce\x17\x82\xb3\x8e\x04\x88\x7a\x7e\x2b\x63\x4d\x0c\x41\x79\xc2\xea\x7a\x39\xa1\xf4\xc1\xb2\xbe\x90\x3f\x4b\xb8\x6d\x39\x6b\x51\xc1\x84\x5b\x96\x6d\x8b\x8a\x7b\x8d\xd5\x75\xe0\xdc\xe3\x09\xa5\xb9\x65\xed\xec\x00\x13\x38\x35\xc8\xc9\x1e\xc3\x81\xcc\x52\xd5\x6b\x67\xf4\xbf\xe6\xfc\x91\x87\x82\x61\x17\xac\x49\x4c\x33\x6f\xe9\x43\x99\x2f\xa8\x68\x0b\x8e\x6f\xec\x2d\x0d\xe6\x09\xaf\xd4\x29\xc3\x97\xef\xbf\x8e\xec\x18\xe3\x5e\x47\xb6\xf3\x54\xc0\x4d\xdc\x3e\x94\xb2\xc7\x16\x93\x48\x8a\xc0\x69\x6c\x1f\x40\x6b\x77\x18\xa9\xca\xb2\x2a\x3b\x20\x5b\x6c\x59\x1f\xab\x47\x74\x28\xf3\x9e\xf9\xfa\xbd\x86\xb1\x88\x98\x5d\x3c\x7c\xf9\xfe\x2d\x4b\xbe\x63\x3b\x50\x9e\x10\xe8\x21\x0c\xee\x53\x1f\x5b\x56\xd8\x2f\xf9\x32\x63\x87\x83\x28\x2b\xd6\x6c\xfc\xcd\x47\x5b\x6b\x4b\x8a\xd1\x90\xa8\x49\x63\x3b\x9c\xdf\x1d\x98\x65\x4d\x5e\x78\x4c\xec\x49\xdf\xb2\xec\xc9\x5d\x5d\x4f\xee\xe6\x15\x3f\x80\x90\x2d\xd7\x02\xd6\xf4\x40\x03\x52\x52\xb6\xd2\x53\xa5\x55\x20\x13\x4a\x25\xcc\x88\xba\xfb\x6c\x3f\x3e\xd9\xb7\x72\x5d\x5e\x54\xf2\x24\x90\xdb\x28\x8d\x10\xc6\xee\x2d\xbd\x6d\x35\x02\x01\x23\x21\xc3\x4e\x30\x3f\x0c\x0b\x92\x5b\x7a\xc4\xa4\xa0\x89\xcd\x30\xd9\xd0\x42\xb3\xaa\x72\x23\x6c\x66\x33\x5c\x78\x1b\x9f\xa2\x3f\xa1\xe9\xad\x18\xc1\xf4\xc0\x6c\xf1\x04\xaf\x4a\x5a\xcc\xb7\x45\x9a\xdb\x88\x20\x4c\x0e\xf4\x89\x1e\x92\x65\xdd\x31\x3b\x30\x4e\x97\x70\x5d\x07\x62\x32\x4a\x2c\x10\xc2\xd9\x2c\x1e\xe6\x70\xde\xa1\xcf\x68\x5e\x64\x99\x5d\xc2\xfc\xc9\xdd\xfe\x88\x4f\x4d\x9c\xe6\x2c\xcb\xde\x9f\x6e\x29\xa5\x47\xb1\x42\xf2\xbc\x6a\x30\xe6\xa6\x69\x54\xe5\xa9\xdd\x69\x7c\x7e\x20\xe8\xc9\x52\x50\x64\xd8\xa8\xdd\xee\x15\xd2\x81\x54\x4c\x0b\x69\xbc\x7d\x1c\xd8\xa1\xd8\xcf\x2d\x3e\x04\xc8\x0b\xc5\xd0\xf1\xf3\x68\x1e\xb2\x70\xc3\xbf\x81\x29\xb2\xac\x88\x67\xbc\xe2\x57\x81\xc7\xe6\x87\x4d\x1a\x57\x36\xf6\x49\xe0\x41\x59\x9f\x72\xdd\x97\xa0\x6b\x32\x65\x26\xaa\xf5\x8e\x3e\x9d\x2c\x08\xeb\xde\x6f\x59\x27\xb9\xe5\xc3\x53\xbd\x38\xe5\x59\x74\xe0\x15\x92\x78\x55\x69\xe1\x26\xcc\x0e\xb0\x9a\xa9\x56\xcb\x32\x59\xb6\x33\x66\xae\x83\x98\xb8\x0b\x67\x7e\x80\x1d\xf2\x63\x96\x19\xe8\xed\x96\xf5\xd1\xa4\x12\x4f\x6b\x24\x44\xf7\xb0\x0f\x28\x7c\x36\xc3\xd1\x9c\x55\x55\xf9\x57\x96\x47\x19\xf7\x42\x8f\xfb\x3e\x35\xc6\x9e\xf5\x6a\x0b\x2c\x8b\x91\x48\xc8\x98\x4b\xd0\xce\x6a\x8c\x28\xef\x03\xe3\x9e\xcd\x0f\xc5\xb1\x0c\xf9\xd7\x79\xc4\x1f\x67\x81\x79\x07\xc8\xb3\x87\x80\x42\x2c\xbb\x13\xd2\x70\x2e\x88\xd1\x9b\x34\xc8\xd2\x3c\x11\x58\x2d\x34\xa4\xad\xd9\xb2\x55\x11\xb9\x4b\x67\xb6\xec\x7a\xb9\x33\x57\xa8\x3b\x43\x6a\xbb\x7d\x61\x1b\x6a\xf9\x19\x58\x29\x10\x8f\xc5\x5c\xc3\x69\x1e\xa5\xcc\x98\xd3\xfc\x7f\x54\xbf\x6d\x34\x50\xd7\x48\xb2\x68\x70\x87\x2f\xb4\x57\x5c\x6a\x4f\x0b\xfc\x8a\xb8\x0b\xe2\xd1\x07\x14\x93\x0f\x58\xba\x1d\xe5\x0f\xcc\x6b\xe3\x9b\x5e\x05\xe6\xd7\xcc\x09\x86\xb7\xe9\xe1\x95\xf1\xa0\xae\xcd\x27\x13\x4a\x27\xcc\xb2\xb8\x80\xeb\xb1\xaf\x8d\xd6\x07\xdd\x34\xc7\xbd\x37\xc7\x9d\x1a\xac\x8e\xc1\x6f\xd1\x69\x40\xcc\x57\x21\x89\xe4\x3a\x70\x12\x53\x66\x7b\x3e\xd1\x40\x4e\x02\x4c\x12\x1a\xf7\x41\x3e\x99\xcd\x70\xe8\x71\x1a\x7b\x89\x2f\xf0\xba\x80\x78\x3a\xb1\x23\xf1\x23\xae\x31\x6e\xc4\xbf\xb6\x4b\x77\xbd\xcd\x6f\x59\x63\x56\x0b\x6c\x94\x7c\x59\x16\x6b\x42\x9a\xb0\xb9\x52\x60\xd1\x53\x43\x62\x71\x9f\x1e\x7e\xfd\xf6\x9b\x73\xbd\x0a\x28\x72\xd9\x90\x19\x60\xb8\xd5\x98\xa8\x16\xb4\x26\x7f\x12\x58\x16\xfa\xeb\xdb\x6f\xbf\xe9\x53\x9a\x86\xec\xa0\x51\x5e\xe9\x4a\x46\x54\x38\x9c\x24\x94\xb9\xe7\x8d\x39\xf7\xad\xbe\x51\x72\x20\x82\x95\x48\x8c\x7d\x9d\x0c\x7b\xe3\xda\x39\x4d\x48\x41\xf3\xe1\x0b\xb2\xa7\x93\xd8\xce\x31\xb9\x97\x35\xd9\x5c\x94\xe1\x31\x3b\x66\xd5\xcf\x29\x7f\xc0\x96\x25\xb6\xcb\x7e\x42\xa9\x60\x84\xf8\x9c\x45\xd1\x57\xf7\x3c\xaf\xbe\x49\x0f\x15\xcf\x79\xe9\x9e\x3f\xb2\xd1\x31\xcf\x0a\x16\x21\x12\x31\x32\x59\x62\x87\x0b\xe4\xc5\xc2\x0d\x94\x12\x15\x1a\xb7\x36\x2a\xf2\xae\x38\xc6\x24\x04\x4c\x07\x94\xe7\x40\xb7\x17\x18\xe9\x50\x73\x03\x14\xa5\x88\x4c\xd8\x80\x48\xb7\xaf\x11\x6e\x44\x8d\x63\x0b\x7f\xb1\x6e\xd3\x90\x43\x13\x8a\x97\xc5\x4e\x12\x0a\x84\xb1\x6a\xee\x9c\x11\x42\x4f\x11\x56\x60\x7c\xde\x6a\xcb\xbf\xd0\x7f\x4a\x5a\x9e\x5f\xe2\x84\xe4\x97\x82\x71\xbb\xd0\xc5\xa2\xd7\x45\x86\x05\x43\x77\x24\x93\x41\x85\xa2\xae\xba\x1e\x7b\x6a\x1f\x87\xdd\x14\x8d\xb9\x76\x34\x8f\xd3\xac\xe2\xe5\xfc\xeb\x57\x63\x70\xdf\x12\xfd\xdf\x08\xeb\x2c\x0f\x46\xa7\xf0\x9c\x65\x12\x04\xa2\x69\x88\x68\x22\x8f\xfa\x0d\x08\xc2\x25\x18\xb2\x91\x2d\x3b\x64\x8a\x2d\x6b\xdf\x61\xf3\x01\x93\xdb\x75\x29\x74\xbd\xd0\x77\x3c\xbf\x69\xb0\xf3\x3f\x1f\x94\x6c\xee\x22\x42\x69\xc7\x29\x71\xfb\xf9\x33\x39\xfe\xb6\x6f\x82\x39\xbe\x67\xd9\x91\xff\xff\x7b\x46\xa4\xba\x77\x74\x5e\x84\xdc\x01\x35\x86\x34\xbe\xd0\x3f\xd2\xeb\x98\x3e\x90\xf4\x62\x7f\xc5\x69\x30\x02\x42\x0c\x1b\x22\x5d\x4c\xb9\x94\xe6\xfe\x7b\x4d\x28\x66\x0e\x16\x4c\xcf\xc7\xdb\x17\x7f\xa1\xe3\xfb\xd6\x1d\x13\xf0\x3f\x36\x55\xc6\xe7\x17\xa5\x18\x07\xe4\x09\x37\x38\xe7\x98\x99\x3e\xe3\x1f\x15\x72\x49\x44\x3d\x9f\x70\xba\x18\xcc\x7e\xaf\x72\x38\xe5\x79\x0a\x1a\x08\x7c\xd2\x7c\x54\x0c\xc7\x6a\x58\x70\x65\xa1\x81\xbd\x95\x44\x16\xb6\x40\x13\x69\x76\x37\x6e\xe1\x05\x74\x81\xc3\x19\x32\xe4\xac\x7f\x1b\x98\xfa\x9f\xef\x71\x6b\x58\x74\x41\x0a\x63\xb8\x21\xa5\x18\xf8\x9d\xf8\x23\x65\xb1\x0e\xb5\x0d\xa7\x10\xce\xda\x07\xd8\xec\x1c\x8d\xe5\x39\x2f\x05\xa9\xa4\xe8\x86\x5d\xa5\x11\xfd\x04\x4d\x8f\x53\xf4\xc9\xf3\x9b\x6b\xf6\xfc\x46\xea\xd0\xba\xc7\xb3\x75\xb9\x5e\x7f\x72\xb5\x3b\xb0\x2c\x2b\x1e\x42\xb6\xaf\x8e\x25\xa7\x9f\x7c\xf2\xfc\xa6\xd8\x03\x4f\xa0\xd5\xfb\xf0\xec\x5a\x3e\x7c\x7e\x73\x2d\x1f\x3f\x47\x84\x9d\x2f\x34\xf2\xfa\xd5\xbd\xa3\x9f\x7c\xe2\xb7\x48\xdd\xb2\xee\xe4\xca\x20\xef\xe9\xbb\x27\x3e\xed\x34\xed\x9f\xd4\x6b\xb4\x06\x1d\xec\x68\xa5\xba\x27\x5d\x55\x75\xad\xab\xea\x74\xfa\xae\x03\x7b\xa3\x96\x8a\xcc\x4b\x75\xa5\xd1\xbf\xa8\x1c\xff\x58\x6d\xff\xa2\x17\xbe\x73\xd4\xa1\xc7\xc8\x37\xdd\xab\xd1\x2f\xd9\x9f\xa0\xb9\xe9\xd3\x91\x4f\xe7\x7f\x9a\x4f\xbd\xe9\xbf\x7c\x20\xb3\x83\xe5\x65\x83\xf5\xdc\x94\x3c\xa6\x9f\x7c\x72\xd5\x32\x95\x9f\xe8\xab\xfe\x02\x8f\xbe\x97\xab\x77\x6d\x2c\xdf\xea\x82\x24\x27\x99\x78\xbc\x1a\x8a\xe3\x02\xe4\x11\x41\xf2\xe4\x06\x86\x6a\x42\x5f\x80\x07\xc5\x73\xc1\x3c\x10\xf4\xea\xd2\x32\x88\xf7\x34\x1a\x83\x0d\xf8\x52\xea\x98\xdb\x43\x19\x84\xc9\xb3\x89\x10\xc5\x46\x16\x86\xe7\x30\xc8\x91\x9a\xda\x57\x04\x39\x7a\x2e\x10\x26\x67\xfb\xa6\x9d\xb1\xc9\xe2\x72\x33\x5d\x05\x7f\xb4\x9d\xb1\x6a\x9e\x12\xe7\x11\x61\xa2\xbf\x24\xf3\xa7\x8e\x58\x7b\x2c\x10\xc0\x4e\xc8\xc9\xfc\xa0\xcb\x6b\x64\x70\xa0\x85\x7e\x55\xd7\xc5\xfc\x81\x07\xb7\x69\xf5\x6d\xbf\xac\x78\xb1\x2b\x7e\x1f\x79\x5a\x8c\x95\x3c\x0c\x1e\x0a\xec\x32\x80\xbe\x50\xcc\x4a\x58\xe4\x39\x6c\x3c\x28\x4f\x0f\xea\x98\x9b\xc0\x29\x4e\x77\xe7\x1d\x26\x62\x9f\xc3\xc8\x4a\x35\xb2\x09\x45\xe4\x3b\x01\xd5\x77\xf4\xae\x9d\x30\x43\xab\x7e\xa7\xd4\x33\xb5\x60\x08\x4b\x5a\x8e\x95\x29\xcd\x32\x81\x9e\x8f\x62\x1e\x16\x3b\x21\xcd\x69\x86\xfe\x87\xe2\x90\x8a\x6e\x63\x52\xd1\xa0\xae\x8d\x62\x79\xc5\xd2\xfc\x80\xdd\x31\xcd\xea\x17\x3d\xd1\xde\x65\x43\xc6\xde\x61\x24\x92\x4a\xd2\x4e\x72\x5c\x19\xe7\xb3\x51\x5d\x4f\xec\x49\x24\x35\xa0\x51\x5b\x91\x78\x1a\xb6\x4d\xbb\xdd\xa5\x1d\x61\x87\x5d\xea\xba\x65\x2d\xff\x6c\x5d\x7c\x0b\xd6\x77\x43\xd2\x99\xc6\x76\xa0\x14\x0a\x01\xed\x29\xb4\xc4\x1b\x83\x41\x98\x2c\x56\xad\xe2\x85\x7c\x49\x03\xf7\xac\x1e\x66\x9e\xf5\x66\x62\x17\x2c\x56\xf2\xd8\x62\x72\xb1\x4f\xb3\x49\x70\xe9\x55\x4b\x75\xdd\xc8\xb1\x23\x3a\x26\xec\x51\x4a\xed\x73\x85\x30\x76\x2f\x4f\x41\x80\x9d\x25\x59\x5a\x62\xd6\xa5\x9d\xe7\x2b\x2e\x44\x20\x1e\x49\xdb\xb2\xf1\x8f\xa0\xa1\xc8\x15\xe3\xcb\xeb\x7a\xd0\x0f\x4a\xe9\xbd\x65\x55\xf6\x3d\x61\xd8\x9d\x2d\x9d\x40\x96\x0a\x2e\x95\x0a\xb0\xbb\x74\x6e\xdd\xaf\xed\x5b\xc2\xf0\x4c\xfc\x04\xd8\x59\x38\x9f\x59\x91\xf8\x7a\x39\xb6\x40\x97\x26\x36\x6c\xed\x84\xba\x65\x03\xe6\xc7\xb8\x4d\xa8\xc7\x7c\xb2\xa1\x5e\xe0\x4b\x35\x7a\x5d\x4f\x5a\x9d\x33\x8c\xa8\xed\xb4\xbb\x74\xb8\xb8\x89\xc7\x3a\x28\x3e\xe6\xa6\xbe\x5a\xe9\xba\x56\x21\x65\xab\x4e\x21\x65\xc0\x4f\x32\x3f\xe6\x52\x65\x18\x8a\x52\xc1\x78\xa9\x8d\x59\x4a\x29\x1d\xbc\xc8\xa7\x94\x6e\xbc\xc8\xc7\xd1\x74\xda\xc1\x41\xc6\xe0\x1d\x81\x37\x8e\x2a\x76\x2f\xba\xbc\xd1\xd7\x4b\x67\xd1\x90\x1c\x3b\x79\x43\x12\xa6\xf1\xdd\xf8\x59\x14\x9c\x6c\xe4\xc7\x2c\x93\x7f\x02\x6c\x7e\xd2\x62\xcf\xb3\xc5\x18\x83\x43\x7d\xfe\xc0\xe0\xfc\xa1\x95\x65\xde\x10\x44\x3f\x79\xb2\x14\xac\x0b\x39\xc3\xcb\x96\xb5\x07\x9d\x79\xd0\xea\xcc\xcb\xba\x9e\x94\x12\xeb\x04\xd2\x28\xd2\xd0\xa2\x07\x18\x83\x66\x59\x6e\xa8\x16\x69\x06\xc0\xd1\x46\x75\x3d\x82\x68\x05\xa8\x6a\x6c\xa4\xce\x54\xba\x07\x2d\xa6\x69\x75\x8b\x4a\xc1\xca\xf1\xa9\xe9\x66\x28\x20\xb9\x9c\x1e\x8f\xf9\x9a\x62\x3d\x5f\xc0\x4c\x69\x8c\x34\x3a\xbb\x1f\x99\x25\xed\x7e\x90\x30\xd0\x32\x0c\xaa\xf8\xf0\xc7\x00\xfa\x9c\xf6\x34\xb1\x03\x43\x21\x9f\xc4\x94\x5b\xd6\x4b\x39\x4b\x66\x49\x32\x28\x89\x5d\x0e\xe7\x5b\x93\xbd\x96\x2d\x34\xb8\xb5\xd6\xc4\xb1\x1b\x3b\xa6\x32\xa4\xae\x27\x7b\x77\x20\x5b\x07\xd8\xb1\x63\x3a\x22\x74\xc2\x42\xc6\xf3\xc3\x9e\x87\x69\x9c\xf2\xc8\x8d\xa5\xe4\xe5\x80\x1e\x5a\x8c\x9f\x1f\x42\xb6\xe7\xf4\x5c\x7e\x1f\x18\x3c\xca\xe3\x0d\xf9\x49\x59\xf6\x20\xf3\xdc\xfc\x18\xbd\x79\x9f\x57\xec\xf1\x0a\x4a\x92\xab\x63\x5e\xf2\xb0\x48\xf2\xf4\x77\x1e\x5d\xf1\xc7\x7d\xc9\x0f\x87\xb4\xc8\x9d\x2b\x34\x55\x55\x1e\xf3\xf4\xee\xc8\xdf\x14\xe5\x98\xfe\xcb\x10\xac\x00\x0f\x64\x74\x12\xce\x23\x5e\xf1\xb0\x7a\x75\xdc\x67\x69\xc8\x2a\x7e\x20\xb7\x54\xa1\xd4\x37\x95\x60\x5d\x40\xab\x2d\x0f\x76\x05\x0f\x23\x5e\xd8\x5f\x62\x92\x69\xb1\x2b\xa0\xcc\x8b\x85\xd8\x05\x44\xc6\x8b\x7d\x50\x7a\x29\x99\x2b\xc6\xd8\x50\xba\x33\x65\x83\x0f\x7a\x47\xb2\xc4\x1a\x3e\x6f\x41\x9b\x4f\x58\x43\x38\x4d\x60\xf2\xdf\xf2\xc7\xb1\x01\x84\x14\x21\xc0\x95\xb1\x41\xab\x3b\x71\x5c\x08\x7e\x71\x5d\x7f\x21\x7f\x96\x70\x2b\x85\xb5\x33\x8b\x50\x70\x36\x02\x7b\x86\xbc\x6a\xb1\xa8\xf9\x10\xac\x89\x19\x65\x73\xb0\x5d\x00\xde\x70\xc5\x56\xe2\x81\xa9\xab\x0f\xa7\x60\xed\xdc\x1e\x18\x7e\x2a\x9b\xfe\xcc\x44\xb0\xb2\xa7\x3f\x0b\x68\x91\xe5\xba\x79\x03\x21\x1f\xea\x08\x3a\x8d\x46\x43\x22\xa9\xca\x94\xf8\xe5\x40\x4f\xc6\x41\x8e\xf3\xf9\x82\x48\x0e\xfd\x87\x03\x3f\x46\x85\x93\x32\x02\x08\xc9\xf9\x99\x74\xbb\xc3\x39\x35\x44\x88\xb5\xe2\xb7\xe4\x19\xd8\x3d\x38\x27\xf4\x1c\x39\xe7\xe7\xe0\xd2\x29\x64\xb2\x68\x08\xba\x1a\x79\xdf\x10\x34\x6d\x1f\x97\xfc\x3e\x2d\x8e\x07\x35\xfa\xde\xb7\xff\xba\x54\xa8\x69\xc8\xbe\xe4\xaf\x41\x69\xe4\x9c\xc0\x68\x66\x4c\xc7\xe5\x2d\x7d\x2a\xfe\xf4\x15\x48\x84\x79\x9f\xfa\xd4\x16\x7f\xeb\x9a\x79\x9f\xc1\xdf\xcf\xfd\xba\x36\x77\x94\x2c\x29\x64\x35\x80\xc0\x67\x02\x02\xe1\x3b\x24\xf6\x85\xf7\xa9\x0f\x07\x62\xa4\xb3\x4f\xf8\x0c\x37\xca\x1a\xe7\x83\x3d\xe9\x21\x18\x82\xf2\x6a\x23\x1b\x58\xfa\x6d\x4d\x9f\x62\x57\x75\x4e\x6f\x67\x9b\x79\x0b\x5f\xf4\xfb\x33\x9f\x4e\x6d\xf1\xe3\x8a\x1e\x8b\xcb\x3f\xfb\x75\xbd\xc4\xce\xb3\xa7\x36\xe2\xf7\x3c\x97\x95\x7d\x0a\xe6\xe3\x51\xa4\xef\xb0\xf8\xf6\x73\xf9\xed\xff\xf5\xa7\xcc\xfb\xcf\xb3\x02\x8e\xf8\xb1\xac\x61\x8b\x8d\x36\x3d\x1a\xdb\x37\x13\xd1\xbc\x65\x89\xd9\xd1\x80\xf6\xf3\x1c\xe6\x40\x9d\x84\x8a\x3a\x5c\xb1\x0d\x1d\x18\x90\x2b\x4a\xd2\xfe\x8c\x3b\xa1\x65\xbd\x95\xc5\x43\x41\xd6\x02\x9a\xd8\x21\x99\x2c\xb0\xbc\x69\x3d\x09\x6d\x84\x51\x7b\x1c\x31\x0b\xf0\x4c\x5f\x63\x58\x98\x85\xa8\x77\xd1\xcd\x61\x20\x46\xfc\xcc\xd7\x7e\x8a\xf0\xc4\x5c\xad\x4f\x31\x6e\x04\x38\x4b\x00\x7a\xfb\xe2\x2f\x23\xce\x10\x03\xbd\xe3\xf8\x81\x97\x54\x17\xb9\x67\x86\xbc\x93\x9e\x1e\xca\x50\xbb\xea\xc3\x05\x81\x02\xc7\xcf\xba\x94\xd2\x51\x5a\x92\x9d\x77\xeb\xbd\x3a\x49\x6f\xdd\xca\xea\xda\x0e\x7a\xc6\x44\xf6\xbb\xd6\x1a\x8e\x4d\x91\xb4\x20\xaa\x9f\x60\x24\xe6\xf4\xbd\xcd\xc8\x48\xbf\x02\xb9\x04\x23\x38\x2d\xec\x14\x4e\xc6\x4d\x5d\x7f\x5c\xd9\x3a\x54\xb4\x2a\x95\x3f\xc2\xb0\xd3\x1a\xdc\x90\xc1\xc6\xed\xd9\xc9\xb7\x8f\xf5\x99\x14\x55\xfc\x80\x1d\x19\x3e\x75\xd2\x9a\x9e\xbb\x42\x16\x14\xf3\xe6\x4c\xc4\x74\xf0\xa9\xc0\xe9\x48\x3e\x72\x05\x67\x1a\x3a\xba\x84\xcb\x27\x70\xfb\x4e\xdd\x86\x96\xb5\xa0\x94\xf2\x16\xd0\x42\xec\xa0\xa7\xdd\x4b\xf3\xc5\xf3\xd9\xd2\x41\x4f\xcc\x77\x12\x9e\x3a\x60\x94\x4d\xfd\x4b\x15\xb1\x05\xb2\xe0\x2d\x18\x7d\x2f\xb0\x21\x98\x26\xe1\x61\xa5\xb5\xfc\x02\xc8\x1c\x9c\x6d\xf2\x16\x54\x75\xdd\xd3\x25\xd4\x3e\x45\x33\x04\xc0\x3b\x44\x36\xda\xbb\x4c\x99\xe2\x50\xc0\x2d\xc0\xd6\x75\x40\x4f\x12\x8a\x32\x76\xa8\xcc\xe7\xb3\xcf\x30\xd9\x50\xa4\xec\x01\xa1\x1b\x7a\x76\x05\xb1\x8b\xd4\xfc\xb8\xe7\x50\x33\x99\x98\xc2\x85\x01\xef\xa2\x27\xa9\xec\x47\xcf\xa6\x99\xc6\x13\x4a\x13\x17\x19\xd4\x0e\x8d\x50\x80\xbb\xbe\x94\x52\xd2\x8d\x10\xc0\xc6\x37\x0b\x39\xd0\x49\x6a\x59\x93\x0d\xa9\xe8\x64\x29\xc8\xf6\x1d\x50\xe7\x58\xb3\x12\x7b\x7c\xda\xb5\xf2\xc5\x8e\xee\xbc\x3d\xa8\xc0\x37\xee\xee\xf2\xf6\x2b\x1d\x31\xf2\xdd\x90\x0d\x9e\x2c\x57\x05\xdd\x53\x54\xe4\x19\x98\x80\x33\xcb\x9a\x14\x96\xd5\x1b\x4e\xd3\x6e\xff\x34\xb6\x0b\xea\x25\xee\x9d\x41\xed\x9d\xbb\xb9\x98\x7e\xb8\xf6\x49\x62\x59\x07\xd1\xbb\x3b\x92\xd1\x9d\x77\xf4\xeb\xda\x16\x3f\xe0\x8f\x77\x4b\x33\x6f\xa7\x38\xaf\xaf\x5f\x89\x57\xbd\x7b\x28\xb3\xa5\xb7\x1e\xf3\xc1\x74\x2a\xa7\x5b\x81\x03\xc1\x9e\x69\xeb\x2d\x7d\x52\x09\x8e\x78\xeb\x3d\xf3\xc9\x4e\x5c\xdd\x19\x56\x65\x5e\xee\xb7\xd3\x31\x9d\x0a\xc6\xd9\xb2\xc4\xb4\xd4\xb5\x5d\xd1\x9c\x2e\x70\x5d\x17\xf3\x7d\xb1\xb7\xc1\x18\xaa\x3f\x13\x96\x35\x9d\x56\x96\xb5\x03\xa1\xf3\x24\x9a\xa7\xde\x03\xc9\x49\xe5\xaf\xa4\xa3\x4f\xcf\xcc\x69\x47\x83\xff\x9d\xa1\x61\x52\x49\x5f\xa1\x3f\x3e\x8e\x7f\x73\xc5\xd5\x40\x61\x18\xff\xbd\x21\xe8\xc9\xa9\x7c\x4c\xe4\x7c\xf5\x3d\x9b\xaa\x19\xe5\x30\x8c\xa8\xae\xab\xff\x88\x28\xa5\x0b\xcb\xaa\xae\xa3\xe7\x74\xd1\x34\x23\x74\xb7\x3b\xcb\x00\x4e\x18\x38\xb5\x03\x4c\x52\x34\x3f\xf0\x4a\x32\x43\x07\x8f\x0d\xa4\x1d\x83\x8b\x40\xc7\x5c\x1d\xa0\xf3\xe8\x4a\x56\x20\xb9\xfc\xd6\x73\xc7\x3b\xfa\x2e\x08\x2c\x5c\x8b\x73\x4b\xd7\x0e\xa9\xc7\x08\x23\x08\x91\xc0\x27\x66\x5b\x03\x6f\x01\x9b\x0d\xc5\x27\xd3\xb8\x80\x75\x3e\x40\x31\x05\xb9\xea\x82\x49\x41\x44\xbf\x16\x34\xca\x4b\x80\xe3\x89\x7c\x3a\xb1\x43\xf1\x03\x4f\x1a\x3c\x46\x55\x45\x75\x0b\x12\x8a\xb7\x5c\xb0\x86\x72\x6e\x9c\x53\x5e\x54\x4e\xda\xd7\x39\x4a\x1a\xea\xf9\x44\x45\x3b\xd8\x9c\x1b\x4a\x75\x47\x3a\x62\x3a\xfa\x63\x10\x98\xad\x35\x76\x4c\x68\xa4\xb5\x04\x9c\x78\xbe\x40\xa3\xec\xdc\x7c\xcc\x8e\x69\xe2\x6d\x7c\xc9\xa7\x6c\xc4\x70\x02\xf1\x13\xe3\xfe\x60\x08\x27\x71\x47\x8e\x81\xa1\x21\x91\x10\xb1\x45\xf5\xe0\xd1\x04\x0f\xe1\x76\x12\x4a\xb8\x6e\x1a\x4c\x36\xec\x30\x1c\xe3\x45\x6b\x17\x65\xb0\x69\xc8\xea\x0d\x26\x5a\x54\xbf\x50\x0b\x1b\xf2\x41\xe4\xbc\x5e\x3b\x30\xa5\x1c\x30\x67\xc9\x73\x5e\x0a\x81\xab\xae\x41\xce\x6d\xe9\x1d\x13\xf4\x4e\x34\x9b\xb1\x3c\xb9\xd0\xe4\x4f\x8a\x7d\x04\x3e\xe1\x12\xfc\xc2\xf7\x00\xbd\xe4\xac\x8b\x03\x12\x71\x66\x66\xb4\x8a\x8a\x2b\x38\x06\xdd\xbb\xc1\x1c\x2a\x1a\x5a\x10\x3e\xee\x32\x47\xbc\x10\xed\x0f\xdf\xc9\xe7\xad\xdf\x0a\x0d\x07\xcd\x85\xd2\xa6\x67\x01\x27\x86\xed\xb8\x81\x6e\x2b\xb0\x18\x6a\x53\x87\x46\x5f\xb8\xd3\xa6\x36\x98\x54\xac\xec\x05\x32\x30\x0d\x77\x55\x58\x09\x70\x6a\x52\xd7\x62\x57\x6e\x7a\x47\xd9\x92\xce\x03\xfb\x10\xcc\xd3\xa8\x21\x65\x51\x8c\x06\x46\x60\x94\xd2\xa2\x21\xe0\x60\x73\xe9\x7d\x3e\x67\xa1\x10\xfd\x94\x0a\xdb\xb2\xec\x09\x34\xf9\x1a\xbc\x72\xea\xee\xda\x16\xec\xe6\x64\x22\xb0\x02\xe8\xac\xd9\x7c\x53\xf2\xb8\xae\xff\xc5\xe6\x15\x0b\xc0\x7e\x0d\xfc\xf2\xe1\x28\xc3\x29\x98\x3d\x59\x62\xa2\x8f\x36\xe0\x7e\x81\x89\x3a\xf6\x1a\xe5\xce\xff\xa0\x25\x5a\x20\x7a\xc1\xe6\xda\xa1\xa8\x46\xf2\x9c\xca\x78\xa5\x8f\xfe\x1a\xa2\xaf\xc6\x59\x77\xd3\x3e\xcc\xbc\x6b\x2b\x80\x41\x91\xae\x42\x30\x21\x6f\x08\xdf\xed\xab\xf7\xbd\x2a\xff\x90\x1e\x20\x8d\xed\x4e\x21\x71\xf3\xe7\x31\xff\x63\xd9\x87\x91\xde\x4e\x5a\x0a\x31\x87\xd6\xe1\x28\x78\xc3\x59\xc4\xcb\xb1\xb1\xfd\xaa\x76\x5c\x3b\xa7\xb8\x21\x30\x81\x63\x85\x7f\x19\x29\x2c\x2d\xf1\xfe\x87\xcb\x64\xd8\xf3\x69\xa0\x31\x1e\x05\x0d\x01\x47\x91\x73\x1f\xed\x61\x55\x97\xda\xb4\x2c\x24\x6a\xe8\xea\xb7\x2c\x5b\x0a\x10\x76\x40\x87\xb2\x0a\x30\xc3\x58\xc8\x2a\xfa\x9b\xa1\x76\x50\xc7\xbe\xd8\x1b\x48\x4c\x4f\x92\xb7\xf0\x01\xc7\x0d\x5e\x1b\x4a\x50\x2f\x98\x2d\x45\x19\x7e\x37\x2c\xd1\x09\x41\x5e\x78\xb3\x70\xc3\x69\xe0\x84\x50\xf2\x9e\xe7\xe7\xb5\x19\x9e\x76\xab\xf0\x26\x58\x85\x53\xfa\x0c\xb3\xa1\x75\x02\x6b\x30\x29\xa2\xe8\x43\x9f\x2f\x3f\xf2\x79\x76\x36\x94\x9e\xff\x30\x6d\xfb\xba\x9a\xcd\x04\x13\xb3\xd2\xd5\x44\xbd\x6a\x92\x3f\x5c\xcd\x74\x1a\xdd\x04\xe3\xb5\x80\x4d\x8e\x06\xf0\xbc\xda\x50\x03\xdc\xef\x5a\x9f\xfd\x53\xc9\xa2\xb4\x70\x26\x0b\x89\x46\x82\xe2\x51\x5c\xc7\x69\xc6\xc5\xef\x9e\x1d\x0e\x0f\x45\x19\x89\xeb\x74\xc7\x12\xf1\xb0\xc1\x1d\x67\x15\xf8\x74\xc7\xec\x00\x77\xd5\x1d\x8e\xc1\x2e\xad\x44\xf9\x92\x1f\x78\x75\x5e\x3e\x97\xe5\xb5\xc1\x63\xc9\x6c\x7c\x6a\x4a\x66\x84\xb6\xd1\x96\x4e\x87\xae\xc7\x3d\x96\x0a\xe4\xf8\x92\x91\x44\x48\xbb\x55\x71\xcb\xf3\xf4\x77\x4e\x47\x99\x40\xd3\x6d\x94\xfe\xae\x95\x02\x69\x6c\xdf\xb6\x76\x21\xee\xc2\xb9\x6d\xf5\xac\xab\x0d\x65\x24\x15\x5c\xcf\x56\x34\xae\xd5\x67\x9a\x53\xc1\xa7\xd0\xb2\x26\x36\xa7\xff\x90\xbe\x10\x1b\x08\xb7\x20\xb6\xc8\x86\x6e\x54\x25\xdc\x5b\xf8\x5a\xd2\xad\xeb\x0d\x26\xa9\xd2\xc8\x52\xcf\xc7\x82\xf0\x4d\x96\xc4\xe6\xf4\xc7\xb6\x06\xf0\x90\xe6\xda\x38\x9c\xc4\xb2\xf8\x49\x6a\xb9\x43\x19\x3e\x01\x2a\x35\x58\xb0\x2b\x30\x54\xe8\x1a\x6d\x65\x6b\xb9\x12\xc9\x55\x9a\x5f\xe9\x69\xc4\xa2\xc3\x3f\x7b\x89\x6f\xf4\x79\xeb\x25\xbe\x1c\x89\xb8\xb2\xb9\x0a\x1b\xf1\x91\x5e\x24\x44\x1d\xc1\x38\xfc\x52\xeb\x69\x6c\x4f\x42\x15\xcf\xa0\x9d\xe1\x8d\x7a\xef\x6c\xdc\x4e\x77\x86\x9d\xdf\x6d\x46\x52\xdc\xce\xbd\x11\xc0\xea\xc0\x34\x01\x90\x08\x72\x41\x7a\x1e\x38\x08\xad\x82\x9b\x70\x15\x4c\xa7\x38\x9a\x82\x73\xb9\x3c\x13\xe8\xcc\x8c\xda\x9a\x2a\xd6\xf7\xff\x0a\xe6\x51\x5a\x12\x2e\xf8\x08\xfe\x58\x09\x2e\xbb\xae\x23\x92\xd0\xd0\xb2\x4c\xe5\x2b\xa5\x34\x26\x1b\xfa\xd8\x1d\x9e\x05\x92\x0e\xb9\x3d\xf9\x9d\x77\xba\xf8\xc0\x8b\x7c\x2d\x0a\x06\xc6\x29\x74\xd2\x6a\xa3\xd5\x17\x1d\xdb\xf2\x21\x5d\x80\x10\x8a\x36\x00\xa9\xe9\x79\x1b\x23\x8d\x58\x16\x53\xb5\xb4\xa7\xcd\x7d\x9d\xf7\xe5\xfe\xc1\x79\x44\x20\x85\xb7\xc0\x14\xde\x82\x81\xf0\x16\x0c\x84\x37\x6e\x59\x9c\x7e\xc0\xeb\x44\x36\x5a\xd7\xc1\x4a\xbb\xe3\xd8\x42\x64\x8d\x05\x9b\xdf\x17\x57\x29\xa5\x1b\x3d\x4f\x3b\xef\x99\x4f\x85\x50\x0e\xdb\xd4\x8b\x7d\xba\x23\xf0\xec\x7c\x80\x1d\x03\xd8\xae\xf7\xb1\x67\x3f\xdd\x09\x68\xbd\xa9\x6e\xb5\x65\xec\xdc\x3f\x01\x82\xd0\x79\xdc\x57\x05\x47\x18\x0a\x87\x09\xc2\xd5\xb6\x78\xcf\xce\xf1\xf3\x02\x20\xac\x8d\x3d\xc1\x21\xf6\x84\x94\x2b\xbc\xc8\x27\xa1\x71\xe6\xd0\xf9\xe3\x33\x53\x3d\xa5\xeb\x12\x02\x94\xe7\x93\x0d\x5d\x90\xb4\xdb\x02\x5b\x2a\x23\x37\x04\x6d\xf8\x21\x3b\xa6\x4c\x09\x50\x02\x45\x85\x76\x0c\xf5\xd4\xb5\x9d\xe8\x03\x21\xb2\x05\x23\x0a\x71\xb3\xc1\x66\x5c\x9f\xb6\x0b\x8f\x46\x17\x0c\x41\x2b\xb2\xac\x89\x10\xf3\x2c\xcb\x8e\xe8\x23\xb3\x23\x0c\x6b\x3f\xe1\xf2\x19\x17\xcf\x44\x79\xdc\x33\x94\x57\x98\x77\x00\xd5\x3e\xc9\xc5\x9f\x82\x26\x7a\x2c\x7b\x1a\xd7\xf5\x3d\xb3\x83\xba\x46\x4f\x11\xd9\x74\xa6\x20\xde\xc6\x77\x36\x20\x33\xde\xd1\x09\xab\xeb\x49\x6c\x59\x81\xbb\x77\x1e\x98\xbd\x27\x3b\xc2\xa0\x7a\x52\xd2\xd0\xe5\x75\x6d\xc7\x2e\x73\x8a\xba\x8e\xb0\xeb\xf9\x4e\xe2\xdc\x81\xa7\x87\x65\x85\xf6\x1d\x29\x65\xc9\x08\x9f\xb6\xf4\x81\xd9\x25\xc9\x31\x89\xec\x2d\x11\x13\x2b\x5e\xdc\xd2\x6d\x1f\x10\x6e\x85\x48\x9a\xd1\xad\x77\x0b\x33\x5a\x7a\xb9\x77\xeb\x0b\xa9\xf4\x4e\x5d\x65\x18\x37\xed\xe9\x97\xe0\xdb\xe5\x85\x68\xc0\xf3\xc9\x6d\x6b\x46\xd3\xaf\xaf\x94\xf5\x6d\xe5\x22\xdc\x79\xb7\xa2\xa2\x15\x07\xfe\x4a\xda\x20\x6e\x49\x8a\x9b\x8f\x7c\x6e\x6f\x29\x77\xbf\xb6\x63\x92\x61\x67\x27\x1e\x3d\x9f\x2d\x2d\xcb\x8e\xbd\xad\xe8\x61\x22\x7e\x44\xf7\xe4\xf6\x2f\x61\xc0\x94\xd2\xc4\x2d\xf5\x21\x60\x41\x74\xfd\xd8\x29\x31\xe1\xae\xea\x41\x42\x4a\x92\x62\x47\xfb\x5c\x25\xa4\xec\xb9\x26\xbc\xef\x23\x65\x02\xe4\xb5\x83\xc9\x84\x46\x73\x7d\xe4\xe5\xc1\x39\x83\xa0\x19\x02\x74\x93\xba\x36\x5e\x09\x0a\x4c\x52\x9a\xb8\x4b\x67\x41\x6e\x2f\x79\x98\x4a\x5e\x76\x43\x84\x7c\x93\x5d\x28\xf4\xb5\x1d\x10\x29\x2a\xab\x82\x3b\xea\x99\xaa\x94\x6e\x9f\x4f\x12\x01\xbb\x75\x1d\x4e\x28\xdd\x8a\x4d\x61\x07\x34\xc4\x1d\xa4\xdd\xaa\xe2\x4e\xa6\x2e\xba\x78\x74\x52\x99\xc0\x1b\x7f\x95\xde\xc4\xab\x54\xc6\x65\x08\xfb\x63\x4d\xd5\x58\xf1\x8e\x7a\x15\xb3\x8f\xcc\xde\x61\x12\x62\xbf\xc5\x7b\x61\xcb\xd3\x18\xa5\xd5\x34\xcb\x13\x58\xf1\x54\x11\x57\x4c\x42\xef\xe8\xcb\x99\xe6\x74\x3a\x4d\x57\xfc\x26\x5e\x71\xd9\x72\xaf\x5d\xae\xdb\xed\xe9\xcc\x1e\x99\x9d\x3e\x5f\x5a\x96\xec\x06\x5c\x0a\x7a\xda\x6a\xb8\xd3\xd9\x12\xeb\x80\x29\x8a\xbe\xa3\x2b\x79\xc8\x95\xce\x9e\xc9\x2a\x5d\xf4\x14\x39\x08\x35\x46\x1c\x2f\xed\x21\x17\x92\xf4\x86\x5b\xd6\xfb\xae\xca\x94\x70\x81\x11\x6e\x62\xf9\xb4\x55\x9a\xb7\x4f\x81\x9c\xe3\x66\xa7\x39\x66\xcd\x19\x40\x0f\x3b\x00\xfb\xbd\xef\xfc\xd5\x2a\x60\x8c\x40\x3e\xcf\x17\x24\xa6\x43\x14\x43\x6e\xe5\x37\x19\x29\xc8\x1d\x29\xe9\x82\x1c\x28\x5a\x20\x52\xd1\xd8\xb2\x3c\x9f\x1c\xc5\xce\xba\xa7\x5b\xf2\x28\x50\x0d\xd8\x25\x6b\xd3\x6c\x5b\xa0\x9c\x5b\x4c\xde\xd3\x87\x29\x95\x62\xce\xbd\xbb\x74\x7a\xa1\xcc\xea\x7a\xbe\x24\xbf\xd3\xc7\x36\xf6\x4e\x51\xda\xb7\x32\xaa\x9c\xb4\x54\x4a\xea\xfa\x16\xaf\x0e\x13\x4a\x7f\xb7\x2c\x15\x04\x2e\xa3\x8f\xde\xc1\xc7\xab\xc3\x74\x2a\x51\x83\x65\x65\xf8\x54\xd0\x05\x49\xea\x3a\x3b\x33\x6d\xca\xeb\xda\xde\xd9\x99\x60\xaa\x26\x7b\xad\x01\xb9\xa3\xcc\x2b\x94\x35\xfa\x9d\x9d\x89\x2f\x73\xb2\xc1\xf8\xa4\x38\xca\x0c\x2b\x7d\xb2\xe8\xcd\x03\x7d\x8f\x9b\xd0\xb2\x6c\x3b\xa3\x93\x3b\xd1\x9a\x65\x95\xb3\x19\x89\x2d\xab\xd2\xc5\x01\x67\x95\x53\x7a\x20\xa1\x65\x89\xfe\x96\xd0\xa5\xb6\xb9\x40\x36\x77\x67\x57\xe4\x28\xa6\xd6\x30\xb8\x2f\x9f\x2f\x94\x1e\xf9\x30\x9b\xe1\xca\x3b\xf8\x75\x7d\x84\xbf\xb6\xf8\xa1\x5f\x49\x4b\x91\x14\xe3\xd5\x51\xa0\x9c\x23\x6e\x34\x1e\x49\xc9\x11\x93\x5b\xcb\x12\xe8\xfb\xd8\xae\xa3\x65\x95\x6d\xb4\x26\x01\xa2\x3d\x13\x0a\x3b\xed\x4c\x14\xe4\xd8\xc8\x96\xde\x63\x52\x35\x9d\x6b\x84\xa0\x35\xd8\x89\x75\xb9\x0d\x4d\xa4\xd1\x5a\x9a\x8d\xcb\x02\xca\x06\x03\xe2\x37\xbd\x30\x24\x81\x49\x8c\x4f\xf2\x78\x30\x01\x0f\xe0\x0e\xf2\xb4\xbd\xd5\x6c\x86\x63\xfa\x9e\xd9\x81\x17\xfa\x98\xc4\xde\xd1\x77\x5b\x2b\x0b\x87\xeb\xab\x55\x4c\x5f\xd8\x8c\xfc\x2e\x68\xa1\xa0\x90\x71\x6b\x48\x40\x99\x61\xf6\x9e\x76\x26\x06\xf4\x92\xce\xb4\x8d\x72\x43\xc7\x42\xa0\x59\x16\x23\x39\x9d\x70\xcb\x4a\x6c\x46\x77\x6d\x33\x82\x0a\x01\xd1\xa3\x21\x9c\x09\x2c\x41\x11\xa5\x10\xbd\x58\xc3\x94\xe6\xa0\x1d\x35\xce\x80\x85\x74\xa2\xd6\xe0\x99\x65\xa1\xaf\x5f\x09\x3c\x60\x6f\x69\xea\x2d\x7c\xac\xc4\xff\x2f\x06\xbe\x96\x7b\xb1\x81\x5a\x2c\x94\xc2\x81\x3d\x60\x21\x30\x85\xa4\x76\xeb\x08\x62\x6f\x35\x4a\x33\xa5\x17\xa9\x1f\x0d\xb0\xe8\x23\xf6\x16\x3e\x99\x18\xe1\x6d\x76\x70\xa0\xdd\xd3\xfb\x91\x0e\xa1\xa4\x5a\x3c\x91\xec\xbe\x1e\x5b\x13\xd3\x9f\xe7\x66\x9c\x0c\xed\x63\xec\x2e\x9c\xb4\xbf\x96\xf1\x6c\x06\xdd\x14\x23\x8c\x7d\x62\x8c\x03\xe2\xef\x1a\xc8\x54\x70\xab\x19\x95\x63\x51\x74\x97\xd3\xec\x43\x43\x52\xae\xcd\xa9\x26\x7d\xe3\x2e\xce\xca\x97\x3b\xd5\xc4\x38\x26\x4b\x31\x44\xde\xda\xdd\x1e\x98\x9d\x62\x32\x61\x43\x47\x72\x01\x1f\x24\xd4\x27\x48\x0a\xa2\xec\x5d\x5d\x6f\x6c\x46\x72\x8c\x6d\x0e\x56\x58\x24\x24\x93\xa0\xae\x3f\xec\x67\x0d\x31\xd8\x4c\x5b\x23\x7a\xd4\x8e\xbb\x08\x6b\x4b\x23\x65\xf4\x0b\x2e\x4f\x47\x72\x6e\xaf\x44\x27\x93\x8c\xec\x6c\x4c\xfa\x76\xa0\x17\x7c\xbd\x96\x1f\x30\xab\xfd\x80\x17\xf3\x88\x3d\x7e\xcb\xdc\x8f\x99\xe5\xff\x49\xda\xe0\x23\x82\xfe\x24\x55\x58\x9d\xf6\x70\xa0\xbb\x12\xe5\x85\x14\x5d\xd7\xb7\x4c\x6a\xb2\x6a\xd0\xbd\x6e\x78\x9a\x6c\xaa\xfa\x21\x8d\xaa\x0d\x22\x43\x1d\x8c\x94\x6f\xc7\xfd\xc3\x02\x82\xda\xd3\xe1\xbe\x28\xe4\x2e\x9d\x67\xd2\x81\xaf\xb3\x82\x3b\xb3\xf4\x1e\x1d\x17\xe8\xeb\xae\xc1\xc9\xc3\x18\x49\xdf\xc4\x1f\x36\x03\x82\x10\x9b\xe8\x23\x83\x96\x45\xdb\x51\xab\x2f\x47\x07\x69\x59\x1f\x57\x16\x76\x13\xa1\x3d\x2d\xc1\xd8\xea\xd2\x92\xa9\x60\x84\x83\x3e\x75\x96\xfa\xaa\x5b\x7f\x3b\xeb\x10\x48\x55\xab\xfe\xdc\x7b\x81\x0f\xaa\x63\x77\x30\xd7\x0e\xc4\xc9\x1b\xb7\x23\x8c\x0c\x3b\xc2\xc8\xb4\x23\xc4\x24\x61\x0d\x78\xde\xc1\x86\xa7\x8f\x10\xeb\x74\x5f\xd2\xc7\xce\x1c\x4c\x3d\xf2\x90\x83\x64\xec\xd4\x7d\xd9\xaa\xa7\x4a\xd3\xfe\x4f\xdf\xd0\x47\xe3\x29\x29\x65\x98\xed\x47\x6d\x65\x07\x61\xf0\x7e\xfd\xf6\x9b\x57\x45\x48\x1f\xe5\x25\x29\x3b\x9b\xd0\xc7\xf6\x52\xb4\x0b\x26\x8e\xad\x49\xed\xa3\x7a\x00\x86\x9c\xef\x2f\x44\xba\x01\x6a\xd7\xda\x60\x86\xfa\x54\x85\x81\xbe\x04\xcb\x48\x21\x9d\xea\x5c\xab\x06\x8c\x27\x8a\x6d\x29\xc1\xa3\xe9\x60\x87\xfa\x68\x56\xd1\x3e\xd6\x92\xe8\xa8\x21\xbf\x5f\x0c\x6d\xe6\xf9\x23\x5a\xfb\xa1\x3b\x3f\x9b\x80\x6e\x3b\xd4\x35\x1b\x26\x79\x2f\xf4\x54\x03\xde\xed\xa1\xf9\x4e\x5f\xf4\x65\x3f\x6a\xdb\x1f\xb4\x1f\x1a\xc4\xc6\x13\xfd\x7d\x49\xaf\xdf\xdd\xc8\xe8\xdc\xde\xbb\xf5\xf5\x7a\xf1\xdc\x81\x18\x67\xd5\xba\x5c\xe7\xeb\xd8\x7f\x8a\xbd\xfe\xfd\xfa\xda\x7d\x6e\xbb\xce\xcd\xfa\x7a\xbd\x7c\x5e\x43\x20\xa4\x57\xf4\xfa\xdd\xdc\x7b\xe7\xfc\x69\xed\xad\xe7\xc4\x7f\xfa\xe4\xba\xeb\xe8\x57\x03\x03\x9e\x5e\x28\xc4\x00\xbb\xe5\x3c\x29\xf9\xbe\x67\x7e\x24\xa4\x98\xd6\x05\x5b\x5b\x2d\x47\x04\x0c\x7a\xc3\x06\x3b\x1d\x69\x1e\xfb\xba\x2f\x51\xa9\x4f\xce\x62\xc8\x04\x1f\xfc\xb4\x0b\xc1\x29\x84\x2d\x55\xc7\x2b\x6d\x55\xed\x96\x4a\xb4\x51\x31\x3a\x1d\x3b\xa0\xe6\x23\x4c\xfe\xbd\xba\x87\xc1\x1e\x1a\x8c\x1b\x5d\xdf\x05\x50\x0f\xbc\x85\x6f\x1c\xe6\xd9\x8c\x22\x27\x2f\x2a\x1b\x4c\xbb\x30\xc2\x44\x2a\xbd\x34\x85\x05\x8b\x1d\x73\xd6\x80\x5f\x19\x58\x97\x83\x05\x95\xeb\x45\xbe\xe3\xf9\x4e\xbf\x88\xcd\xf4\x90\x82\xb1\x21\x9d\x77\x9f\x18\x01\xaf\xed\x13\xd8\x87\x8e\x98\x0a\x92\xa8\x17\xca\x9b\xc3\x9d\x19\x54\xb3\x33\x21\xd3\x48\x70\x18\xd9\x5c\x6c\x55\x35\xf3\xc6\x69\x0b\xe8\xe6\xe9\x62\x15\xdc\x44\xa0\x33\x15\xec\x7c\xe7\x0e\xc3\xbd\xc0\x27\x10\xde\xbd\x53\xac\x29\x35\x72\x48\x07\x0d\x78\x3e\x26\x66\x4d\x72\x5e\x6c\x46\xa0\x12\xc3\xcf\xf3\xf9\xd2\x35\x51\xa2\x1d\x62\x27\x6c\x4d\x09\x47\xe6\x6c\xd0\xce\x57\x2a\x86\x3a\xf0\xb1\x93\x25\x86\x58\xd0\xa3\xe7\x6c\x1f\xfc\x70\x21\x83\x17\x8f\x1d\xfd\x4d\x54\xc9\x73\x63\x3e\xcb\x7a\xd1\xf2\x8f\xa0\xa6\x6e\x7b\xa1\x7d\xcd\x1b\x69\x41\xff\x9a\xfc\x45\x06\x33\x5b\x1f\x9e\xda\x37\xde\xfa\x61\xfd\x8b\x3f\x7d\x8e\xbd\x77\xcf\xfd\xa7\xf5\x9f\xcc\x78\x66\x7f\xa5\x6d\xd6\x80\x51\x08\xe6\x24\x06\xe2\xd6\x5b\xd7\x96\x95\x7f\x3d\xd2\x49\x89\x9c\x29\xba\x91\x72\xfb\xc2\xb7\x2c\xf4\x5c\x5e\x77\xd1\xbe\xfc\x2e\x8c\xe7\x73\xfa\xa9\xeb\x49\x4d\x03\x18\x4b\xf8\xce\x5f\x74\x34\x27\x02\x1e\x2d\xdc\x13\xc5\x35\x27\x2e\x38\xc8\x60\x2e\x33\x0c\xb8\x76\x50\xd7\x21\x56\x2b\x8d\x9d\xb3\xe8\xef\x41\xfb\x0e\x5c\x5c\x74\x54\xa8\x80\x06\x57\x69\x7e\xa8\x58\x1e\x8a\x2e\x97\xae\xd8\xa5\x4e\x40\xcc\x34\x00\xa4\x9c\x43\x58\x6c\xc1\xec\xc0\x97\x24\xd0\x56\x6c\xb0\x35\x47\x62\x55\x45\xb0\xac\xe4\xa5\x5c\x23\x68\xed\x3c\xcc\x79\x20\x03\xe6\xf2\xab\x34\xbf\x0a\x70\x0f\xc9\x42\xc4\x7b\xee\x63\x57\x5d\xd8\x81\xb8\x93\xa3\x02\xcb\x49\x4e\xe0\x89\x19\x3b\xbf\x15\xdf\x68\x34\x74\x4a\xe7\xde\x33\x21\x13\x5a\x96\xac\x78\xe1\xd3\x98\x18\xbb\x98\x2e\x31\x31\x6b\x30\x1c\xdd\xda\x0f\x58\xff\x03\xb9\x17\x9d\x41\x90\x5c\xb7\xa3\xe4\xf3\x92\xb3\xe8\xbd\xab\x7e\x01\x42\xed\x52\x7c\xd0\x86\x72\xb6\x65\x95\xb8\x59\xfd\xd5\x38\x56\x13\x30\x48\x5e\xd3\xd2\x8e\x24\xfc\x7e\x2d\xa1\x57\x4a\x06\x87\x7a\x5f\xf2\x7b\xdb\x75\x7e\xca\xab\x34\xab\xc1\xd3\xf9\x9a\xfc\x8d\x9e\xc0\x24\xae\xe4\x39\x9c\x10\x4a\xdb\x95\x83\xb8\x86\x28\x6e\x93\x05\x11\x9f\x39\x93\x85\xcc\x50\xd1\xe2\xb8\x0d\x3b\x8c\x45\x4e\xd7\x1d\x33\x85\x6c\x73\x23\x8f\xe3\x2e\x19\x7e\x69\xb1\x62\x37\xe1\x8a\x9d\xe1\x2f\x99\x24\xc2\x63\xbe\x89\xbf\x1a\x12\x66\xc5\x81\x9b\x19\x15\xfa\xe1\xba\x15\x7a\xd5\xc8\x36\x16\xec\x52\x42\xcf\x31\xad\xe4\x7f\x60\x77\xb6\x68\x01\x60\xab\x55\xeb\x77\x88\xd2\x8b\xfc\x55\x68\x59\xa1\x60\x66\x56\x03\x2f\x2d\x88\xf2\xd5\xda\x29\x2c\x97\x96\x65\x27\x6e\x22\x2d\x5f\x94\x7d\xeb\xd0\x7b\xfe\x02\x5d\x82\x18\xda\xf8\x14\xb7\xc7\xcf\xbd\xc3\xb6\xb3\x94\x14\xed\x09\x48\x0f\x1f\xc7\xd8\x89\xc1\x7c\x21\xe2\x8f\xa3\x76\x1c\xee\x48\x2c\x6f\x45\xab\x4b\xf0\x3f\x92\xc0\x8b\x75\x7c\x6e\x89\x76\x35\xca\x10\x18\xc9\x51\xb8\x02\x90\x93\xba\x30\x23\x01\xa9\x05\x2f\x0f\x42\x9e\x17\x50\xf4\x22\xcb\x6c\x8d\x63\x9d\xd9\xb2\x21\x2c\x8a\x9c\x51\xc7\xb3\xb3\x84\x1e\xc6\xc8\x7a\x59\x46\x12\x5e\xd9\x98\x94\xf0\x31\xc4\x82\x66\x51\xf4\xe5\x30\x3b\x89\x59\x29\x8b\x22\x5b\x87\x4c\x1f\xe4\xbd\x70\x06\xf7\x1a\x58\x19\xc6\x8d\x19\x57\xf9\xef\xb2\xab\x43\x76\x7b\xd9\x67\xb7\xbb\x73\x7a\x15\x1d\xfa\x34\x62\xa6\xa2\xad\x43\xce\x1d\x5f\x03\xe5\x8e\x66\xe2\x4a\xe5\x11\xa5\xf6\xf3\xd8\x10\x05\x62\x30\x4f\x39\x71\x5b\x1a\x76\xfd\x25\x2b\xf3\xe1\x67\x10\x88\x3d\x1f\x9a\x99\xa8\xc2\x62\xf8\x3d\x63\x5f\xdc\x48\x1c\x71\xa9\xec\xd0\xb2\x59\xd5\xfd\x22\xcb\x2e\x0e\x61\xa4\xfa\x0f\x15\xbf\xd0\xc2\xc7\xc7\x6c\xb6\x03\x83\x16\x35\xfd\x81\xa9\x1a\xda\x6a\x8b\x4f\x0f\xf2\x66\x74\x5d\x7e\xb7\x6d\x73\x91\xeb\xfa\xd4\x60\x43\x86\x87\xdc\x36\x2d\x1e\x1e\xfd\xde\x14\xf9\x45\x61\x8d\xa8\x47\x0a\x0b\x59\x09\xa5\x71\x09\x41\x85\x5c\xe9\x85\xc8\xf3\x36\x7a\x93\x63\x43\x81\x8a\xef\xf6\x19\xab\x38\x02\x93\x4c\xda\x16\xab\x6b\x60\xe8\xe5\x1e\xf3\x7c\xc2\xcc\xc8\x9c\xe0\x2e\xd2\xdf\xb0\xf3\x38\xf7\x98\xdf\x71\x3d\xc6\xa1\x8c\x91\x87\x27\xe8\x78\x47\x04\xf3\xdb\xb3\xbd\xff\x1c\xc3\xc9\x63\x88\x49\x64\x59\x67\x78\x29\x02\xcd\x5c\x2b\x71\x44\x70\x18\x61\xe0\xf6\xe7\x02\xd7\xfe\x0d\x6c\x80\x7b\x88\x82\x63\xf2\x75\xa7\x24\xe3\xf3\x92\xdf\xf3\x12\x94\x1a\x64\x80\x5f\x38\xd6\x2c\xdf\x37\xf4\xda\x7b\xd7\x13\x00\xa7\xd7\x49\xb7\xf5\xbf\xed\x36\xed\xa9\xd5\x54\xab\xfd\xad\x1c\x63\xed\x6f\x40\xf1\x49\xfa\x26\xbf\x81\x17\xfa\x54\xd0\x2d\x12\x34\xe5\xfc\x25\xcb\xb2\x80\x85\xb7\x87\x9e\x23\x1e\xa3\x23\x28\x59\xb4\xe8\x74\x69\x59\x1a\xa2\xbc\x3b\xdb\x43\x5c\x49\xd7\xe0\x04\x79\xb6\x24\x29\x1d\x90\x56\x4e\xc1\xe8\x10\xb2\x6c\x45\x34\xa0\x93\xc5\x4a\x1f\xcc\xae\xc4\x17\xf8\x14\xd2\x44\x6b\x5d\x95\x0a\x61\x3a\xdd\xdc\x68\xba\x82\x63\x6f\xa3\x0f\xb2\x42\x6f\xe1\x93\x50\x30\x64\x60\x76\x0e\xee\x8b\x55\xb1\xff\x3e\x7f\xcd\xb2\x83\xb4\x91\x89\xbb\xa8\xf2\x93\x25\x6e\xd8\x7c\xc7\x77\x45\xf9\x1e\x6c\x4f\x26\x4b\x21\x5b\x4c\x96\x44\x14\x8d\x69\xe8\x7a\xbe\x03\x19\x2a\xb6\xf4\xd4\xa3\x04\x9d\xed\xae\x3a\xec\x0e\x7a\x75\xcf\x96\x24\xd1\xb4\xb1\x33\x6b\xbd\x8a\xc0\x12\x57\xae\x45\x30\x4c\xfc\x64\xf2\x5a\xa1\xd8\x14\x12\x4e\x2c\x0b\xd2\xb0\xd9\x21\xae\xeb\x96\xde\x3a\x60\x2e\xaa\x65\xc9\x8e\x63\xd0\x71\xf2\x43\x6c\x59\x90\x1e\x0d\x37\x46\x22\x02\xa2\x3a\x9a\x6a\xf8\x6a\x88\x8c\xac\x38\x32\x2e\x0d\x31\x6d\x1a\x84\x11\x1e\x46\xab\x73\x42\x5a\xce\x55\x32\x0d\x31\x2c\x12\x62\xc1\x4b\xe0\x58\xab\x97\x43\xb2\xc4\x24\xbc\xa1\x1b\xcb\xda\xcc\x66\x8d\x6e\x7b\xc8\x9f\xb5\x64\xbf\xab\x8d\x91\x18\xd8\x92\xd8\x70\x5a\x1e\x18\x69\xf6\x56\x02\xec\x9b\x54\xf5\x4a\xa5\x37\x52\x92\xd3\x44\x1e\xc1\x80\x47\x69\xaf\xf4\xc8\x12\x4f\xe2\x86\x64\x85\x49\xaf\x87\x15\x85\x75\x1d\xd4\xb5\x2d\xeb\xd3\xcd\x8b\x4f\x46\xab\x9b\x70\xb0\x47\xe4\xbf\xa4\x55\x2f\x91\x4b\x87\xc0\x39\xc0\xa2\x3c\x40\x01\x9b\x7e\x65\x19\xec\x6a\x0b\x61\xec\x84\x7e\x07\x60\x24\xa8\x6b\x63\x49\x45\xdd\x23\x7d\xdd\xce\x75\xa3\xc3\xdc\x60\xc6\x77\xa3\xfd\x8d\x9a\x16\x8f\x6c\x0d\xfb\xa8\xef\xcc\x35\xeb\x0e\x50\xbf\xef\x3c\x99\x8d\xa7\x3f\x68\xa3\x0e\x85\x78\x21\x30\x28\x93\x42\x53\x0b\xf5\x10\x03\xa1\x2c\x76\xe9\x81\x63\x97\xeb\x0c\x3b\xf3\xa8\xc8\x39\x08\x78\x2c\xcd\x04\xec\x8f\x7d\x56\x6d\x78\xde\x7d\x03\xb8\xdc\x09\x14\x4e\x90\x52\x0b\xf1\x98\x3e\x71\x8a\xb0\x8e\x43\x0a\xb1\x4d\x86\xa5\x70\xd3\x74\xd8\xec\x15\x8f\x79\xd9\x9b\x98\xf6\x70\xd8\xf3\x50\x5e\x54\x69\xfc\x1e\x09\x72\x5b\x24\x25\x3f\x1c\x10\x31\x50\xa7\x8d\x24\x6a\x81\x48\x28\x63\x4f\x9f\xf9\xc4\x43\x25\x3f\x14\xd9\x3d\x47\x04\x89\x81\x0e\x2a\x10\x48\xf1\x6a\xbc\x96\xfe\xab\x05\xd1\x15\x45\x48\xd6\x0a\x01\x79\x09\x12\xb3\xf6\xdf\xad\x74\x49\x54\x3d\xa2\x52\x9f\x44\x14\xed\x79\x1e\x01\x3b\xc1\xe9\xe9\x50\xb1\x6a\x0c\xd2\xa2\x86\xb0\xec\x81\xbd\x3f\x8c\xa6\xfd\x83\xd5\xec\x80\x4f\xae\xea\x19\x30\x22\x58\x1f\x34\xea\x43\x02\x8b\xad\x4c\x16\x04\x3f\x94\xee\x7b\xbd\x90\x92\x5a\x5b\x63\x47\x02\xf5\x52\xf6\x43\x6c\x4a\x3c\x17\x92\x71\xee\xc0\x94\x7f\xbd\xc8\xfb\xcc\x17\x6c\xb4\xba\x5a\xc5\x5e\xe4\x2d\x7d\xdf\x3e\x6b\x9c\x43\x58\xc6\xb1\x3c\x7c\xab\x21\xf0\x1a\x10\xdf\x5e\x82\x2c\x22\xe1\xc9\x16\xcc\xb5\x80\x31\xbd\x0d\xe6\x6a\x95\xd5\xc4\x89\x7b\xc8\xbe\xe9\x04\x5e\xe4\x2d\xfc\x29\x12\x3b\x1c\xf9\xb2\x5d\x2e\x53\x1f\xb5\xad\x37\xb8\xc1\x84\xc9\xe8\xb9\xb8\x6b\xad\x21\x62\x46\x4d\x4b\x40\xc3\x3b\x71\xd1\xed\xf8\xc4\x6e\x4d\xc3\x86\x9e\x2b\xb2\xf4\x46\xe6\xa4\x33\x92\xe3\x91\xed\x79\x72\xbd\x2d\x48\xb2\x76\x70\x13\x63\x15\x18\x25\x52\x93\x05\x86\x50\x0c\x44\xd0\xb6\x73\x46\x0a\x56\x21\x62\xa8\xc8\x08\x6f\x37\xd2\x1f\xe1\xea\xc0\xb3\x78\x06\x73\x72\x84\x23\x6a\xbc\xda\x42\x50\xd1\x3f\x9a\xcb\x4b\x2c\x27\x00\x54\x3f\x23\xd0\x16\xbb\xdc\xdd\x6a\x74\x92\xd8\x31\x09\xc9\x77\x84\x63\x75\xf9\xbd\x60\xf0\x1c\x3b\x9e\x4e\xc9\x87\x0b\xb5\x4f\x43\xb5\x8e\x62\x79\xb0\xf8\x36\x9a\x50\xfa\x1d\x30\x0d\x0a\xf3\xa4\x54\xe0\x1e\x62\xf3\xba\x0e\xf5\x2a\x43\x69\x98\x16\xc1\xd6\xde\x52\xee\x6e\x4d\x50\x17\x48\x74\x6b\x1b\xc8\xac\x03\xf2\x39\x7f\x0c\x39\xf8\x4f\xfc\xb5\x28\x6e\x05\xd0\x8d\xbf\xb1\x19\xb9\x9d\x1f\x04\x8b\xf9\xb6\x64\x21\xc7\x24\x98\x2e\x9f\x53\x41\x4b\x45\x07\xbf\x1f\xe9\x60\xa8\x40\x0e\x48\x89\xea\xda\x2a\x70\x6f\x6d\xec\xd8\x46\x2b\x09\xaf\x80\x73\x95\xcd\xdb\x66\x23\xf4\x42\x31\x5b\x7a\x7e\xf3\xea\x6d\xba\xe3\xc5\xb1\xb2\x6f\x45\xdd\x1f\xd8\xbf\x02\x7d\x7b\x0b\xdf\xfb\xd4\x07\xa1\x39\xb1\x17\x84\xf5\xd7\x51\x10\x12\xe7\x3b\xc2\x7a\xb3\x0f\x0c\xe2\x07\x3e\x0a\xb0\x1b\x38\xdf\x41\xb9\x67\x1f\x2a\x17\x61\x37\x72\xbe\xc7\xb8\xbf\x97\xd4\xe5\x07\x12\xfd\xb9\x2d\x79\x61\x84\x4b\x4f\xb8\xf8\x9c\x59\x0f\x47\x58\xae\x84\x06\xde\x33\xc1\x47\x07\xde\xe7\xfe\x8a\x7b\x81\xc0\x3f\x34\x11\x1d\x24\x1b\xcb\x82\x0b\x13\x1d\x45\x74\xd3\x90\xd0\xfb\x74\xc6\x7c\xef\x99\xaf\x63\x9b\x11\x98\xb4\x67\xfe\x5c\xf0\x28\x98\xc8\xaf\x02\x31\x50\xc1\x03\x60\x12\x7b\x81\xb7\xf0\xfd\x91\xa4\x57\xf2\x4d\x0f\xc9\x50\x4a\x63\xa5\x18\x74\x2e\x30\x16\xbd\x8f\x68\xd2\x32\x22\x0d\x26\xbc\x9d\xb9\x18\x4b\xbd\x2b\xec\xa6\x98\xc4\x98\xc4\x0d\x79\xd8\xf0\x51\x5f\x95\x61\xee\xcd\x90\x06\x24\xa2\x3a\xf9\x24\xe1\x67\x59\xbc\x30\x49\x0c\xa8\xb3\x31\xd9\x8c\xc4\x6a\xe9\xd0\x59\x88\x4f\x91\x10\x19\x25\x16\x15\x57\xc3\x26\x9f\x2f\xdd\xb3\x36\x9c\x90\xcc\x66\x41\x5d\x27\xe6\xf6\x05\x61\xb0\x69\x04\xce\x0b\x6e\xa8\x90\x03\x05\x2f\x94\x48\x74\x0e\xf2\x81\x2e\x4d\x12\xb5\xb3\xc8\x24\xc0\xa4\x25\xb5\x10\x8d\x18\x68\xad\x8d\x07\x69\x41\xb9\x17\xfa\x96\x25\xfe\x4a\x06\xa8\x3d\x0b\x4f\x24\x8d\xc4\x86\xf5\xd0\x0f\x50\x9a\x00\xc3\xa8\x1b\x32\x32\xad\x75\x00\xac\xc4\xcc\x7f\xd0\xeb\x77\xf6\x57\xf7\x2c\xab\xbf\xce\x2b\x5e\xe6\x2c\xab\x7f\x64\x79\xc2\xeb\x1f\xc5\x24\xf2\x3c\xe4\xb5\x0c\x4f\x53\x83\x0d\xf7\x4f\x3f\x7e\x8d\x01\x37\x3f\xb9\x5e\x5d\xc2\x35\xb4\x2f\xf1\x80\x3c\x7f\x28\x64\x90\x19\x75\x39\x7f\x60\x65\x6e\x59\x81\x65\xfd\x43\x9d\xed\xcd\x73\xb6\xe3\x78\x58\x44\x27\xe2\x6e\x5b\xba\x6a\x5b\x72\xae\xd0\x34\x98\xef\xf8\xe1\xc0\x12\x4e\x02\x89\x77\x40\xfd\x51\x4a\x6d\xf5\x57\xba\x24\x35\x19\x81\x1e\xe2\x31\x71\x2c\x90\x9f\xa0\xc1\x0d\x4c\xcb\x8f\x3d\x30\x92\x0a\x67\xa8\x75\x0c\x9e\x7e\x94\xcb\xc0\xb0\xa7\xf8\x19\xbf\x6f\x91\x30\xe8\x8f\xcd\xf0\xea\xff\xb4\xe2\x51\xc7\x83\xb6\x39\x4b\x97\x04\x3e\xf8\x85\xa5\x95\xa3\xae\x7b\xbb\x03\x62\x8c\x4d\x16\xee\x6c\xa6\xaa\x86\x92\x02\x64\xa0\x02\x5c\xd7\x76\x7b\x03\x01\xfd\x27\x32\x75\x4a\xaf\xfc\xf3\x45\x5d\xff\x38\x80\x60\xaf\xf4\x41\xc3\xa8\xa7\x10\xc6\x45\xe5\xf0\x3a\xfe\xe0\x8d\xc0\x38\x2a\x58\xff\x20\x86\xf5\xab\xef\xbf\x55\x4e\xa7\xdf\x14\x2c\xe2\x11\x22\x6f\x04\xae\x1f\x2d\x2b\xc3\x57\xbf\x69\x1b\xb3\x71\x83\xc2\x62\xb7\xcf\x78\x05\x76\x2d\x91\x7c\xfc\x46\x6c\x89\xba\x86\xe2\x4a\xfa\x35\xdf\x58\xd6\x24\x1a\x86\xf1\x9b\x47\xc5\x9b\xb0\x2c\xb2\xcc\xed\xad\xb6\x6a\x07\xe2\x1b\x9f\x87\xdf\xbe\xd0\xf5\xf3\x82\xba\xdf\x72\xff\xbc\x1d\x5a\xd6\xa9\x04\x72\x9a\x53\x1a\xb1\xa5\xa7\x34\x5c\x19\xc9\x34\xa8\x21\xce\xe3\x13\xa7\x93\x05\x1c\x88\x6e\xae\xd2\xfc\x2a\xc4\x6f\xa1\xda\x0d\x09\xbd\x8d\x4f\x26\x0b\xa8\xba\xf5\xe9\xef\x25\xd1\x15\x5f\x0e\x09\x17\xa4\x13\x9e\x2c\xc0\x1e\xdf\x4e\x5c\xbb\x3b\xd4\xd7\xe9\x15\xb0\x63\x6f\x69\x40\x82\xb3\xc3\xc2\x56\x9e\xec\x14\xf1\x21\x44\xad\x54\x07\x5e\xad\x3b\x40\x00\x6e\xd4\x24\x24\x89\x1b\x39\x91\x4e\x22\xba\xf1\xc9\x86\xe8\x57\x86\x0b\x00\x77\x99\xb3\x75\x75\x3f\xb0\x93\xba\x01\x84\x8b\x11\x92\x5c\xdc\x90\x9f\xc6\xf6\x57\xff\x94\x5b\xc6\x7e\x32\xef\x27\x53\xe3\x0c\xbc\x83\xd3\x9f\x61\x5b\xa7\x87\xb9\xca\x20\x2c\xad\x3b\xc4\xd5\xf4\xe7\x39\x24\x64\x6c\xe0\x97\x2e\xc9\xcf\x66\xb6\x73\x88\xc4\x34\x46\x8e\x3c\xb3\xb6\x41\xbc\x97\x53\x43\x7e\x92\x29\x09\xcd\x00\x93\xfd\x2f\x68\xe0\x28\x7d\xbe\x8c\xd4\xd2\x39\xea\x13\xb3\x1c\x51\x96\xd2\x01\x09\x8b\x3c\x4e\x93\x63\x09\x0a\x0e\x38\x2b\xc7\x24\x68\xc8\x81\x57\x97\xb2\x79\xca\x93\x26\x18\x81\x0e\xa0\x7c\x96\x26\x13\x73\xaf\x9c\xb7\xd9\x69\xed\x00\xfb\x34\x5c\xf5\x13\xc2\x0e\xcb\x44\xb8\x9f\xc8\x94\x0f\x73\xb9\x1b\x27\x26\x12\x28\x21\xde\x4a\xaf\x33\xce\x60\x36\x84\x80\xd5\x7b\x30\xec\x55\x43\x58\x18\xf2\xc3\xe1\x92\x02\xbc\x6b\xa8\xae\x83\x11\x6d\x6d\x60\x59\x6d\x91\xd0\x6d\x0f\x69\x44\x5f\x1d\x79\x66\x73\x90\xb7\x24\xc4\xa4\x3b\xf3\x74\x43\x27\xc0\xe7\x2a\xb3\xde\x99\xde\x10\x14\x7a\x7b\x11\xa4\x9d\xf6\x36\xc0\xa7\x7e\xd6\x6c\xc1\x76\xd2\x00\x14\xd2\xc6\x80\x95\x2d\x8b\x39\x01\x44\x1e\x6b\x5f\x45\xae\x17\xf8\x4e\xd0\x53\xea\x5e\xb0\x1d\x56\x09\x60\x22\x2f\xf0\x42\xdf\x6f\x6c\x73\x8a\x04\x6a\x30\x72\x2b\xdb\x11\xfe\x08\xb4\x2a\x6e\x4f\xd5\x39\x78\x2b\x04\x95\x0d\x3b\xbc\x62\x15\xfb\xe3\x5b\xa5\x9b\x14\x95\xca\xd3\xec\x4f\x20\xd8\x27\xf1\xf9\x2f\xe0\x74\xf9\x33\xf9\x55\xfd\xfe\x53\x99\x3e\x9c\xa4\xdd\xc3\xd3\x75\x53\xaf\x3d\x7d\xed\xe3\x27\x90\xe9\xcd\x7b\x31\xfb\x2f\xdf\x54\x90\x3f\x31\x92\x75\x57\xe5\x11\x48\x0a\x08\x87\x2c\x3b\x70\xd0\xf9\x0b\xf1\x51\xa0\x40\x19\x31\x0a\xc2\x62\x09\xc2\x3a\x65\x53\x84\xdc\x29\x73\xfe\xd9\xda\x66\xfc\xed\xcd\xf7\xdf\x49\xeb\x01\x80\x63\xc3\x8f\xe0\xb7\x33\xb3\xc1\x0e\xe4\x86\xd6\x45\xe0\x53\x41\x51\xc4\x2a\x36\x13\xbc\x8b\x36\xe9\xfd\x2f\x82\x66\x4f\x2c\x34\x8c\x92\x10\x0e\xad\x17\x23\x7c\x6e\x9d\x11\x4a\xe9\x30\xa4\x4f\xec\x10\x1b\x41\x21\x7f\x35\x60\x5b\x92\x8b\x90\xf6\x43\x26\x86\x86\xf6\x6b\x6c\x21\x5b\x4f\x74\xf5\xd2\x66\xb8\xae\x7f\x31\xee\x1a\x12\xf5\xbf\xe9\x6d\xce\x5f\xe7\x72\xf3\xea\x3e\xa8\xfd\xf4\x6a\xf8\x09\x3e\xfd\xaa\xd8\x03\x15\x65\xf2\xb7\x0f\xd5\xfa\xcb\xb0\xd6\xdf\x2e\x56\xfb\x4b\xaf\x5a\xe0\x69\x0c\xa3\x81\xb3\x46\xfa\x09\x01\xd4\x19\x32\x49\x84\x88\x1c\x1b\x96\xb4\xbd\x15\x96\xa6\x31\xc6\xf9\x0f\x50\xe1\x5f\x01\xcb\xc4\xd2\x12\x2c\x36\x0e\xd9\x27\xbf\xc8\x37\x04\xa9\x49\x14\x4b\x7b\x40\x18\xcb\x93\x8f\xb3\xcd\x9c\x00\xdb\x6f\x47\x54\x5c\x00\x7f\x4c\x16\xc0\x17\xb5\x21\xd8\x24\x28\xc9\x23\xab\x1e\xba\x56\x1a\xd0\xcf\x31\x26\xbf\x49\xf7\x3c\x2f\xf2\x05\x15\xfe\x05\xe0\x62\xd8\x05\x32\x59\xb4\x16\x96\x3a\x4d\xd4\xb9\x66\x45\xa2\x50\x10\x5a\x0d\xc6\x59\x42\x9a\x14\x09\x71\x83\x9d\xb7\xf2\xfa\x4c\x8f\x0a\xfe\x1d\x06\x46\x0e\x54\x72\x05\x35\x5d\x84\x99\x38\xb8\xb3\xd2\x87\x32\xbf\x5d\x7c\x2f\x61\xfb\xa3\x1d\x13\x20\x80\x1b\x15\x3b\x96\x9c\x0b\x79\xf2\x8d\x98\x85\x71\x38\xed\x9f\xdc\x9f\xb7\xa4\x40\xad\x9d\x05\x09\x6f\x1a\xd8\xee\x8e\xfc\xc8\xc7\x29\xb6\x18\x5f\x6b\x8a\x15\x50\xf0\x4c\x8c\x1f\x11\x9e\x22\xf8\x08\x91\x88\xfe\xd2\x52\x2d\x12\x5a\x16\x84\x9f\xee\x13\x94\x10\xbb\xa2\x94\xb1\x33\x4c\xfb\x9c\x10\x63\x27\xea\x0e\xa9\x20\xb3\x5f\x43\x22\x7e\xde\x29\x7c\x82\x7c\x7c\xa2\x7d\x19\xb1\x98\x96\x73\x28\x25\x1b\x8f\xda\x14\x5b\x90\x6d\xab\xf5\xde\xa6\xe5\xfc\x37\x28\x26\x64\xbf\x43\x1b\x48\xc8\x50\x2a\xcc\x55\x6b\x72\x2b\xae\x50\x9a\xb7\x7a\x74\xaa\x52\xe4\x18\x15\x46\xb3\x19\xf8\x7c\xda\xa2\x23\x54\x19\xeb\xea\xb8\xc3\xe6\xb7\x98\x28\xd2\x14\xc3\xe1\x1f\x69\x8f\x05\x12\x70\x10\x9d\x44\x96\x05\xbb\x17\x4e\x92\x40\x1b\x61\x0b\x8c\xd1\xf5\x75\x3c\x0d\xbd\x9a\x7a\x28\x81\x56\x2d\xe2\x91\x8b\x10\x02\x12\x6c\xa7\x3a\x24\x27\x79\x4e\x75\x59\xbb\x3e\xd4\xd5\x18\x78\xc9\x0b\xda\x65\x0e\x7d\x99\x9c\x69\x88\xa7\xc6\x56\x49\xf6\xf3\x99\x3e\xc4\x1e\xb1\x23\xb2\x65\xfe\x73\x31\x81\x44\x60\x92\x33\x88\xbf\x09\x5d\xbd\xb6\x1a\xd5\xb5\xf9\x3a\x5a\xce\xcd\x19\x05\xf6\x3e\x64\xb4\xdb\x6b\xd5\x87\x02\xb5\x13\x88\x5a\x43\x26\x2d\xfc\xf5\xca\x89\x2d\x0c\xb6\x3a\x1d\x68\x74\x5b\x67\x04\x36\x3f\xbc\xfd\x46\x2b\x09\x33\xce\xca\x7f\x7c\xb0\x1e\x05\x93\x12\xe2\x89\xe7\x8f\x2a\xf5\x4c\xae\x6f\x49\x78\x5f\xbb\x24\x09\x05\x49\x7a\xf6\x5d\x1b\x13\xf6\x67\xb3\xa8\xae\x79\x4f\xcc\x8e\x89\x17\xfb\x62\x1b\x5c\x5e\x38\xb9\x10\xe0\x8e\xa4\xf6\xa3\x91\xe1\x4b\x21\x84\xd8\x4b\x7c\xc2\x7a\xc0\xaa\xb2\xc5\x00\x44\x0a\x9a\x30\x9d\x12\x75\x07\x40\xb8\xe9\xa4\xb0\x8d\x6d\xea\xe4\x82\x56\x1b\xc4\x18\xbd\xf6\xa6\x33\xdf\x15\xfc\x56\xf4\x74\x3d\xaf\xf1\x3a\x9a\xda\xae\xe3\xf1\xaf\x7c\x78\xb1\x8e\xa6\x35\xbe\x56\x49\xef\x48\xc0\xe8\x30\xa5\x30\xe4\x23\xc6\xb4\xc6\x36\x9a\x32\x36\x45\x18\x4c\xdc\xff\xc3\x7f\xda\xa6\x19\x0e\x19\xf5\xd0\xdb\x62\x8f\x08\xfa\x31\x4d\x36\x15\x22\xe8\xcb\xa2\xaa\x8a\x1d\x22\xe8\x1b\x1e\x57\xc8\xef\x65\x88\xed\x27\x49\x0f\xea\x9a\x11\x94\x17\xb9\x64\xe8\xe6\x87\xea\x7d\x06\xd9\xdd\x20\xf7\x78\x8d\x46\x9e\x0a\x30\x6b\x4d\xf8\x06\xd1\xaa\x89\x10\xd9\xda\xea\xca\x79\x08\xdb\x1a\xa9\x2f\x91\xcc\x4c\x3b\x94\xf0\x5b\x4b\x5a\x92\xd0\x53\x03\xd2\x7a\x2c\xa5\xa6\xc4\x8b\x7d\xdd\xbc\x17\xfb\xa4\xbb\xa4\x81\xcc\x0b\x14\xb6\x69\x60\x01\x13\x63\xf3\x6b\xa3\xb4\xa8\xa8\x13\xb6\x3a\xae\x36\x66\x67\x9d\xa0\x4b\x92\xd0\x67\x0b\xb2\xa1\xd1\x79\xd8\xd3\xab\x68\x1e\x1e\x4b\xdb\x0c\x54\x6f\x9c\xfd\x87\x8a\x5e\x80\xed\x43\x4a\x05\x54\x6c\x05\xdf\x1a\x42\xc4\x5a\x1b\x0a\x7c\x07\xc9\xf4\xbd\xc0\x77\x11\x72\xd0\xfe\x11\x61\x72\x4b\x07\xaf\xea\x5a\xbc\x98\x50\xba\xb5\xac\x69\x8a\x2d\x2b\x60\xd2\xe4\xb7\x6d\x42\x86\xeb\xb8\xb5\xac\x5b\xef\x53\x1f\x3c\xa3\x4f\x5b\xba\xad\x6b\x71\x4b\xf4\x21\xf8\x2d\x9d\xa6\x75\xbd\x5c\x45\xc5\x55\x4c\xe3\xba\x46\xf3\xcf\x11\xb9\xbd\xa6\x31\x29\xe5\xcc\x40\x67\x6f\xa7\x5b\xad\xf0\x8c\x27\x94\xda\xb1\xe8\xf7\x75\xaa\xec\xde\x62\xcb\x9a\xcd\x92\x96\x9b\x11\x14\xf3\x96\x4e\x6f\xeb\x5a\x54\xbd\x10\x94\xcb\x5b\xfa\xee\xed\xd4\x16\xbf\xd3\x25\x7e\x1a\x7a\xcf\x7c\x67\x2a\xfe\x92\x48\x6c\x9a\xf9\x31\x4f\x2b\xba\x25\xd1\xfc\x50\xb1\xb2\xa2\xb7\x24\x9a\xf3\x3c\xa2\xe0\x61\x0c\x8e\x1b\x09\x83\x55\xd7\x4b\xb2\x61\x66\x0c\xde\x21\x7c\x45\x86\x43\x13\x44\xbe\x36\xa5\x68\x97\x3b\x10\x53\x37\x28\xa2\xf7\xbd\x04\x25\xe1\xc0\x4b\x0d\xc2\x20\x28\xf0\x0c\x0c\xf0\x24\x1f\xca\x9f\xd9\x82\xb5\xa4\xb0\x28\xc8\x8a\xf0\x16\x61\x02\x7d\xa0\xbc\x97\x7b\x34\x65\x03\x67\x1a\xc1\x33\x4a\xeb\x89\x05\x49\xba\xe0\x15\xf1\x4d\xb2\x8a\xa7\x53\x1c\x41\x14\x70\x98\xa4\xf7\x19\x87\xb0\x32\x51\x7f\xd7\x91\xc0\xb5\xdb\x1e\x88\x65\x00\xb8\x96\xb8\x2b\x32\x86\x50\xd7\xd2\xeb\xdd\x8b\x05\xc8\x0d\x2a\xa1\x90\x3b\x0e\x49\x75\xe0\x60\x53\x47\xcc\x8e\xb0\xae\x77\x03\xa1\x22\xb0\x23\x5b\x9c\x18\x2d\xca\x27\x44\x32\xbe\x46\xc3\x52\x2b\x05\xbb\x8f\x2e\xda\x71\xc9\xa3\x1b\x2e\x23\x9c\x8b\x21\x0e\xfa\x23\xde\xf4\x0c\x2a\x0d\x5a\x7d\xd8\x14\x0f\x23\xdb\x2c\x65\x92\x2e\x01\x77\xb9\x49\xa3\xb1\xb3\x74\x55\x06\x37\xa4\x2a\x92\x24\x1b\xa3\x59\x28\x28\x8a\x8c\x33\xf3\x50\xd3\x55\x2c\xb9\x68\xd8\x56\x76\xe5\xa2\x01\x7d\x3d\x24\x94\x91\x6a\xc5\x2d\xe5\xaf\xfe\x50\xdf\xca\x6f\x9b\x96\x1a\x6c\x99\x94\xbc\x75\xb4\xa6\x1a\xe2\x37\x81\xe3\xd1\x2d\xa3\xd7\x7d\xe7\xa5\xbe\xa9\x1a\xbe\x4e\x49\x26\x3e\x7f\x52\xaf\xaf\x6d\xd7\xd9\xb2\x7b\x56\xf3\x70\xc7\xf0\x21\x2c\xd3\x7d\x75\x9d\x92\x1d\xa3\x27\x19\xfa\xcd\xf1\x96\x04\xe9\x54\x46\xbb\x63\x56\xa5\xfb\x8c\xd3\x4f\xf4\xd5\x27\xcf\x11\x41\x5d\x12\x23\x9f\x54\x1b\xce\x22\xf9\x11\x78\x94\xca\xf7\xea\xd2\x27\x61\x91\x39\xde\xb3\xf6\xe5\x4d\x58\x64\x49\x59\x1c\xf7\xb2\x58\x7b\x67\x7c\x51\x95\xbd\x0f\x2a\xb1\x1f\x55\xa5\x70\x69\x16\x8d\x1c\xef\xd3\x61\xd1\x9b\xaa\x54\xc5\xcb\xe7\x23\xdf\xfc\xa6\x3c\x16\x1d\x6f\x41\x10\x22\x08\xf9\xcd\x6a\xc7\xe6\xc5\xbe\x82\x9e\x50\x79\x9d\x16\x39\xd9\xb1\x39\x7c\x2d\x1e\x55\x71\x51\x54\xe2\x42\xf7\x18\xae\x99\x3c\xd2\xd8\xc1\x69\x36\x8b\xe0\x8b\x0d\xdc\x46\x1d\x4a\xca\xcd\xe8\x06\xad\xc0\x7f\x39\x1f\xdf\x79\xc2\xb7\x0b\xd9\x1a\x65\xe0\x14\xec\x8c\xd7\x34\x4c\x7d\xe4\x8e\x64\x43\xd2\x35\x78\x3e\x19\xe8\xef\xa4\x63\x9d\xdb\x1a\x7c\x32\xd0\x09\x87\xfd\xd4\xb1\x83\x08\x6b\x80\x5e\x15\x6a\x0a\x6f\xa2\x55\x38\x9d\x62\xb9\xd3\x99\x17\xfa\x04\x25\x59\x11\xb0\xec\xab\x7b\x96\x21\x70\x55\x96\xe8\x27\x18\xbe\xc3\xd2\x23\x6f\x2f\x80\xba\xb6\xfe\xe4\xae\x1f\xa6\x2b\xc3\x93\xee\xee\x52\xb8\x1c\x1d\xee\x8b\x64\x34\x50\x18\x5b\x23\xfe\xd7\x25\x4b\x00\x75\x63\x1d\x8a\x66\x41\x8a\xae\xb3\xf9\x4d\xb1\xca\xa5\x7f\x41\x4c\x99\x97\xfb\x24\x96\x11\x2a\x63\x3c\x7a\x34\x10\x63\xac\x27\x66\x47\x62\x23\x6e\x4d\xec\x3b\x31\x6e\x13\x74\xef\x99\xd4\x59\xc5\x18\x9f\x12\x0a\xe1\x1f\x7a\x19\xbb\x86\xce\xcf\x51\x7a\x2f\x10\xec\x86\xda\xb7\x8a\x62\xc7\xb8\xae\x3d\x09\xa4\xf8\x3c\x3e\x7e\x4a\x77\xcc\xdb\xf8\x75\xbd\x63\x73\x0d\xd5\x24\x31\xbc\x88\x53\x41\x55\xcb\xf9\xa6\xda\x65\x3f\x94\x5c\xfb\x58\xe0\x69\x2a\xe8\xeb\x2d\xf8\xfa\x1b\x81\x66\x12\x9a\x74\xf1\x97\x57\xdd\x00\x93\x5e\x0e\xfe\x84\x66\xa6\x85\x72\x62\xc6\x36\xa5\x08\x49\x15\x80\x0a\xf8\xa1\x47\xf8\x96\x3f\x02\x4d\x84\xe4\x14\xd9\xe0\x0b\xb1\x18\x6d\x16\xc6\x9d\x97\xab\xb8\x17\x11\xd8\xec\x28\x93\xc4\x98\x44\x60\xdd\x08\x36\x3e\x3a\xec\x82\x9e\xe6\x2d\x35\x38\xca\x78\x40\xf1\x63\xd1\xe3\x9c\xd9\xfd\xa9\x8f\x31\x41\x12\xf5\x21\x38\x8d\x29\x98\x9d\x60\x12\xe2\xd3\xad\xd1\x97\xc4\xbb\x15\x7d\xc9\xf4\x2a\xea\xa0\x8b\x08\xb7\x0e\xab\x71\xcb\xda\x64\xcd\xe4\xcc\x22\x29\xba\x0c\x85\x01\xed\x67\x6f\x8b\x2e\xc0\x42\x48\xcf\xde\xe8\xf4\x70\xe1\x78\x7a\x38\xa0\x0a\x90\x35\xa7\xff\x5a\xa7\xc8\x23\xa8\x4b\x96\x37\x2c\xa3\x52\xc6\x55\xc0\xca\xf4\x38\x20\x4c\x0a\x19\x23\xf4\x65\x56\xe4\x5c\xec\x2f\xf1\x0b\x6b\x3a\x59\xe0\xc1\x5d\x0b\x44\x3a\xac\x28\x09\x7a\xae\xed\x62\xfd\x59\xc9\xd9\xf3\xc7\x9b\xeb\xf6\x1a\x91\x62\x9e\x17\x50\xfd\x4b\xf9\x15\x05\xb7\xd7\x0b\x35\xf7\xdc\xce\x6d\x49\x21\x4b\x31\xe5\xc3\xec\xc0\x07\x41\xf7\x6e\xf9\xfb\x6b\x52\x29\x02\xba\x2b\x8e\x07\x5e\xef\x8b\x34\xaf\x78\x59\x87\xd2\xa5\x78\xc7\xf3\x63\x1d\x95\x2c\xa9\xa3\xb2\xd8\xe3\x3a\xcc\xd2\xf0\xf6\x9a\x1c\xe1\x1b\xef\xdd\xdc\x7f\x8a\x85\x14\x36\xb7\xe7\x53\x5c\x63\x03\x1d\xdd\x33\x33\xab\x41\xfb\xf8\xc1\x78\xbc\xec\x05\xda\x92\x3a\xe2\x56\x20\xe8\xc5\x8b\xed\x8c\x8a\x9a\x7e\xf8\x25\x33\x38\x17\x70\xbd\x64\xd3\x3f\xb5\x6c\x8f\x93\x4e\x67\x92\x6c\x08\x1a\xc9\xa8\xae\x43\xa2\xd5\xce\xb8\x3b\xd4\x0c\x30\xd4\xbf\x81\xfa\x03\x6f\xe3\x93\xd8\x60\xa9\xd2\x58\x79\xc5\x44\x32\xc0\x0d\xa5\xdc\xb5\x39\x15\xf2\x77\x5b\x97\xa3\x5e\x58\xd6\xf9\xf1\x56\x28\x4a\x47\x24\x6a\xcb\xaa\x5b\xa3\x2b\x82\x97\x86\x68\xe5\x9c\x3e\xb0\x76\x53\x4f\xda\xec\x4a\xcc\x8c\xb7\x1f\x5b\x96\x9d\x08\x6e\x79\xec\x64\xb2\xb4\xf1\xbc\x88\x63\x9b\x81\x79\xcd\x98\x25\x60\x43\xf8\x3c\x39\xa6\x11\x4d\xe0\x07\x82\xa4\xc1\x7d\x09\x3f\xd3\x29\x18\x43\x9d\xab\x34\xf8\x3d\xcf\x2b\x10\xdb\x95\xd7\x02\x27\x11\x1c\xc0\x36\xea\x1d\x3d\x49\x02\xe6\x9c\xce\xbd\x97\x7a\x89\x07\x4c\x32\xa5\x03\xff\x93\x3b\xad\x56\xc4\x2a\x44\x7f\x38\xdf\x40\x56\x99\x52\x5a\xc4\x93\x90\xc6\xfa\x09\xd8\xd9\xe8\xd0\x04\xa0\x9a\xbb\xe0\x2e\x56\x32\x22\x33\x31\xcb\x81\x86\x83\x81\x12\x3b\xa5\x77\xb2\xf3\x07\x5c\xd7\xc6\x1d\x84\xf8\xb3\x13\x7a\xa7\x9a\x94\xe7\xd6\xfa\xae\x67\xbf\xa1\x98\xe1\x11\xfe\xa3\x14\xfd\x92\xd3\x56\x95\x69\x92\xf0\x12\x72\xb7\xcb\x2c\xf4\xae\x7e\x25\x18\x79\xf0\xee\xd7\x62\xb8\x61\xd7\xa3\xf2\xd5\x0a\x5c\x09\x7c\x0a\xc2\xe6\x99\x1b\x42\x3e\xd9\x0e\x0f\xdd\xb6\xb3\x19\xde\xd0\xa3\x22\x9e\x81\xb7\xf5\xb1\x0a\x92\xbf\xa7\x1b\x6f\xe9\x93\x82\xda\x1b\xef\x99\x4a\x66\xa3\xe2\x9e\xcc\x75\xe0\x13\x4c\x72\x08\x67\xaf\x3b\x07\x11\x23\x58\xe6\xe5\x7e\x5d\x9f\x1a\x92\x53\x9b\xbb\xd9\x3c\xe2\x19\x4f\x04\x4d\x7b\xbf\xe7\x4e\x36\x0f\xd2\x3c\x82\xe3\xa4\xba\xce\xc9\xc5\x6f\x6f\x69\xa7\x7f\x86\x80\x9a\x39\x29\xca\x34\x81\x3a\xf6\xf2\xe4\x26\x22\x6a\x81\x9d\x90\x88\x25\x72\xe4\x82\x11\xbd\xd4\x0e\x27\x66\x08\x04\x07\x16\xfe\x42\x7c\x04\xe5\x22\x8b\x89\x40\xe8\x87\x3d\x0b\xb9\x53\xa8\xa0\x2e\x73\x84\x1b\x41\x14\xed\x1d\x4d\xbd\x5c\xcc\x8f\xba\x12\x6c\xd1\xae\x1d\xdd\xcb\xe2\x98\x57\x74\x41\x32\x41\x21\x8e\x7b\xcb\x52\x17\x5d\x18\x82\x82\x24\x78\x22\xf6\x6d\x5d\x9f\xdb\x65\x58\xd6\x88\xad\x46\x4e\x12\x8c\x49\x26\x5e\x88\x79\x16\xbf\xba\xba\x5b\x4c\x6e\x35\x80\x6b\x80\xed\x3f\xa0\x72\x3a\x04\xb6\x70\x77\xda\xc7\x61\xd0\xdf\xe9\x94\x2c\xc8\x2d\x76\x14\xf7\x71\x0b\x5a\x7f\x58\x0e\xb9\x39\xc5\x28\x85\xdc\x37\x7a\x9c\xfc\xc7\x76\x69\x77\xe0\x66\x59\xbd\x3d\x6b\x59\xe6\x7e\xc2\xa7\x7f\x0f\x68\xd3\xd8\xfe\x1f\xc1\x2d\x3e\x7d\x08\x6c\xa3\x8f\x80\xad\x04\x00\x68\x71\x43\x37\x90\xcd\x69\x90\x30\x67\xbd\x9e\x63\x34\xd5\x30\xb4\x5e\xcf\x6d\xd7\x99\x3f\x5d\xaf\xe7\x35\x46\x78\x8a\x6c\x71\xf5\x04\x23\x38\x6a\xa0\xbb\xf3\xc8\x4b\xb7\x74\xe7\xc5\x3e\x99\x70\xcb\xda\x4f\x28\xbd\x9d\x6b\xe8\xaf\x6b\x50\x92\x8a\xa5\x85\xe7\x72\xed\x37\x96\x35\xd9\x48\x20\xbe\x9d\xb7\x30\x8c\xeb\x3a\xb2\x2c\x59\xee\xd0\xa6\x02\xb4\xd1\xd3\xa7\x60\x86\x54\xd7\x93\xee\x39\xc0\x75\x2f\xd2\x92\xf9\xcd\x00\x6e\x66\x33\x92\x29\x75\x8d\x00\x74\x79\xd5\x81\x26\x5e\x25\x96\x35\xd9\x75\xa7\x8a\x82\x83\x65\x65\x54\x3c\xe4\xa2\xb8\xbe\xd6\x1f\x14\xa4\x45\x9c\x6a\x7f\x94\xa6\xc9\x95\xcd\x48\xde\x95\xd0\x87\x26\xb0\x17\x9b\xd6\xec\x23\x17\x94\x39\xc5\x7a\x49\xdb\x33\x8a\x7c\x2a\x80\x03\x40\x75\xb2\xc0\xab\xe1\xa1\x7d\x0a\x50\xd9\x96\x46\xb2\x91\x2b\x09\x94\x08\xe2\x42\x2b\x84\x3b\xe6\xa3\xad\x1a\x8b\xd3\x47\x30\x2f\xd2\xb6\x53\x62\x27\x80\x5e\x59\x79\x07\x0d\x0e\x2f\x30\xd9\x52\x5b\x6e\x05\x19\x57\x41\xb7\x06\xce\x8c\x9e\x44\xf8\xbe\xd2\x28\x0e\x61\xb4\x7d\xab\x14\xb7\x42\x22\xa1\x01\x91\xe1\xb6\x87\x2d\x81\x3c\x99\x7a\xa1\x61\x17\xea\x85\x60\xed\x11\x74\xe0\x0d\xd9\x00\xe4\x41\xc0\xe4\x76\xbe\x2f\xf9\x2b\x35\xe2\xba\xee\xdd\x1a\xce\xcb\x81\x5c\x27\x7c\xda\xb4\xfd\x53\x98\xe7\x60\x96\x22\x5b\xc1\x8e\x6b\xf1\xc0\x8e\xe9\xc6\x0b\x85\x7c\x60\x59\x93\x60\x9e\x1e\x7e\x28\x8b\x3d\x4b\x20\xc7\xc0\x9b\xaa\xd8\xef\x79\x64\x0b\x34\x30\x0f\x8f\x65\xc9\xf3\x4a\x75\x2c\x9e\xf3\x8c\xef\x8c\xc4\xf3\x76\xd2\x91\xf6\x83\xcc\x4e\xa7\x2a\xfc\x7a\xb7\xe3\x51\xca\x2a\x3e\x5a\x73\x30\x2f\xdb\x8d\x01\x1f\x74\xb7\x72\xe3\x24\xbd\x8d\x63\x07\xaa\x91\xef\x83\x2d\x4d\x48\x30\x17\x34\x87\x26\xf0\x43\x38\xb5\xed\xe1\xca\x24\xed\x16\xf5\xa5\x57\xaa\xfc\xbc\xae\x13\xdd\x5b\xac\x88\xb6\x1a\x52\x6a\x9c\x08\x0b\x56\x10\x9c\x26\x8e\x59\x45\xb9\xf6\x0a\xb4\x03\x70\xa2\xe6\x79\xf5\x4a\x32\xef\x42\x0e\x82\xc3\x42\x63\x84\x36\xc6\x5d\x04\xc0\xf9\xbe\x38\x54\x7a\xc5\x2c\xab\x7f\xdf\x5b\x41\xa2\x9b\x03\x43\x18\x39\x9b\x97\x2d\x0a\x04\x50\x0b\x7a\x97\xd2\xa0\x8f\x09\xc8\x96\xb2\xb9\xcc\x28\x01\x11\x98\x2d\x6b\x6b\x9a\x0c\xd8\x08\xc4\x02\x33\x44\x3e\x9b\xcb\x18\xfc\xcf\xe9\x52\x59\xe2\x6d\x27\x54\x06\x70\xd9\xd2\x6d\xcf\xb7\x17\xd4\x7a\x2a\xda\x92\x59\xad\xae\x75\xd2\x05\xf6\xdf\xb6\x19\xb1\xc1\x58\x14\x4b\x3d\x87\xf2\xe9\x3c\x35\x44\x86\xb3\x4f\x61\x47\x44\x14\x34\x28\x9c\x46\x2d\x86\x9b\xa2\x2b\xd4\x29\x74\x12\x8f\xfb\x82\x6d\xf6\xb8\x4f\xa3\x1e\x9f\xe0\x96\x36\x97\x71\x19\x54\x3c\x82\x2d\x38\xfe\xa9\xe0\x2e\xf2\x95\xca\x61\xba\x6d\x73\x98\x62\x22\x2b\x8c\xdb\xc8\xf3\x71\x8b\x1a\x37\x2a\x78\xb8\x80\x08\x67\x6b\xac\x44\xd3\x2e\xea\x56\xb9\xab\xdc\x04\x1f\xfd\x2a\xd0\xd1\xff\x20\xe4\x39\x30\xd6\x02\x52\x06\xeb\x3a\x6e\xc4\x57\xce\x01\xdb\x76\x96\x84\x84\x91\x13\xcf\x8f\x3b\xae\xed\xf7\x86\xf6\x7c\x60\x43\x37\x74\x49\x30\xa4\x00\x6d\x5c\x22\xf6\x45\x9a\xb3\x0c\xea\x6f\x8d\x05\xc6\xde\xf5\x8e\x79\x3e\xf8\xf9\xf9\x1b\x8f\xf9\x03\xd3\xc2\x8b\x43\x55\xa7\xbe\x1f\x19\xdd\x43\x99\x56\xfa\x5a\x59\x34\x82\x6e\xb9\x21\x71\x3a\x1e\x09\xc2\x6b\x2d\x34\x7d\x97\x39\x10\x7a\x7f\xae\x48\x18\x6e\x88\x42\x14\xce\x29\x2b\x58\xe4\x9c\xf2\xe2\xcb\x63\xa0\x0c\x23\x55\x8a\x94\x93\x62\xfc\x47\x66\x61\x42\xa9\x90\x84\x65\x78\x88\x39\x14\xef\x4d\x45\x2c\x53\xa4\x90\xc9\xb2\x21\x3d\xce\x05\xc1\x9b\x34\x47\x0d\x09\xb2\x63\xf9\xa1\x36\x68\xaf\x0d\x51\xba\xd7\x84\x78\x70\xb9\x85\xe2\x58\xa1\x86\xc0\xbe\xbc\xd4\x06\xd2\x8a\x78\x81\x0e\xa0\x4a\x89\x11\xa4\x99\xa5\xf8\xd2\xb2\xbe\x54\x34\x51\xe9\x6c\x7a\x1d\x80\x22\xaa\x07\xad\x4e\x7a\xdc\x6d\x5f\xa1\x24\x82\x18\x10\xf1\x80\xc7\x45\xc9\x8f\xb9\x9c\x79\x13\x27\xf6\xc9\xba\x46\xc7\x4c\xe1\x46\x81\xad\x7a\x40\x06\x16\x87\xbd\x27\x73\xd9\x28\xa8\x55\xda\xef\x70\xd3\x34\xd2\x90\xbf\xe5\x62\xce\x0c\x94\x47\xcd\xca\x45\x83\x63\xd6\xe6\xca\x06\x6d\x7e\x5e\x55\xcf\x26\xa0\x17\x45\x48\x16\x76\x6d\x06\xbe\x63\x6d\x1c\x9d\x7e\xf7\x75\x48\x1d\x30\x1d\x96\xc5\xe4\x83\xf4\xa0\x68\xce\x0f\x92\x02\xf1\x88\xb6\xa1\x0b\xdb\x47\x75\xdd\x19\x93\x9d\xbd\x94\x63\xe9\x26\x47\xd0\x34\xf7\x9e\x39\x0f\xba\x49\x49\xe0\xf5\x5a\x59\xd6\xa7\x92\x4a\xc0\x9d\x99\xe5\x5e\x3d\xe9\x28\x83\xd3\xae\xaf\x84\x8b\x1e\xc3\xc0\xfa\xf7\xb2\x08\xc4\x2e\xe5\x51\x5b\xa4\x77\xaf\x8e\x9b\xe4\x14\x90\x40\x8a\x89\x20\x7a\x6a\x5a\x29\xdf\xa7\x3b\xfe\xa6\x62\xbb\x3d\x95\x33\xaa\x6f\x05\xbb\x9a\x17\x0f\xb6\x24\xe7\xd2\x20\xa5\x43\x04\x40\x8c\xfa\xb8\x00\xec\x04\xcf\xd0\x2d\x3d\x19\x91\xa2\x1c\xf5\x9a\x9c\x2f\x83\x98\xbf\x31\xe6\x49\x3e\xff\x00\x0f\x24\x0b\xbc\x49\x77\x47\x18\xbb\x33\x59\x92\x3e\x77\x71\xee\x9e\x7a\x0e\x2f\xab\x4b\xc0\x71\xcf\x08\xb3\xac\x89\x7a\xdd\xb6\x02\x99\x8d\x06\x3c\x4c\x43\x06\x2c\xcc\xbf\xd3\xf0\xf9\xb8\x3e\xd4\xf4\x19\xaf\x24\xdb\x1e\x9b\xa5\x7f\xa7\x13\x1f\x98\xe5\x8f\xf5\x66\xec\x53\x5b\x41\xd8\x79\x6f\xc1\xbf\x06\x62\xde\xb0\xac\xfa\x3b\x7f\x2f\x68\x51\x00\x64\x03\x22\x4b\x85\x62\xaf\x67\x2d\x01\xdb\xb0\x3c\xe1\xd1\xdb\xe2\x08\x89\x47\xc4\x93\xaa\xcc\xd4\x57\x11\xaf\x58\x9a\x89\x2b\x58\x8c\x1f\x36\xec\x00\x1f\xed\x78\xc5\x54\x91\x3d\x4b\xf8\xaf\xfa\xe2\x9f\xe2\x02\x8c\xd6\xd4\xdb\xfb\x94\x3f\x88\x5f\x14\x6e\x58\x89\x54\x7b\xe5\x4b\xb1\x1d\x27\x0b\x72\x2b\x0b\xdd\xf2\xf7\xfa\x89\x4a\xe1\xd4\x5e\xc9\x0e\x65\x29\xcf\xab\x5f\xbb\x4b\x68\xa6\x88\xe3\x03\x97\x4f\xe5\x25\x3c\x55\xfa\xe6\xaf\x23\xe3\x06\x08\x8e\xe8\x58\x58\x72\x9e\xff\xda\x5d\xc2\x17\x12\x29\x18\xe3\xaf\x0a\xa5\x26\x96\x37\xed\xf3\x87\x4d\x3a\x2a\xd1\x69\xde\x74\x35\x88\xc2\x0a\xe5\x2d\xeb\xc0\x74\xaa\x2a\x08\x5a\xec\x2a\x47\xc9\xb9\x9e\x08\xb7\xbb\x74\xd8\xbc\x9d\x8b\xf6\x7b\xd3\x7e\xbc\x1a\xd4\xb5\xb4\x02\x77\xe9\x3c\xb3\x02\xf7\x53\xe7\x33\x2b\x70\x9f\x39\x0b\x47\x7d\x28\xa1\x40\x2b\x54\x05\x80\xe0\x16\x2c\x40\x35\x2f\x76\x60\xe9\x20\xb8\x2e\xee\x79\x89\x08\x5c\x66\x9c\xdd\x73\xfd\xf8\x58\x21\x3d\x89\xaa\xb8\xba\x93\x1f\xa8\x1b\xf5\x89\x7e\x05\x34\x7d\x40\x6d\x06\x62\x0f\xf3\xe9\xa9\xc7\x0e\x04\x44\xab\x4a\x9c\x40\x71\xa6\x67\x33\xad\x03\x38\x12\x3e\xc4\xc5\x90\x26\xa1\x15\xbc\x5a\x4b\x13\x95\xc8\x36\x12\xb8\xb6\x3d\xb9\x82\xf8\x35\x75\xad\xa6\x90\xc6\xad\x00\x66\x6a\x7f\xc7\xd5\xda\x44\x7d\x03\xe1\x9d\xcf\xac\x16\x7b\x99\xc7\xb4\xb9\x92\x0e\x5b\xc4\x5a\xa3\x41\x78\xde\x90\x22\x3f\xd7\x8b\x5d\x2a\x4e\x96\xe2\x83\x38\xbe\xe4\xff\x02\xf6\xb4\xe7\x38\x53\x3c\x69\x27\x45\xf3\x44\x91\x39\x53\xa4\xb4\xd9\x40\xa6\x97\xaa\xfd\xa8\x93\x6a\xdd\xa8\x9d\xa1\x29\x9a\xa3\xa9\xf1\xca\xe9\x5e\x91\x4e\x2a\x22\x51\x2b\xba\x02\x82\x1a\x3d\x40\x51\x89\x2e\x20\x80\x20\xc3\x12\x67\xc6\x31\x84\xd8\x66\x97\xa2\x03\x06\x13\x29\xe0\x76\xae\xee\x5d\x7c\x55\x88\x2a\x12\x90\xa0\xb5\x22\x0c\xb5\x34\x1c\xd2\x07\x15\x4e\xed\xe2\x81\x43\xcf\x90\x99\x84\xd2\x6e\x5a\x99\x83\xfc\x0e\x36\x1f\xee\x84\x95\x9c\xd5\x41\x59\x87\x45\x56\xf3\x5d\xc0\xa3\x7a\x53\xd6\xe9\x2e\xa9\x81\xe7\xac\xb3\x34\xbf\xad\x05\x56\xac\xf7\xac\x64\x3b\x6c\x5f\x36\x13\x79\x2a\x23\x58\xe2\xf5\xf5\xf3\xeb\x24\x25\x2f\x44\x03\xf2\x90\xb4\xbe\x01\xbb\x9b\xfa\x46\xd4\x76\x9d\x92\x2f\x19\xbd\x56\x47\x7b\xeb\xc3\x53\xdb\x75\xbc\x77\xd4\xaf\xe9\xfa\xf0\x54\x9f\xf8\xcd\xf1\x75\x4a\x5e\x32\x7a\xfd\xae\x2a\x8f\x7c\x7d\x6d\xcf\x9f\xe2\x6b\xf2\x4a\x3c\x58\x1f\x9e\xde\x4c\x6c\xd7\x59\x7b\x2f\x5f\xbd\x78\xfb\x62\xed\xd5\xb3\x19\xae\xc5\x03\x7f\xed\x8b\xeb\xe7\xeb\xc3\xd3\x27\xa6\xf3\xc8\x57\xac\xc7\x1a\xca\x30\x55\x82\x4c\x20\x2c\x58\xec\xf3\x78\x68\x81\x79\x42\x8d\xaa\x12\x61\xb7\xb4\xd1\x73\x30\xe6\x40\x84\x61\x6f\xe1\xd7\x35\x73\x8c\x50\x29\xaf\xfb\xd9\x83\x60\x37\xd9\x12\x21\x5e\xca\x7d\x37\x45\xd7\x68\xaa\x78\x4c\xa3\xa6\xbf\x74\x06\x68\xf4\xa5\xd2\xe8\x2a\xac\xd8\xfa\xa8\xb9\x7a\xbf\x7a\x4b\xdf\xd1\x2c\xf2\x59\x0b\x66\xad\x7f\x65\xa3\x4a\x0c\x92\xca\xc0\x0e\x83\x04\x99\x42\x3c\xe9\xeb\xaa\xed\xd8\xb0\x49\xc7\x24\xa1\xd2\x2e\x23\x20\x31\x26\x5b\x1a\x77\x7a\x6b\xa5\x8f\xd4\x5a\x1e\x92\x74\x87\x42\xab\x76\x63\x6c\x55\xec\xc3\x05\x89\xe8\xd6\xe3\xfe\xd0\xe8\xc3\x3c\x30\x0b\x08\x27\xa2\x8c\x17\xfa\xb8\xf9\xb5\xdf\xa9\x0d\xfd\xd5\xe8\x54\x4a\xcd\x78\x56\x1b\x4c\x7e\x55\x7d\x84\x68\x07\xed\x54\x7c\x3d\x48\x16\x72\x21\x83\x62\x17\xc3\x3c\xb4\xac\xed\x80\x3c\x05\x1a\x4e\x69\x9b\xfa\xd2\x51\x1f\x48\x6b\x35\xa4\xcf\xa5\xe1\x1e\xf4\x67\xe6\x89\x33\xed\xc7\x3d\x37\x4c\xf7\xfe\x66\x98\x84\x06\x54\x1f\x43\x7a\x3e\x09\xe4\xb6\x15\x0b\xd7\xd9\xa9\x2c\xc8\xae\xf3\x47\xcd\xe9\x6e\xb6\x24\x77\x10\xd4\x98\x1c\xfa\x71\x50\xee\xe0\x94\xe1\x50\xd7\xbb\xe7\xcb\x11\xe7\xba\x3b\xcb\x9a\x98\x07\xf4\x96\xf5\xa5\x1a\xf1\x9d\x11\xaa\xbd\x8f\x66\xda\x60\x23\x6c\xce\xef\x6c\x8e\x57\x07\xcb\xb2\x21\x8b\xee\x9d\xa1\x5a\xe3\x24\x06\xfb\x11\x1b\x63\x4c\xfe\xc6\xec\x58\x13\x0a\xe8\xd0\x0e\x28\xd9\x1d\xb3\x05\x76\x5c\xf8\x03\xdb\x8b\xc9\x92\x80\x6f\x6b\x4c\xb9\xb9\x21\x97\x90\x50\xbe\xb3\x29\x31\xdd\x75\x62\x4c\xe2\xba\x8e\x94\xc2\x6b\xa3\x42\xc5\xe5\xcc\xe6\x86\xc1\xc6\x6b\x00\x16\x9d\x5b\x6e\x95\xdd\xec\x56\xd9\x74\x8a\xb7\x94\x13\xb1\x5f\x73\x48\xd1\x52\x4a\xd3\x01\x7b\x4b\x26\x0b\xc8\x11\x94\x0a\x49\x48\xda\xb4\x6c\x48\xce\xec\x6d\x57\x23\xc6\x24\xd4\xae\xb2\x99\x4f\xb6\x24\xc3\x32\x01\x1b\x64\x7c\xa1\x1b\x6f\xd3\x85\xd6\x1d\x8c\x51\x76\x70\x43\xfe\xc2\xb0\x58\xcf\x55\x76\x93\xaa\xce\x6c\x44\x55\xda\x78\x64\x6b\x1a\x8f\x4c\xda\xad\xb8\xed\x1b\x3e\xf5\x6c\xa3\x6f\xc9\x16\x72\x3e\xcd\x0f\x65\xe8\x96\xf3\xdf\xf8\x3d\xcb\x7e\x2a\x33\x51\x46\x5f\xcb\x97\xd8\xd9\x8b\xfa\x3b\xa3\x9a\xd6\x97\xed\x15\x83\xdc\x00\xb7\x9d\x4a\xd5\x40\x29\x7f\x3f\x4f\x5f\x46\x38\x0d\x7a\x51\xbd\xb1\xc3\x48\x4c\x17\x2b\x95\xdb\x26\x52\x16\x97\xf1\x74\x8a\xc3\xba\x5e\x4e\xcc\x70\xda\xc0\xcc\x64\x9c\xe5\xb0\xbf\x73\x99\x2d\x2c\xea\xa5\x85\xb5\xc3\xde\x08\xa3\xa1\x75\x2e\x06\x4b\x1c\xf1\xa9\xb1\x36\xbd\x3a\x7a\x56\xb5\x46\xb6\x26\x66\xfa\xcf\x99\xe6\x4e\xe3\xb9\x6a\xf5\x04\xfd\xce\x08\xba\x79\xb2\x7c\x7e\x73\xfd\xe4\xd9\x73\x24\x83\xc7\x9e\xf1\x3f\x2d\x3b\xa3\x14\xc7\xac\x6f\x94\x02\x78\xeb\x03\x16\xed\x32\xf0\xce\xd0\xb8\x45\xce\x9e\x19\x0a\x7f\xd9\x7f\x20\x5d\x44\x65\x9a\x00\x9b\x61\xa9\x5c\x06\x53\xa6\x8d\xd8\x51\xb9\x40\xa1\x2a\x9e\x6d\x3c\x4c\x38\xf7\x35\xb3\x63\x2f\xf2\x49\xe2\x45\x3e\xb4\x1f\x40\x18\x5a\x2c\x95\xc8\x71\x5d\xcb\xaf\xc1\x26\x4d\x56\x78\xa1\xa2\xbf\xf6\x2a\x82\xe3\x29\xa0\x44\x9b\x2e\x88\x05\xf4\xc8\xd8\x9c\x89\x91\xe8\xa7\x60\x76\x42\x26\xa9\x65\x81\x09\xa4\xb1\xa4\x1b\xe9\x12\x92\x9f\x39\x76\x99\x49\xc6\x22\x30\xb2\xee\xf1\xe7\x00\x8c\xad\xdc\x61\x87\x60\xf7\x2c\xe1\x31\x8d\xed\x9f\xc0\x67\x1f\x22\x50\x87\xde\x2f\x9d\x73\x2c\x3c\xd2\x24\xae\xf3\xaa\xd6\x4f\xb8\x17\xf9\xee\x80\xe5\x82\x9c\x60\xfd\x73\x3b\xb0\x8f\xd1\xe7\x76\x2b\xb3\x01\xc5\xdb\x35\xa1\xf7\xab\xe1\x4f\x6d\x9b\xb7\x9a\xfd\x3b\xe7\xce\x23\xc8\x9e\x32\x1a\x24\xb5\x65\xb6\x0d\x57\xb8\x0f\x16\xc4\x23\x49\x80\x55\x99\xa1\x23\x20\x3b\x77\xdc\x66\xae\xcc\x54\x21\xed\x8f\x95\xd1\x32\xa4\x47\xc6\x67\x1c\xea\x52\x1d\x72\x0c\xe1\x77\xf0\xec\x8b\xe1\xa3\xba\x96\x5a\x3b\xd3\x04\x90\x75\xde\x80\xec\xcc\x37\x0a\x37\x44\x1a\xa2\x8d\xd8\x69\xff\x8d\x0d\x04\xa1\xde\xf0\x14\x5b\x34\x68\x7e\x39\xf2\xec\x8b\xe1\x23\xcd\xbf\x7d\xd5\x4e\xec\xaa\x6f\x0f\xc7\xa4\xe6\x7c\x5f\xf2\xff\x57\x74\x2d\xcd\x0f\xbc\xac\xbe\x04\xb5\xb0\xc0\x59\xbd\x98\xb0\xa2\xa3\x52\x63\xfc\x6f\xf7\x13\x5a\x36\x11\xf8\xe0\xc1\xb0\x61\x69\x35\x2f\x96\x2c\xae\xfa\xfa\xf2\xff\xb5\xe6\x7a\xd9\x45\x44\xd3\x67\x91\x2a\xdb\x40\xe2\x90\xc0\x40\x51\x32\xa9\x0e\xf3\x02\x1f\x43\x3a\x83\x61\x5a\x12\x7b\x40\xca\x18\xe4\x22\x10\x62\x76\xcf\x74\xb5\x2f\x0d\x8e\x10\x0f\xc3\x55\x4a\x69\x57\x2c\x8b\xa9\x58\x1f\x94\x06\x2e\x73\x02\x39\x0a\xc1\x44\x8c\xfa\x04\x01\x0f\xd3\x73\x9b\x15\xe4\xed\x0f\xee\x70\x09\x2a\xca\xa7\x10\xac\x41\x24\xcf\x6e\x38\xc8\xf5\x5d\xa8\xcf\x52\xed\x6b\x89\xa5\xb3\xdf\x1c\x8d\x62\xc1\x2c\x6b\xf2\x82\x75\x41\x75\x27\x3b\xe6\xb5\x46\xcc\xec\x43\x46\xcc\x3e\x3e\x31\x3a\x34\x51\x66\x18\x42\x55\xc2\x71\xaa\x96\x29\xd4\x38\x42\x39\x8e\xe5\x20\x35\xd9\x70\xc1\x02\xb9\x60\xa6\xdd\xa9\xd8\x29\x74\x61\x78\xe5\x37\x81\x82\x30\x8d\xe4\xe4\x0e\x87\xd3\xad\xcb\xd8\x48\xb1\x0e\xfd\x40\xa2\x5a\xc7\xea\xb5\xde\x44\x1f\x80\xf4\x56\x76\x19\x80\xf7\xaa\xb3\x77\x56\x5b\xfb\x66\x71\x3e\x32\xd8\x63\xca\xb7\x50\xf5\x45\x99\x93\xab\x5c\x1e\x0d\x61\xda\xe3\x59\xaa\x5a\x61\x54\x6f\x0b\x07\xc9\x2b\xa4\xd1\x96\x78\xa4\x2e\x11\x31\xb7\x96\x83\x24\xbe\xd0\x4f\x5f\xc0\x6e\x46\xb0\xa9\x91\x9e\x80\x17\x59\xe6\x20\x63\x32\x46\x54\x6b\x83\x08\xd0\xac\xe7\xd0\x24\xd3\x14\x41\x24\x9b\xb8\x4d\x82\x36\x5b\x92\x84\x2e\x56\xc9\x0d\x8d\x57\x89\x60\x32\x21\xd3\x62\x6c\x38\xc0\xca\x0d\x21\xd8\xae\xd2\xe6\x5e\xe2\x63\x2f\xf0\xed\x10\x13\x6d\x42\x18\x91\x50\x06\x7f\xef\xed\x4d\x23\xa6\x73\xd4\xc5\x74\x66\xf4\xfa\xdd\x8e\x95\x49\x9a\x5f\x93\x6f\x87\xbe\x93\xda\x5f\xd2\x9d\xec\x1f\xb1\x74\x9a\x9c\x6a\x9f\xc9\xef\x18\x3d\x5f\xcd\x41\xca\x8a\x56\x40\x4c\xf9\x83\x91\xa2\x26\x9c\x17\x7b\x9e\xf3\x12\xf4\x42\x0c\xcb\xde\xbe\x2c\x76\xfb\x63\xc5\xa3\x37\xe0\x56\x17\xe0\x66\x65\x1a\x93\xb7\x1c\x7b\x20\x0f\x1e\x53\x7c\x4a\x95\x23\x54\x78\x38\xbc\xe5\x8f\x15\x45\x41\xf1\x38\x3b\xa4\xbf\xa7\x79\xe2\x04\x45\x19\xf1\x72\x16\x14\x8f\xab\xbd\xca\xb3\xe6\xe8\x64\x77\x2b\xe5\x39\xe5\x80\xf7\xd9\x4a\x0e\xde\x61\xc7\xaa\x58\xc9\xcf\x9c\xe5\xfe\x71\xb5\x67\x51\x24\x6a\x12\xd7\x55\xb1\x77\x96\xff\xb1\x82\xa4\x68\xce\xe7\x8b\xff\x40\x24\x35\x6d\xb9\x11\x29\xfb\x86\xec\x1b\x15\x86\x5a\x6a\x4d\xfa\x03\x4b\xf1\x2a\xa4\x68\xf9\x1f\x48\x1a\x82\x16\x7b\x92\x50\xf4\x6c\x2f\xfd\xca\xe7\xb2\x33\xdf\xf0\xb8\x22\x9c\xa2\xcf\xf4\x63\x68\x99\xe8\x01\xcb\x42\xe0\xa8\x4a\x11\x74\x27\x36\xca\x1a\x6f\x45\xbf\x4c\x59\x61\x23\xf8\x74\x88\xc1\xd9\xf4\x32\xc4\x9f\xdb\xd7\x83\xe5\x3d\x49\x2f\xbc\x58\xa5\xad\x93\x9d\xee\x53\xc0\xc2\xdb\xa4\x2c\x8e\x79\xf4\x32\x4b\xf7\x14\xa9\x80\xe9\x62\x05\xc4\x6c\xf5\x2d\xd8\xc7\x3f\x41\xa4\x80\x5d\x5e\x82\x88\x00\xb3\xd5\xaf\x87\x52\x3a\xde\x1c\xd9\x9c\x83\x02\x2c\xe4\x42\xad\xd9\x7f\xee\x1f\x57\x32\xb3\x9d\xb3\x80\xd5\x5c\xac\x32\x1e\x57\xce\xec\x8b\x2f\xbe\xf8\xc2\x58\xec\x85\x82\x86\x19\xac\xf8\xde\x00\x1e\x16\x40\xa0\x4f\x8e\xe4\x26\x6b\x97\x3a\x35\xa2\x2a\x14\xe4\xb4\x4f\x1f\x79\xa6\x33\xfb\x8d\xd0\xfe\xc0\x86\xe4\x83\x41\xf1\xf8\x06\x00\xf5\x47\x9e\xa5\x17\x62\x45\x8b\xa2\xbc\x21\x50\xe3\xb7\xdd\x9a\x5e\x28\x19\x0b\xa4\x2c\xeb\xfa\xb6\x85\xa1\x0b\x65\x93\xa6\xc1\xb8\xb1\x8d\xac\x0d\xdf\xb3\x4b\x52\x1e\xcc\x6b\xe7\xaf\x15\xd6\xf5\x77\x20\x36\x85\x60\x83\x0e\x1b\x57\xdb\x60\x80\x16\xc8\x0e\x70\x5d\x87\x5e\xe0\x13\x24\x20\x3c\xe9\x69\xf7\xcf\xe5\x42\xb0\xaa\x36\x5c\x69\x31\x26\x93\x62\x3e\x1c\xb3\x8d\x2d\xeb\x5b\x45\x59\x13\x6c\x59\xdf\x30\x9d\x7e\x0b\xac\xfa\x37\x6a\x7f\x70\xba\x99\xef\xd2\xfc\x17\xb8\x89\xc5\x0d\x7b\x94\x37\xdd\x73\xe3\xa9\xfe\x8e\x26\x44\x8c\xe4\x41\x95\x94\xcf\x22\xf3\x1b\x4e\x8c\xaf\x62\x6c\x18\x79\x25\x6e\x32\x45\xc8\x31\xb2\x88\xff\xd0\xd3\xd3\x9e\x7a\x31\xa9\x3a\x4e\xc8\x56\x29\x6d\x94\xa6\xb1\x52\x91\xa0\x9c\x36\x71\x07\x0d\xf0\x05\xeb\x7d\xb9\x77\xff\x01\x3e\x19\x79\x91\xf3\x1a\xb4\xc0\xb6\x3b\x99\x85\x1e\x67\x3e\x9e\x4f\xf1\x35\xf9\x51\xbc\x9e\xcd\xae\xc9\x1b\x46\x4f\x2d\x10\xa3\x0e\x8a\xef\xd3\x43\x1a\xa4\x59\x5a\xbd\x77\xd0\x26\x8d\x22\x9e\x23\xa2\xf1\xa2\x72\xcb\x6d\xc8\x5b\x46\x4f\x19\xaf\x2a\x5e\xbe\xd9\xb3\x50\x6c\x10\xb4\x40\x24\x2e\xf2\xea\x17\xb9\x99\xd0\x67\x8b\x05\x6a\xc8\x4f\x8c\x7a\xe8\x17\x1e\xdc\xa6\x15\x22\xe8\xdb\xe2\x77\x44\xd0\xee\x80\x7c\xf2\x33\xbb\x80\x41\x14\x5c\xb5\xb3\xf6\x0b\x53\x22\x01\x13\x52\xe9\xcf\xac\xf3\x7b\xd0\xd1\x9b\x16\x82\x67\xfa\x69\xbf\xd7\x3c\xd3\x54\x27\x48\x58\x62\x12\xd2\x9f\xd8\x79\xb0\x1a\x08\xf0\xfb\x13\xf3\x42\x7f\x1a\x90\x61\xbd\xdd\x82\xfd\xca\x4c\xf3\xcf\xf0\x00\xc7\xd1\x07\x8f\x0d\xa2\xa9\xf5\x5e\xd1\x5f\x64\xf0\x21\x86\x49\xd0\xd5\xf4\x4f\x36\x48\xcc\xa6\x6d\x99\xbb\xcc\x60\x2e\xe4\x56\xde\xb1\x47\x7b\x41\x22\xef\x99\x3f\xb3\xc3\xba\x5e\x60\x3c\xb5\x23\x70\x70\x07\x6f\x76\xc7\xa8\xf3\xbf\xd8\x88\x5d\x36\x5d\x28\x2f\xe1\x90\x52\x6a\x47\xae\x42\x77\xc8\xd1\xe8\x12\x61\xf7\x33\x07\xc9\x24\x9e\x10\x3c\x63\xe9\x2c\x56\xf1\xcd\x67\xab\x78\x4a\x9f\x61\x24\x91\x9c\xf6\x82\x4e\xa6\x6d\x94\x81\x70\x1a\x82\x13\xf5\x64\x01\x79\x24\x22\xd7\x6e\x6b\xd4\x85\x67\x5d\x48\x02\x85\x35\x51\xff\x23\x5d\xfb\xe4\xfc\x03\xd5\x4d\x59\x7e\x8a\x7e\x91\x49\x46\xe5\x77\xd8\x31\x3b\x32\x5a\x77\xf7\x74\x72\xd6\xf3\x0f\xd7\x3d\x92\xed\xff\xc9\x10\xe5\x51\x89\xd8\x62\xaa\x90\x21\xc7\x82\x32\x77\x8c\x44\x2f\x1a\x43\x8b\xc0\x11\x99\x2c\x49\x77\xaa\xf1\x6d\xeb\xed\xe8\xc6\x8e\x1d\xd1\xc4\xb2\xec\x62\x7e\x86\xee\x6d\x5c\xd7\x71\x17\x16\x42\x88\x60\x04\x09\x0e\x04\x29\xf7\x9e\x98\x32\x0f\xc9\x83\x73\x34\x0d\xce\x81\x3f\x68\x81\xdf\x17\x5d\x86\x40\x60\xaf\xb3\x82\x55\xe0\x2d\xb9\x20\xf1\x54\x03\x8e\xc0\xb0\x63\x00\x02\x00\x35\x05\x78\x33\xd4\x86\xe1\xe1\x20\xe3\xd0\x9c\x0a\xb1\xe3\xab\xf7\xce\xe9\x3c\xaa\x1e\xe8\xd2\x14\xc3\x07\x93\x85\x54\xe1\x56\x16\x04\xf7\xf8\xd0\x45\x4b\xe4\x84\x60\x37\xd5\x86\x66\x70\x4e\x2c\x4f\x77\x60\x0b\xf1\x75\xc5\x4b\xb8\x00\xab\x53\x69\xae\x97\x1d\x77\xdd\x6d\x9c\x66\xd9\xf7\xaa\x1b\xe2\x36\xe3\x8f\x7f\x29\x8b\x07\x7d\xfd\x66\x53\xa6\xf9\x2d\xdc\x75\x18\x69\xb2\x20\x59\x9a\xf3\xbf\xb6\x77\x45\x57\x81\xe4\x09\xe0\x62\xbf\x61\xd2\x7a\xe1\x21\x8d\x8a\x07\xb8\xfa\xfd\x6b\x48\x07\x25\xae\x8a\x62\x07\x26\x7c\x7a\xc7\x3b\x27\x14\x8b\xc9\x15\xf3\x77\x38\xc0\x3c\xa3\x86\xc0\xd2\x8d\x1c\x13\xcb\xf3\xde\x4f\x07\x9a\xcd\xff\x1c\xdc\xab\xa5\x37\x02\x77\x90\xcd\x30\xda\x5e\x4a\x7f\x6c\x29\x1d\x58\xe5\xf6\xa8\x71\x0a\x78\xe9\x57\x66\x6f\xb0\x0c\x32\xac\x17\x0f\xc2\x5f\x18\xb7\x1b\xc3\xb1\x39\x74\x13\xcb\x42\x09\xaf\x50\x9a\x5f\x25\x86\xfd\x82\xcd\x69\xa2\x22\x0a\x4d\x96\x24\xc2\xd8\xe5\xce\xd6\x0b\x7c\xc7\x8e\x5b\x3f\x36\x23\xd8\x1b\x40\x29\x6f\x51\x5c\x88\xb1\x65\xc9\x2c\x7a\x76\x48\xe3\x76\x07\xc5\x14\xe5\xb0\xee\x08\x13\x29\xfc\x0b\xf6\x5f\xe1\x12\xfd\x4a\xd5\x16\x4e\x21\x7c\xfd\x79\xa0\x8f\x4d\x17\xe8\x03\x9f\xf3\x88\x75\x8d\xd4\xf9\x14\x98\x61\x74\xa1\xc8\x3a\x1e\x11\x09\x3e\x43\x0c\x86\xa2\x34\xdf\xf0\x32\x05\x1d\xad\x65\xa1\xc3\x60\x1e\x28\xe8\x53\x13\x15\xa9\x4e\xac\x26\x78\x85\xb9\x5b\xf1\xa4\xb5\x35\x85\x1c\x0f\x50\x5d\xd8\xf2\x02\x58\x02\xf9\x08\x38\xfc\xc1\xf5\xfd\xef\xac\x6a\x7f\x29\xcd\x15\x5c\x90\xae\x6b\x6d\xb8\x0d\x85\xd8\x22\x0c\x81\x38\xca\x1d\xcb\x54\x28\x0e\x88\xe9\xf8\x96\x41\xa1\xb7\x4c\x62\x23\xd8\xc1\x75\x1d\xba\x76\x0f\xbd\x70\x75\x78\xbf\xa8\xeb\xf4\xf0\x3a\xcd\xd3\x8a\x03\xb2\xab\xeb\x85\x23\x23\x8a\xb7\x42\xb7\x87\x24\xcb\x8d\x88\xa2\x44\xfe\x99\x70\x6c\x8c\x8d\x0e\x11\x8d\xde\x4b\x3a\xb2\xda\xe4\x1f\x6a\xae\xce\x43\xe2\x08\x4a\x0c\x92\x16\xd8\x1f\xfd\xc8\xc3\xea\xd0\xe6\x52\x13\x3b\x2d\xe1\xd5\x97\x02\x0e\xd2\x3c\xe9\x8a\xd8\x58\xb2\x7a\xee\x13\x3d\x2d\x0e\x17\x57\x6f\x8c\x4c\xad\x2d\xc3\xd6\x96\x69\xf0\x59\xb0\xd3\x5e\xe4\x9b\xc8\xb2\xbe\x53\xe7\x0a\x91\x65\x29\x24\x1c\x91\x3f\x46\x47\x62\x6c\xf8\x9d\x26\xe7\x1b\x4c\x85\xb4\xb1\x79\xc7\x2b\x80\xf5\xaa\x26\x22\xe0\x24\x69\x04\xb8\x21\xc0\x91\x84\x24\xd1\x2a\x77\x3d\xdf\x86\x9c\x49\x7f\x60\x76\x31\x3f\x97\x1d\xc8\x08\xce\x97\x3d\xb3\x0d\x70\x90\xe8\xbf\xab\xad\x5b\x8b\xd1\x09\x17\x42\xd7\x0c\x66\xf9\xd4\x7d\xe3\x2c\x9a\x91\x19\xff\x70\x25\x0d\x96\xb4\xcb\x50\xf1\x28\x39\x1e\x21\xa2\xa5\x39\x84\x88\x92\x03\x15\x3b\x70\xae\x9d\x69\x01\x90\x4d\x05\x08\xca\x93\x8a\x6e\x71\xcd\x73\x41\x38\x1c\x3a\x35\x02\xa5\x9d\x3b\xf8\x86\xda\x21\xed\x0a\x61\xc7\x0b\xfd\x55\x74\xf3\x19\x1c\x1e\x71\x8f\x09\x96\x24\xf2\x45\xfd\xb1\x17\xf9\x75\x1d\x7b\xd1\xec\x19\xfc\x2e\x8c\x80\x4a\x0d\xf9\xa6\xd5\x1d\x6a\xfc\xd7\x75\x4d\xe0\x1f\xfa\x4f\x86\x87\x07\x27\x43\x94\x73\x59\x11\x3a\x10\xef\xc4\x48\x04\x1f\x99\xc6\xf6\x30\x16\xac\x1c\x73\xa4\xf8\x21\xde\xb9\xea\x25\x37\x1c\x14\x52\xb1\x17\x78\x89\xef\x77\x90\xe6\x25\xbe\x24\x1a\x7a\x38\x71\x33\x0c\xaf\x1a\xba\x66\xc0\xa4\x10\x3b\x1d\x98\x36\x60\x49\x75\x1e\x01\xb1\x0b\x3d\x17\xf1\x8c\xbd\x1f\xc4\x70\x6f\x03\x14\x96\xf3\xf8\xd1\x15\x7f\xe6\x87\x3d\xe7\x91\xc4\x93\x81\x13\xc8\x18\x4e\x10\x48\xcd\x88\xad\x36\x9e\x6e\xa5\x17\x94\x3b\x24\x01\x5e\x45\x60\xb9\x69\xc6\x4d\x63\x92\xf0\xe8\x52\x5c\xf9\x0d\x5c\x8a\x77\x30\x0c\x55\x40\x82\xf3\x57\xd2\x28\x0b\xc9\xc8\xb9\x1f\x8c\x84\x20\x83\xb1\x20\x8c\x57\xca\x6a\xa6\x33\xbc\xd7\x41\x09\xbe\xcf\x29\x92\xee\x33\xe0\xd9\x40\x8a\x79\xb1\xaf\xa4\x23\x34\x8f\x68\xa8\x2c\xc0\x78\x44\x3e\xd0\x47\xf5\x2d\x45\x15\xd2\xe6\x74\x2a\x96\x02\x29\xe6\x70\xf1\xb3\x7e\x4f\xdb\x96\x74\xe4\x81\xdf\x18\x61\x81\xce\x57\xce\xaa\xaa\xfc\x2b\x1c\x13\xf6\x53\xa3\x8a\xe7\x1f\x84\x58\xf9\xe9\x45\x90\x20\x9d\x9d\xd0\xbf\x11\x8d\xaf\xfb\xe8\x52\x40\xcc\xb3\x7e\xf5\x77\x4b\xc7\xb9\x89\x1d\xf3\xa9\x8c\xea\xf5\x9f\xf2\xe7\x99\xf8\xc1\x67\x5e\xe0\xb4\x17\xcf\xa6\x35\x6c\x72\xcb\xf9\xbe\x2c\xf6\x7a\x1b\xd8\xca\xa1\xbf\x77\xce\x5d\xd7\x90\xd3\x0f\xe6\x50\x92\xc7\xc1\x79\x01\x20\x08\xc3\xef\x39\x28\x8a\xac\xcd\x48\xfe\x1b\x73\xda\xa8\x02\xc6\xfe\x93\xc7\x2d\x34\x94\x8a\x87\xde\x9c\x40\xfc\x69\xde\xf1\x43\xdc\xe4\x0b\x23\xca\x5b\x7e\x28\xc0\xd8\x8d\x1c\x9b\xf5\x43\x66\x04\x24\x9c\x22\x01\xc5\xb2\x92\xa4\xad\x44\xd9\x8e\x41\x15\x3a\x62\x28\x54\x10\x51\xe5\xaf\xcf\x74\xf3\x44\x45\x58\xd0\x09\x3d\x22\xd0\xe1\xeb\x09\x70\xa4\xc3\xf8\x69\x18\x66\x1c\xe8\xd2\xc4\x04\x4d\xcb\x52\x00\x2b\xc3\x74\xca\x74\x8f\xca\xf1\x44\x89\x2c\x0a\x6c\x5b\xab\x89\xd1\x10\x22\x2a\xb4\xa9\xde\x10\xa1\x10\xf4\x9b\xe6\x02\xf8\x0d\x73\xeb\x42\x3a\x11\xed\xf2\x0c\x69\xa1\xcf\x02\x2f\x2b\x35\x05\xe5\xd2\xdd\xf1\xdc\xfe\x2d\x04\x00\xfd\x8d\xd1\xb3\x41\xf7\xb0\x1f\x78\x60\x0c\x16\x33\xc4\xce\x60\x50\x21\x09\xc1\x2a\x56\x13\xcb\x73\xe0\x91\xd1\x13\x55\xa7\xaf\xd7\x0f\xd3\xeb\x04\x8f\x65\xde\xa3\x2c\x50\x8c\x68\xbb\x7e\x2b\x78\xd4\x77\x86\xe9\x05\x24\x1c\x40\x6f\xab\x0c\x81\xcc\x75\x0c\xa8\x07\xfc\x85\x90\x16\x4a\x4a\x50\xb5\xb8\x89\x23\x4f\xa1\xe0\x7d\x8c\x09\xd7\x07\x17\x41\x20\x03\x99\x48\x0b\x4f\x89\xdc\x6a\x6d\xb0\x56\x4b\x9b\x6f\x88\x0b\x16\xaa\x82\xac\x16\x6f\xc4\xa3\x3e\x46\xda\x9f\x7b\xd3\x0d\x31\x92\x28\xf2\x31\x8c\x34\x70\xca\xfb\x30\x46\x32\xd4\x7e\x9e\xac\xfe\x75\xfa\x08\x49\x3a\x99\x3f\xc4\x4c\x67\xfd\xfb\xef\x61\xa6\xab\x0b\x88\x46\x60\x6c\xdd\x01\xa0\x9c\x60\x70\x22\x9e\x68\xce\xbc\x87\x45\xfe\x38\x96\x60\xc0\x90\xfe\x41\x8c\x20\x0a\x43\xac\xd3\xbd\xde\xef\x2c\x90\xf2\xf8\x40\x2c\xe8\x34\x75\x26\xfe\x40\x15\x0b\x40\xee\x43\x86\x7d\x29\xb0\xa9\x5f\xe7\x95\x1d\x90\xe5\x02\x3b\x41\xa0\xcd\x1f\xb5\xb1\x24\xae\xeb\xf0\xfc\x21\x18\x66\x97\x3c\x76\x17\xce\x6c\x29\xb6\xbc\x9a\x1d\xe7\x84\xe2\xa2\x44\x0e\xda\x54\xbb\xec\x75\x51\x22\x82\xc2\x8c\x1d\x0e\xc8\x91\xbf\xe2\x63\x24\x96\xae\x47\x78\x01\x53\xb7\xe3\x6a\x89\xf0\x50\xda\xf9\x48\xb6\xe3\xa0\x67\x11\xd0\x8b\xb0\xa8\x6b\x84\xd9\x22\x32\x09\xf2\xe1\xe3\x75\x07\xe0\x8a\xdc\xff\xf8\x8f\xb6\x82\x7b\x42\x9e\x5e\x29\x44\x50\xc9\x59\xf4\x7d\x9e\xbd\x47\x42\x24\x78\xfc\x06\xb6\x88\x98\x26\x9e\x65\x4a\x81\xac\xee\x7e\x50\x7a\x3c\x82\xca\xe2\xe1\xcd\x9e\xe5\xe2\x79\x91\xa9\xab\xe3\x81\x7f\xcb\xf6\x88\x20\x48\xd3\xfb\xa5\x54\x5d\x11\xad\xba\xfa\x2a\x92\x3e\x9c\xa6\x38\x29\x68\xbc\x06\x62\x69\x5a\xd3\x23\x97\x70\xc8\x6c\x66\xa9\x8e\x02\x73\x5a\xcc\x2c\x06\xed\xa4\xab\x30\x0f\x57\xc8\xb0\x6b\xe5\x41\xcf\x80\xce\x24\xeb\x4a\xcc\x34\x63\x4d\x01\x70\xe0\xba\x46\xa8\x1f\x94\x91\x45\xd1\x4b\xf1\xee\x7c\x89\x06\xf1\x06\x80\x3b\x1f\x64\xc2\xc7\x97\xd1\x8a\x40\x5c\x2a\x6c\xa2\x6e\xc2\x66\x3d\xe7\x7d\x1e\xa8\x93\x73\x65\xb9\x3a\x66\xc2\xc0\xf0\xe9\x6c\x4e\x34\xb1\x02\x7c\x95\xaa\xd8\x64\x9c\xf2\xc0\x0e\x31\x89\xe8\x30\x75\x3b\xba\x42\xd3\x28\xb0\x39\x9e\x8a\xe9\x3b\x25\x46\x38\xb1\xc0\x4b\xc4\xe7\x46\xc0\xf8\x2b\x34\x8d\xa1\x1c\x9c\xf3\x47\x53\x2a\xef\x56\x1b\x1a\x05\x76\x84\x09\x9f\x50\xba\xb1\xac\xb3\x48\x5e\x30\xbb\x64\xd3\xa5\x69\xab\x8c\xb4\xb2\xff\xdb\xf3\x6b\xb4\xf2\x91\x29\x9e\x9c\x59\x4f\x98\x0d\x00\xfa\xd2\x63\x41\xe8\xff\xd9\x45\x91\xb7\x63\x4b\xf3\x7c\xb6\xc4\x11\xe4\x38\x92\x16\xa3\xed\x1b\xf2\x3f\x59\x2a\x19\x57\x74\xb8\x54\x1d\xa7\xa1\x87\xbf\xba\x14\x6d\xb4\x97\x61\x85\x86\xae\xca\xee\xd2\x01\xbf\xb2\xdf\xeb\xad\x16\x76\x06\x2b\x3e\x96\x43\x20\xec\x96\xda\xe8\x65\x6f\xa9\xc3\x76\xa9\x21\xf2\x02\x6e\x2e\x44\x38\x95\x80\x07\x60\xd7\x5f\x5d\x1a\xe2\x93\x64\x17\x55\x53\x40\xcc\x47\x96\x38\xa0\xb1\x64\x12\xff\x7f\x9c\x5d\xdf\x6f\xe3\x36\xf2\x7f\xef\x5f\x61\x13\x0b\x41\xac\x19\x27\xe9\xf7\xa9\x72\x59\xe1\xdb\xbe\xb4\x87\xc3\xb5\xd7\xdb\x62\x0f\xf0\x1a\x0b\x51\xa2\x6c\x25\xb6\xac\xd8\x49\xf7\xb6\x2b\xff\xef\x07\xce\x0c\x7f\x49\x72\x6e\xef\x5e\x12\x4b\xa2\x28\xfe\x18\x92\xc3\xe1\x7c\x3e\xa3\x97\xbb\xe2\x8c\x25\x51\x3c\xd7\x51\xbd\x8c\x02\xef\x6b\xae\x88\x46\xc5\xa3\x9f\x93\xc4\xb5\x9f\x75\xe6\x97\xbe\x02\x49\xf2\xce\x87\x26\x60\x1f\x3e\xb8\x05\xed\xc3\x07\xe6\xc0\xb2\xe7\x68\xba\x1b\xdd\x72\x1d\xad\xfa\xbe\x40\xbd\x94\xb1\x2c\x24\x45\x89\xf3\x85\x99\x91\xa3\x37\x17\x55\x6b\x7a\xc4\xca\xbb\x95\x92\x46\xe4\x0a\x23\x72\xb1\xdc\x57\x24\xf7\x43\x69\x4f\xad\xb8\x9b\xd1\x80\x22\xef\xe4\x5a\x81\x3c\x5b\xb6\xba\x95\xe3\xa7\x23\xc5\xb2\x56\xf2\xf6\xfd\xe9\x76\x1b\x6b\x89\x7f\x14\xfb\x6b\xf3\x89\xf5\x2d\x5b\x81\xe5\xff\xca\x70\xaf\x06\x21\x5d\x27\x41\x4f\xa5\x8d\x51\x3c\x72\x82\x04\x5b\x60\x95\xc7\x02\x68\x45\xf4\x8f\x62\x9f\x72\x9e\x15\xc2\x92\xd3\x69\xc9\x58\xe6\xad\xeb\x34\x5c\x74\xae\x17\xe6\x41\x6c\xef\xd1\x9c\x22\xc8\x1f\x8a\x2e\xd5\x53\xbe\xb1\x84\x98\x34\xbd\x59\x2c\x18\x83\x80\x5b\xf2\x64\xbe\x8a\x1a\xa2\x43\x5a\xc3\xb6\x20\xbe\x3d\x8d\x4b\xd9\x08\xe5\xd5\x48\x15\x82\x28\xbd\x14\x6a\xc1\x60\xeb\x05\xb6\x7b\xc8\x0b\x77\x62\xda\xce\xac\xde\x2f\x2f\x2c\x8c\x9e\x28\x89\x7e\xb5\x18\xdb\x71\x31\xd2\x52\x2a\x90\x5b\x5f\x08\x9e\x97\x99\xd9\xae\x91\x71\x65\xd2\x04\x68\xa7\xc7\x1a\xe8\xcb\x89\x11\xb0\x34\xed\x56\x5a\x03\x6c\x20\x4e\xee\x9c\x0d\x8e\xe6\xbf\x4c\xcd\xa5\xc2\x84\x58\xd6\xb9\x54\xb9\xca\x2a\x95\x92\xdf\x72\x01\x01\x5b\x51\x5f\xbb\x92\x2b\x89\xad\xa5\xf8\x3d\xc3\xc4\x13\xab\x82\x5b\x49\xc6\xa9\x1b\x47\x6b\x0f\x18\xaf\x9d\xdc\x62\x58\x22\x20\x8e\xd9\xe6\xf5\xe2\x3e\xd3\x8e\x89\x1b\xcc\x86\xf5\x77\x77\x79\x93\x6d\xf3\x3a\xbb\x5b\x55\xdf\x35\x60\x05\x85\x90\x29\x7a\x5d\x6d\x44\xea\x0d\x51\x7d\x5f\x01\xbb\x6c\x92\xcc\x4b\x47\xf5\x92\x24\xe9\xbc\x0c\x35\x4f\xfb\xa0\xef\xe7\x3f\xa4\xe1\x13\xc1\x2c\x5b\x31\xc4\xab\x01\x8f\xf7\x53\x5a\xd2\x70\x10\x5b\x27\x1e\x2b\xa2\x56\x51\x0e\x00\xb2\x1b\x07\x2c\x0b\x50\x65\x51\xc3\x84\x71\x4b\x20\x8a\x88\x8e\x1d\x0d\xb6\x37\x37\xbc\x92\xda\xec\x61\xd3\xca\xab\xf7\xde\x8b\xd1\xcb\x21\xe5\x8a\x74\xe3\x1c\xe3\xcb\x73\x38\x2f\x03\x1a\x2b\x72\xb1\x01\xf0\x6b\xd4\x19\xf2\xe6\x9e\x8b\xfa\x72\x89\xb4\x6e\xb2\xca\x79\x33\xe0\x40\x1b\x8e\x86\xe1\x66\x6c\x44\x80\x06\x1b\x59\x7e\x9d\x6a\x6b\x91\x61\x41\x3d\xd2\xc2\x36\x2d\x4c\xa0\x97\x8b\x37\x3c\xc2\x26\x27\xfe\x22\xf8\xb0\x5c\x9d\x4c\x46\x70\x42\x12\xec\x9c\x1d\x5b\x96\x59\xbb\x22\xa7\x19\x79\x4b\x3b\x78\xa2\x3d\x81\x7f\xbd\x65\x28\x01\x4e\x13\x08\xfd\x49\x63\x8b\x40\x0c\x62\xcc\x58\x62\x04\x3f\x24\x0f\x25\x04\x1a\x50\xdb\xc9\xb5\xee\xfb\x6a\x23\x3a\xb9\xc7\x39\x56\x09\x42\x20\xe6\xc8\xcf\x95\x29\xf1\x14\x3c\x73\x30\x5b\x48\xe0\x69\xa7\x3c\x2f\x5d\xb6\x06\x5a\xae\x9d\x6c\xa4\x96\x26\x73\x61\x36\xe7\x7a\x70\x24\x1c\x5e\xcf\xb7\xb4\x1b\xed\x16\x23\xda\x48\x23\x28\x9d\x57\xcd\x96\xa0\x94\x25\x49\xfa\x24\xbb\xe0\x9b\xa2\x93\x4f\x2e\x6a\xcd\x13\x31\xe3\x71\xf1\x28\x83\x57\x33\x54\xb5\x4d\x4b\x2f\x3a\xa1\xa4\x0a\x29\x6e\x54\x44\x6b\xd1\x89\x31\xb7\x6a\x92\x00\xd1\x54\x73\x7e\x8b\x45\x93\x75\xfe\x4d\xf6\x7f\x22\x68\x03\xf9\xe4\x69\x16\x45\xc8\xc9\x25\x83\x44\xf9\x24\xb9\xde\xd3\x7f\x24\xd7\x43\x73\x90\xe3\xd5\xa2\x00\xd1\x8a\xc8\x43\x00\xa3\x48\x24\x24\x70\x52\x69\xe7\xe0\xb5\xda\x64\x51\x08\x22\x01\x86\x8d\x76\x44\xc6\xd6\xa1\x6b\x75\xdd\xf7\xf3\xd6\xb6\x7e\xdf\xbb\x9f\xe4\x93\xa5\x45\x49\x7c\x69\x68\x81\xac\x93\x64\xde\x2e\x2d\xfd\x0f\x45\x92\x7b\xd7\xb4\xd5\xf1\x63\xaa\xe9\x40\xe5\x41\xb6\x11\x01\x61\xdf\x77\xc2\x76\xf8\xc3\xa2\x33\xcb\xdc\x4e\xee\x82\x09\x8e\xaf\x76\xab\xc1\x9d\x23\xce\x64\xe0\xc7\xb9\x5b\x35\x52\xca\x54\xc7\x3e\x75\x7d\x0f\x00\x32\x4c\xd7\x84\xfe\xb7\x7d\xdf\x50\x56\x58\xb0\xbe\x2f\xf8\xc5\x6f\x03\xd2\x9d\x3c\xe2\x3e\xe0\x35\x2e\x37\x1c\x0b\x72\xfb\xfd\x7d\xfe\x90\xb5\x8e\x41\xd1\xd4\xe5\x60\x19\xf0\x76\x57\xe8\xef\x2c\x59\xe4\xce\x72\xf2\x31\x2e\x0e\x49\x72\x70\x21\xd8\x4b\x2e\x0e\xf2\x31\x49\x76\xeb\xc7\x4d\xf0\x24\x49\x7e\x4f\x77\x3c\x64\x53\x8b\x5e\x71\x77\x11\x52\x3e\xe6\x57\xf3\x26\x21\x2c\x7c\x67\x7a\x57\x4d\x10\x9e\xa4\xdc\xf4\xb4\xa5\x21\x4a\x12\xff\x9b\xbe\x77\x5c\x76\xc7\x2e\xe5\xb6\xf3\xfb\x7e\xfe\x7b\xaa\x79\xdf\x3f\x0e\x42\xeb\xeb\x75\x07\xcd\x18\x49\x01\x90\x63\x6a\x53\xb5\x06\x02\x41\x3c\x6e\x30\xda\xa9\x18\x8d\x75\xd9\x09\x93\x43\x3a\xf5\xc8\x46\x44\xb7\x59\x34\x3c\xa2\x7d\x3b\x13\x37\xc9\xb4\xc1\xd0\x63\x8b\x83\x51\x2e\x4a\x81\xd6\xf5\x01\x95\xcc\xdd\x85\xaf\x06\xdf\x4f\x2b\x8a\x57\x36\x8e\x0a\x35\x9a\x6c\x87\x3c\x46\x57\xf1\xfd\x36\x6f\x53\x50\x87\x9d\xa1\x9b\x3f\x11\x1b\xec\xe4\xf6\x90\xb4\xee\xc0\x45\x60\x36\x95\x67\x09\xc8\x31\xbf\x76\x32\xb3\x62\xcc\x60\xf9\x98\xd1\x8a\x32\xb3\x8b\xc9\xec\xa4\xcf\xcd\x9f\x7a\x76\x86\x58\xbd\x33\x60\xa7\x9a\x55\x6a\x8f\x3f\x80\x75\xa3\x3a\x7e\x6c\xf1\xd7\x4b\x87\xff\xcd\x26\x6c\xe6\x88\x3a\x66\x96\x9b\x63\xe6\x79\x3c\x66\x9e\xbb\x63\x86\x8c\x2e\x33\x0a\x2d\x71\x7e\x51\x87\xe6\x79\xf6\xa8\x3f\x41\xbe\x8f\xfa\x53\x77\xd2\xe7\xb3\xf9\xf1\xd2\xcd\x02\x5a\x6e\x16\x1c\x30\x4f\x61\x0c\x62\x03\xbc\x3f\x21\x18\x59\xac\xef\x70\xbf\x0b\xcb\x21\x01\x3c\x4a\xcb\x96\x44\xad\x36\xd1\xbd\x3b\x53\xb5\x57\x3a\xd7\xd7\xd5\xa8\x09\xbe\xba\xa9\x82\x69\x06\xac\xa2\xd4\xd8\x92\x1d\x5b\x4b\x60\xd6\xb4\xb3\xc2\x3f\x31\x4a\x3b\x9e\xe5\x23\x77\x9a\xe3\x39\x43\x9a\xb3\x90\x94\x6c\x4a\x20\x22\x6d\xc3\x4e\xeb\x24\xcf\xa9\x12\x8e\x60\x2a\xe6\xf8\xe4\x97\xd5\x88\x90\x13\xf5\xa5\x97\x6e\x88\x6d\x21\xd0\xd0\x60\xc2\x25\xd6\x13\x87\x67\xae\x84\xe2\x2b\xb3\xe0\x8f\xd9\x7f\x0b\x94\x46\x11\xa6\x15\xa9\xee\xfb\x3b\xbe\xb8\x07\xe0\x22\xd2\xa8\xfe\xef\x1f\xbe\xb9\x5f\xe9\x3c\xca\x5e\x43\x74\xe9\x29\x06\x32\x5f\x1a\x02\x7c\x9a\x0c\x60\xa7\x02\x6a\xd7\xce\x6c\x84\xd7\xef\x37\x6f\x6e\x45\x03\x7b\xe2\xfc\x7d\x7b\xbb\x15\x0f\xa4\x8d\xa1\xe8\xd2\x49\x4b\xdf\x1c\x8a\xad\xee\x4f\xfa\xac\x9f\xfb\xba\xd9\x6b\x0c\xc9\xf2\xea\x19\xcd\xa3\xfe\xb4\xd5\x2d\xbf\x6d\xbc\x5d\x76\xaf\x06\x7e\x53\x93\xfe\x09\x24\x25\x2a\x40\x13\x09\xcd\x3f\x97\x7d\xbf\x53\x2e\x40\x6a\x95\x16\xa6\xea\x26\xc7\x05\x5b\xb3\xc5\x98\xe5\xc4\x1d\x47\xe8\x5c\x65\xcc\x68\x19\x1b\x26\x34\x7c\x7c\xf5\xd5\xc5\x87\x56\x28\xfb\xde\xbe\x3b\x77\xd1\x2f\x14\xe7\x15\x08\x9f\x0f\x53\xac\x91\x49\xde\x7d\x51\x43\x86\x6a\xad\x37\x48\x16\x70\x5a\x02\xfb\x88\x9c\x3e\x37\x04\x24\xd0\x94\x58\x0f\xd9\x18\x55\xca\x33\xb5\xaa\xd6\x15\x8d\xe8\x8d\xd4\x6d\x79\xac\xf4\xef\xbf\xfd\xfc\xe3\xf1\xd0\x1d\x5b\x24\x27\x5c\x30\xc9\x16\x13\x4f\xe2\x5d\xe9\xb8\x7d\xc1\xad\x79\xf9\x00\x51\x52\x68\x09\xfb\x75\x5f\x34\x2d\xb1\xeb\x16\xae\xf9\x23\x37\x29\x84\xe7\x61\x44\x50\xbf\x59\xe7\x97\xa0\x79\x4a\x64\x93\xd9\xab\xb4\x14\x10\x0a\x45\x05\x7e\xb3\x15\x29\x7e\x09\x03\x1e\xb5\x30\x84\x91\x3e\x35\xc5\xbe\xf9\x73\x0a\x22\x41\x2d\x6a\x23\x27\x53\x42\xac\x07\x07\x47\xad\xf0\xd6\x44\x06\x53\xb0\x43\x74\xe3\xa0\x53\x7a\x34\x5c\x69\x8a\x3a\xe3\x77\xde\x45\x1e\x6a\x93\x64\x68\xbc\x70\xcb\x1a\x30\xca\xce\xd9\x48\x22\x40\x96\x69\x2e\xd3\xc6\x64\xc7\x69\xce\x29\xcb\xec\x7e\x97\xf1\x24\x79\x24\x71\x8e\x4c\x29\x46\xb1\x78\x50\x1e\x66\x88\x4f\x4b\x8b\xa8\x9f\x3b\x9a\x0f\xce\x2f\x3c\xae\x5c\x28\x55\xa1\xed\x28\xe6\xc6\x42\x57\x81\x6c\x14\xd1\x13\x4d\x44\xe5\x84\x89\xe8\xb3\xa9\x48\x86\xda\x3d\xf1\x6a\x7a\x7a\x81\x46\x09\xf6\xfe\xf4\xbe\x65\x66\x19\xc8\x26\x92\x96\xd3\x49\x11\xc1\x36\x5a\x89\x3e\x9e\x8a\xee\xff\xf7\x13\xc6\xb8\xb0\x65\x21\x92\xe3\xd0\xb4\x9f\x24\x69\x21\x03\x0b\xda\xfa\x6e\x83\xa6\x2c\x42\xd1\x8e\xb8\x3b\xf8\x52\x3f\xa5\x36\xb0\x07\xc0\xed\x6c\xb2\xf8\x9c\x2c\x42\xe4\xda\x9c\x85\x9a\x16\x2c\x20\x5d\x42\x95\xbb\x40\x88\x32\x79\xe1\x20\x52\xd9\x94\x70\x74\xd7\x13\x3c\x38\x7c\x26\x21\x20\xd1\x92\x6e\xda\xe4\xe7\xb6\x9d\x26\x7a\xf8\x02\x73\x77\x70\xb2\xe1\xb2\x8a\xcf\x35\xf8\xeb\xf6\x6d\x67\xc2\x2e\xa5\x5a\xd2\x39\xdd\x39\xe5\x2b\x1b\x9e\x35\x2f\x97\xd4\x6f\x66\xac\xa8\x00\x64\xca\xb1\xf4\x93\xa6\xaf\xa8\xdc\xab\xeb\xba\x64\x19\x17\x1f\x62\x3a\xc5\xa6\x52\x88\x90\xcd\x2f\xe2\xa5\x1d\x7d\x2c\x02\x48\x42\xb7\x1a\x0d\xa6\x3d\x3e\xa7\x0c\x98\x92\xc6\xb0\x7f\x7f\x08\xe4\x70\x9f\x76\x0c\xba\x08\x41\x17\xea\x1a\x32\xfa\x75\xa7\x65\x77\xd6\x2f\xd5\x11\xa2\xa1\x55\xba\x9d\x30\x8d\xcc\x07\x09\x01\xa1\x63\xa4\x04\x66\xc4\xa9\x47\x53\x99\xcc\xd3\x62\x89\xb8\x01\x70\x79\x34\x73\x39\x5e\xa2\x47\xfc\x2b\x8e\xb2\x1c\x0c\x3a\xe0\x17\xf6\xd3\x5b\x70\x10\x80\x41\x20\x27\x3c\xdc\x9a\x43\x87\xd2\x09\xbb\xc3\x89\x97\x52\xc6\x38\x84\x10\xf4\x4e\x36\x61\xec\x9b\xfa\x78\x3a\x7c\xff\xdd\x2d\xfe\x0b\x2f\x98\xf8\x06\x8c\x43\x23\x5e\x9c\x0b\x6c\x86\xe0\x44\x1f\xf2\x18\x6e\x6f\x82\x93\x15\x1f\xfd\x8b\x76\x05\xeb\xcd\x6a\xf2\xec\x88\x38\xc9\xe6\xf7\x9e\x94\x68\x1b\x62\x7e\xa6\x5a\x23\x4f\xd5\x97\xd6\x1f\x3c\x27\x07\x7e\x76\xaa\x38\x9b\x5d\xaf\x06\x07\x03\x59\x2d\xf7\xc7\x12\xb3\x30\xd7\x42\x2d\x77\xba\xa8\x22\x47\x40\xcd\x79\xa6\x24\xd0\x07\xfd\x68\x81\xe4\x62\x2b\xe7\x65\x92\xac\x37\xa2\xce\xd7\xc3\x4f\xd4\xeb\xfb\x0d\x07\xa7\xfd\xa7\x02\x02\x96\x29\xb1\x05\x5f\xf7\xad\xf3\x87\x3e\xa5\x5b\x7b\x82\x09\xad\x4a\xc1\xcd\x36\xa2\x0e\x25\x18\xa4\x0e\x45\x07\xb4\xe1\x5f\xe0\xe7\xeb\xac\x30\x68\x50\x0b\x40\x3c\x04\x3c\x63\x5c\xec\x11\xe5\x7c\x90\x9f\x21\xcc\x6c\xf1\xdc\x94\x4c\x4a\xb3\xdb\xb7\xfe\xcb\x4b\x9b\x5a\x32\x8b\xd4\x65\x5c\xec\xe4\x9e\x4a\x41\x81\x9d\x29\xeb\xe7\x63\xc7\x88\x75\x06\x6f\xec\xc1\xfb\x58\x3c\xc8\xd4\xa3\xdc\xcc\x07\xfa\x9e\xd5\xcd\xbf\xc0\x1f\x4f\x3e\x02\x09\xd8\xa2\xf1\x67\x41\x08\xba\xe1\xdf\xdf\xdc\x8b\x87\x3c\xad\xe4\xde\x95\x22\x05\x17\x6e\x00\xe8\x6a\xd3\x53\xba\x7e\xe6\x59\xba\x1d\x43\x6d\x74\x78\xab\x31\xb7\x4c\xa3\x46\x3a\x1b\x44\xd1\x55\x36\x36\x42\x29\x62\x8a\x2f\x6e\xa1\x11\x00\x07\x4e\x92\xf4\x60\xfe\xe3\xd5\xcd\xce\xfc\x5d\x6c\x7d\x12\x53\x10\x48\x63\x7e\xd0\xf5\xcd\x0e\xfe\x2d\x34\x17\xcc\x6c\x98\xb6\x70\xa0\x91\xab\x25\x5c\xd8\xef\x1e\x78\xb6\x87\xd6\x3a\x20\x09\x69\xc8\x8e\x38\xec\x5c\xfe\xda\x59\x56\x40\x96\xf2\x7c\x25\x28\x33\x6c\x4b\x31\xd7\xa5\x13\x9e\x90\xc2\x61\x15\x1d\xc8\x87\x9b\x79\xe7\xb0\x54\x5f\x9b\xa9\x4c\x47\xd5\xd7\xdc\xc3\x85\x92\xc3\xf0\x67\x66\x51\x1a\x46\xc4\xd2\x52\x85\xc6\x31\xf1\xf9\xf9\xd8\x65\xd0\xdd\x0b\xbd\x04\xb2\x54\x2c\xf3\x4d\xb9\x44\x56\xd3\xb7\xc7\x4e\x00\x66\xb8\xa2\xb6\x86\x54\xff\x1c\xa4\xfa\x2b\xf8\xa6\x67\x90\xdb\x1d\xa6\xbf\xbb\x5c\x44\x37\x81\x08\x26\x86\x6e\xa3\x2b\x7c\x26\x6a\x0e\xe1\xac\x1a\xa2\x92\x71\x1e\xf6\x10\xdc\xc9\x32\x0a\x7e\x19\x0e\xb2\x5c\x21\x20\x77\xaa\x59\xb2\xd4\x92\xdf\x42\x81\x7f\xc5\x95\xce\xb4\x56\x70\x37\xe5\xe2\x87\xb4\x30\x9f\x07\x27\x27\x38\x7a\xab\x64\xe1\x9e\x72\x5b\x2c\x6c\x28\x1a\x7b\x90\x1e\x1d\xed\xdf\x1e\x3b\x07\xbc\xe3\x51\x73\x8d\xd3\x9a\xa6\xf2\x89\x2f\x1c\xbb\x00\xa5\x1e\xf2\xbf\x71\x55\x44\xd7\x7e\x88\xcf\xec\xf2\x25\xc9\xc7\xec\x87\x49\x01\x8a\x80\x76\x9f\x8b\x08\x6b\xfc\x5f\xed\x01\x46\xed\x65\xf5\x36\xf0\x3b\xb0\x53\xd8\xc4\x6c\x07\x5a\x5c\xf4\xa2\x5d\x06\xfb\xfe\x54\x5c\x22\x66\x0a\x34\x35\x01\x0e\x82\x05\x12\xc5\x04\x3e\x78\x7b\xec\xf0\x3e\xc9\xe3\x15\xdb\x47\x94\x44\x4a\xa9\x56\x23\xe6\x89\xea\x15\x6c\x80\x07\x97\xae\x02\xad\x91\x4c\x97\x05\xcf\x6b\x59\x64\xdf\x0e\xe9\x61\xea\x80\x00\xb0\xd1\x1f\x43\xb8\x51\x5e\xe7\xf5\x5a\x6d\xb2\x62\x5d\x6d\xc0\x19\x39\xad\xf3\x7a\x69\x6b\x94\x96\x79\x1d\x8e\x9e\x4c\x8b\x32\xd7\x59\x1d\x8e\x3b\x9e\x61\x48\x60\x40\x07\x54\x13\x1c\x24\x91\x0b\x1a\x44\xee\xde\x63\xa0\xee\xd7\x30\x46\x80\x75\x89\x30\xfa\x22\xb6\x9a\x85\xc6\x44\x02\x37\x2a\x2e\x2c\xaa\xd3\x6c\x82\x8c\x76\xe8\xd7\x88\xb5\xda\x00\x0e\x25\x2b\x2f\x3c\xe8\x54\x82\x1f\x3a\x00\x14\xc8\xb9\x05\xe4\x4e\xd0\xef\xc2\x4b\x0e\xb6\x02\xaa\x12\x5b\x14\x82\x34\xe9\x4c\x09\xc6\x32\x76\x7c\x79\x86\xdb\x97\x01\x84\x01\x7a\xba\x0a\x7a\xda\x9f\x71\xc9\x61\xbb\x19\xad\xa7\xef\x03\x57\x10\x6b\x0b\x31\x2b\x6d\x09\x7e\xe7\x08\xee\xaa\xe1\x7f\x6e\xc1\xbc\x99\x45\x90\x3a\x3d\x7c\x28\x44\x70\xb8\x76\x5d\x88\x14\xcf\xef\x20\x64\xb2\x5b\x79\xb1\x3a\x3c\x57\x6b\x57\xe1\x4d\xe6\x27\xea\xe1\x8c\xbd\x66\x38\xc3\x42\xb2\x6f\x23\xe6\x9b\x3c\xad\x27\x66\x78\x87\xb4\x56\xa0\x89\xae\x19\x8a\x9f\x79\x5f\xd4\xd1\x95\x4d\x60\xf1\xb6\x98\x20\xbe\xf2\x1f\xe7\x36\x2a\x1b\x08\xba\x0d\x80\x5d\x8a\x1d\xcf\x2c\xc4\x05\x0f\x1a\x8d\x46\xad\xc4\x36\xd7\x94\x1e\x60\x58\x23\x04\x8f\x6a\xda\x61\xa4\xbc\x78\x6a\x82\xbb\x68\x76\x87\x88\x01\x2f\xed\xe8\x95\xc1\x0b\x75\xed\xde\xe0\x3e\xb0\xc3\x75\x42\x63\x6f\x10\x2e\x88\xff\xf8\xa5\xbd\xf2\x96\x7b\x07\xdc\xd9\x07\xc2\x95\x07\x9f\x67\x5f\x7f\xcd\x68\xc7\x68\x6e\x28\x51\xf4\xbd\xb9\x27\x4a\x1a\xba\xbb\xe3\xbe\xfa\x4d\x17\xd5\xa7\x68\x17\x53\x80\x2f\x7b\x51\x7d\x7a\x57\x34\xcf\x8b\x45\x46\x57\x29\x92\xbf\x59\x6b\x84\x8c\x6c\x13\x76\x63\xf0\x97\x7f\xfc\xf2\x37\x69\xfe\xe0\xa5\x38\x39\x93\x89\xfc\x41\x78\x5a\x62\xb7\x05\x40\xa0\x46\x92\xe0\xff\x65\x71\xa8\xec\xef\x94\xa1\xfd\x8b\x89\xf5\x66\x02\x9a\x76\x22\xf5\xe5\xa0\x64\xb1\x7c\xf8\xbb\x49\x29\x5a\xf3\xfb\x8d\x17\xfc\xf6\xf8\xe3\xb1\xad\xf7\x4d\xf9\x3c\x11\x2b\x70\x56\x2c\xdf\x98\x95\x03\x94\xdf\x37\xb2\x55\xe0\x82\x65\xf3\x72\x4f\xe8\xf2\xa0\xb8\x38\x5d\x84\x02\xcf\x00\xba\x67\x5e\x3b\x99\xdb\x7c\xf5\xd5\xbf\x03\x00\x00\xff\xff\x46\x1c\xa1\x7e\xdd\x0f\x01\x00")
-
-func resJquery321SlimMinJsBytes() ([]byte, error) {
-	return bindataRead(
-		_resJquery321SlimMinJs,
-		"res/jquery-3.2.1.slim.min.js",
-	)
-}
-
-func resJquery321SlimMinJs() (*asset, error) {
-	bytes, err := resJquery321SlimMinJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "res/jquery-3.2.1.slim.min.js", size: 69597, mode: os.FileMode(420), modTime: time.Unix(1510060966, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resPopperMinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x7c\x6b\x97\xdb\xb8\x91\xe8\xf7\xfc\x0a\x6a\x37\x4b\x00\x16\xc4\x96\x26\x77\xee\xde\x4b\x19\xa3\xe3\xf1\x23\xe3\x8d\xed\x9e\xb5\xdb\xf1\x24\x34\xcf\x98\x2d\x42\x12\xc6\x14\xc1\x00\x50\x3f\x22\xf1\xbf\xef\xc1\x8b\x04\x25\x75\x7b\x36\x1f\xdc\x22\x81\x02\x50\x55\xa8\x37\x40\x5f\x3c\xf9\x43\xf4\x9c\x37\xf7\x82\xad\x37\x2a\x82\xcf\x51\xf4\x8a\x96\x54\xb0\x25\x8f\xfe\xce\x6e\x78\xc5\xa3\xef\xa6\xb3\xff\xfc\x43\xf4\x82\x49\x25\xd8\xf5\x4e\xd1\x32\xda\xd5\x25\x15\x91\xda\xd0\xe8\xed\xeb\xab\xe8\x0d\x5b\xd2\x5a\xd2\x08\x56\xee\x41\x51\xb1\x95\x51\x21\x68\x54\xa8\x68\xa3\x54\x93\x5e\x5c\xf0\x86\xd6\x92\xef\xc4\x92\x26\x5c\xac\x2f\x1c\xa8\xbc\x78\xfb\xfa\x0a\x25\x7f\x88\x9e\x5c\xc0\xd5\xae\x5e\x2a\xc6\x6b\x48\xb1\x42\x7b\xc0\xaf\x7f\xa3\x4b\x05\x08\x51\xf7\x0d\xe5\xab\x88\xde\x35\x5c\x28\x19\xc7\x40\xaf\xbe\x62\x35\x2d\xc1\xc8\x77\x6e\x79\xb9\xab\xe8\xc2\xfe\x24\x0e\x94\x28\x88\x52\xe0\xa7\xed\x67\xb2\xa3\xe3\xd8\xfe\x26\xc5\xb6\x5c\xd8\x47\xa8\x50\x4a\x93\x9f\x79\xd3\x50\xa1\x07\xb7\x08\xaa\x0d\x93\xb8\xc3\x0c\xed\xc1\x4e\xd2\x48\x33\x62\xa9\xc0\xdc\xb7\x47\x14\x52\xb4\x17\x54\xed\x44\x1d\xd1\x38\x06\x99\x45\x3e\x7a\xe5\x00\x72\x40\x08\xd9\xb7\x89\xe2\x1f\x94\x60\xf5\x3a\x59\x16\x55\x05\x29\x6a\xbb\x19\x94\xa5\x9a\xad\xe0\x6c\x44\x08\x4d\x6a\x5e\xd2\xab\xfb\x86\x22\x3b\x6b\x96\xcf\x6f\x0a\x11\x71\x72\xcb\xea\x92\xdf\x26\x6b\xaa\x9e\xf3\x6d\xa3\x37\xe3\x83\xba\xaf\x28\xa4\xb8\xde\x55\x15\x9a\x3b\x24\xd4\x82\x67\x2a\x4f\x79\xbf\x00\xef\x51\x04\x3f\x5d\xbd\x7d\xa3\x31\xb2\xcb\xbc\x2b\xb6\x74\x41\x53\x9a\x34\x85\xa0\xb5\x7a\xc7\x4b\x7a\x38\xd0\x64\xc3\xa5\xea\x87\xd7\x7a\x38\x5b\xc1\x11\x3d\x1c\x26\x1a\xc5\xcc\xce\x82\xc1\x8f\x97\x2f\xfe\x06\x30\xf8\xf7\x92\x2f\x77\x5b\x5a\x2b\x90\x27\xac\x2e\xe9\xdd\xe5\x0a\xf6\xf3\x23\x47\x47\xe4\xf0\xf7\xc0\xc9\x35\x2f\xef\x0d\x69\x8c\x28\x48\x11\x16\x84\x25\xfc\x86\x8a\x55\xc5\x6f\x71\x13\xbc\xfc\x82\x65\xf0\xf6\x37\x4f\xe8\x05\x2c\x76\x8a\x1f\xe4\x52\xf0\xaa\x42\x17\x89\xa2\x52\x41\x31\x96\xe3\x06\x2d\x68\x5a\x43\x4d\x76\xc0\x66\xa1\xc9\xb0\x9c\xa4\x71\x4c\x13\xbe\x5a\x49\xaa\x7e\x36\x84\x63\x46\x78\x1c\xf3\x0e\x67\xbf\x04\x8b\x63\x4b\xe4\x88\x10\xfd\x6c\xe8\xd6\xcf\x0b\xc7\x88\xab\x17\x00\x83\xab\x67\x3f\xbe\x79\x19\xd0\xde\xcf\x83\xe2\x18\x48\x55\x28\xb6\xd4\x3c\x57\x90\x63\xd0\x70\xc9\x8c\x4c\xa2\x85\x80\x1c\xa5\x3c\x3d\xe6\x8b\x7f\x78\x59\x51\xfd\xd3\x53\xd0\x78\x0a\x54\xb0\x7d\x0e\xd5\x0e\x4d\x15\xc7\xb0\xdb\x65\x75\x38\x08\x48\x93\x15\x13\xd2\x4f\xf7\x7c\xc3\xaa\x12\x69\x09\x08\x78\x23\x03\x21\xd6\xc2\x64\x04\xa4\x97\x89\x05\x4d\x25\x0c\x1b\x82\xa1\x65\x27\xbd\x5a\x3e\x46\xbd\xf8\x1e\x0e\x23\xa5\xff\x1d\xcb\xf3\x89\x1c\x1c\xd1\xeb\xa4\x9d\x26\x4b\xbe\xd5\x2b\xbe\x70\xdd\x3f\x3b\xc6\x41\x85\x62\x8d\x43\xf2\xe2\xf2\xf9\xc7\xb7\x2f\xdf\x5d\xfd\xfa\xf3\xe5\x87\xd7\x57\xaf\x2f\xdf\xfd\xfa\xea\xf2\xcd\x9b\xcb\x4f\xaf\xdf\xfd\x59\xef\xe7\x82\xa6\x0a\xd7\x84\x2f\x54\x4a\x71\x41\xba\xe5\x96\x82\x16\x8a\xbe\x2f\xea\x35\x85\x68\x5e\x24\x92\xaa\x0f\xaa\x10\x0a\x32\x3c\x45\xd8\xbc\xbf\xac\x4b\x58\xe3\x29\x32\xa8\x54\xa4\xd0\xa8\x6c\x79\xfd\xac\x5e\x52\xa9\xb8\x78\xce\x6b\x55\xb0\x9a\x8a\x39\x5b\x41\x3a\x22\xa4\x8a\x63\xa5\x7f\x0e\x07\x96\x2c\x6d\xa7\x84\x75\x27\xf8\x0d\xac\xd0\xa2\x4a\x05\xac\xec\x8c\x2b\xa2\xf9\xed\x65\x6c\x65\xd4\x6d\x51\x42\xfb\x80\x15\x4a\x35\x4f\x25\x54\xc8\x34\x04\xbc\x2e\x7a\x01\x98\x3d\x2d\xc4\xda\x10\x24\x93\x8a\xd6\x6b\xb5\x89\xe3\x1b\xce\xca\x68\x3a\x22\xa4\xeb\xca\x66\xf9\x22\x7c\x49\x81\xe2\x0d\xc0\x9c\x98\x5f\x2d\x20\x0b\x60\xf5\xe7\x8a\x37\x20\x75\xcf\x6f\xe8\x4a\x01\xcc\x42\x21\x63\x2b\x68\x25\x8c\x10\xc2\x0e\x87\x4e\xc0\x98\xc5\xa7\x26\xdf\xd8\x54\x2c\x4e\x20\xec\x5a\xac\x5e\x3b\x90\xc3\xa1\xf6\x2c\x11\x19\xcf\x5b\x6f\x53\xf5\x73\xc7\x81\xca\x4a\x9b\x15\x91\xef\x7e\x1f\x0f\xbe\xcb\xe3\x38\x7c\xc3\x8c\x14\x50\x61\xc3\x02\x84\x6b\xfb\x52\x69\x9a\xb5\x0d\xe2\x8b\xc9\x2c\x9d\x79\x4c\x68\xa2\x78\x33\x26\xec\x89\xc0\x34\xb9\xe6\x4a\xf1\xad\x7f\xd3\x23\xc6\xa4\x36\xcf\xc6\x81\xba\x97\x1e\xd9\x55\x88\x2c\xb8\x73\xfc\x36\xdc\x4d\x81\x66\x38\x66\xc4\xbe\x12\xc2\x17\xe0\xbd\x9e\x04\xa4\xe0\x47\xb3\x0c\x70\x28\x8c\x69\x06\xae\xb9\x28\xa9\x00\x63\x3e\x06\x9f\x58\xa9\x36\x20\x4f\x64\x53\x31\x05\x41\x73\x07\x50\x36\xcd\xc7\x51\x08\xc6\x1e\x02\xeb\x51\xdb\x6a\xd4\x30\xc7\xac\x53\xfb\x5f\xa0\xca\x80\xb5\x89\x60\x4c\x73\xac\x32\x27\x0d\xe6\x8d\x67\x60\x59\x31\x6d\xe2\xdd\x5b\x00\xc9\x07\x90\x35\x85\x68\x31\x00\x18\xb3\x0c\x6c\x0b\xb1\x66\x35\x18\x43\xf0\x13\x35\x64\x6a\x0b\xb3\x00\x56\xea\x0c\x0b\xd0\x63\x70\x8e\x25\xa9\xe3\x11\xca\xd3\x69\xa0\x16\x4b\x68\x99\x4c\x4f\x64\x4c\xbb\x18\xac\xbe\x29\x9c\x9c\x68\xac\xe3\xf8\x21\x0f\xab\xbc\xb2\xee\x37\x06\xab\x74\xdb\xe1\x87\x0d\x1f\x11\xbe\xd5\x0c\xd7\xed\x96\xf3\xae\xb9\xed\x91\xdc\x04\x26\xb6\xa4\x70\xdf\x62\x8a\xf7\x46\x6e\x52\x27\x4b\x34\x31\x93\x60\x2b\x66\xa9\x15\x3d\x9a\xd8\x25\xdb\x80\xde\x75\xef\xc9\xf6\xad\x56\x4d\x8d\x3d\x52\xe2\x7e\xaf\xcd\xe6\x9a\xaa\x1f\xf9\xae\x2e\x59\xbd\x7e\x6e\xb6\xec\x3d\x5d\x2a\x88\x9c\xa7\x2d\x20\x0d\x25\x9f\x7a\xc9\x9f\x73\x27\xe9\x98\x7b\xc9\xc6\xbc\x97\x78\xcc\x3b\x19\x6f\x97\x85\x5a\x1a\x6a\x5a\x5a\x49\x1a\x7d\x6b\x4d\x41\xf6\x7a\xc2\xd4\xce\x8b\x15\x6f\x52\xb3\x96\x63\x99\x9b\x78\xe2\xba\x1d\x83\xfd\xd2\x13\x03\xda\xe2\x86\x9c\x8b\x5c\x96\x10\xa5\xfb\x16\x4b\xd2\x58\xd6\xe9\xd8\xc5\x8a\xe9\x27\xfb\x2a\xdc\xe4\xc2\x4e\x5e\x92\xc6\xb1\xb3\x87\xfc\xc9\xbd\x0b\xbf\xa2\x30\xc8\x55\xc4\x47\x08\x66\xaa\x89\xc4\xdb\xae\xc5\x0e\x99\x94\x9a\xf5\xd5\xe1\xb0\xb5\x9b\xb1\x36\x51\xcc\xbc\x9a\x90\x15\x5c\x63\x70\x07\x10\xde\xba\xe7\x7b\x6d\x59\x2c\x8a\x13\x52\x61\xe1\x90\x98\x90\xad\xb7\x72\x1b\x28\x82\x0d\xde\x41\x8a\xb9\x9d\x95\x19\xd1\xc4\xa2\xa7\xbf\x8f\x2e\x70\x43\xb4\x28\x60\x49\xd6\x90\x23\x5c\x12\x1d\xa9\xe1\x42\xc7\x19\x08\xaf\xc8\xb8\x48\xac\x45\xb8\xe2\x8d\x21\xe2\xc8\x1a\xe0\x6d\x0f\xa2\x55\xf0\x2c\xcc\x92\x6c\xe0\x5e\xef\x59\xa3\xd9\x32\x91\xe6\xef\x0a\x9b\x1d\x6d\x0c\x57\x27\xd2\xfe\x6c\xdd\x86\xba\xbd\xf0\x3b\xd9\x74\x02\xac\xd9\xb5\x4c\xac\x86\x5f\xf1\x86\x4c\xb1\x7f\xd3\xab\x93\x29\x1e\xb1\x38\x16\x96\xec\x9d\x46\xad\x03\x3d\x46\xea\xba\xef\xd5\x43\x8f\xba\xe7\x4b\x83\x24\x59\x4d\x76\x78\xe9\x77\xd5\xbd\x19\x4c\xc9\x76\x72\x8d\x97\x4e\x34\xdc\x4b\x8f\xd6\x6e\x88\xd6\xb5\xdb\x22\xc8\x16\xbc\x77\xee\x25\x4a\x39\x21\xa4\x0c\xe2\xc3\x32\x8c\xfa\xe0\x92\x54\x70\x89\x39\x42\x78\xd9\xef\xeb\x75\xef\xbf\xbf\x6d\x92\x76\xc6\x7b\x60\x46\x7e\x81\x2a\x94\x6a\xec\x86\xb2\xba\xa6\xc2\xc9\xf9\x54\x6b\x73\x0f\x67\x05\x74\x00\xe8\xc5\x7c\xaa\x65\xa9\x80\x0a\xe1\x66\xe0\xf8\x24\x31\xbb\x2c\xac\xc2\x8d\x79\xcf\x10\xb7\xd7\x4e\x41\xbb\x1e\xcd\x1c\xb7\xe3\xcc\xef\x75\xdd\xce\x3b\x79\x96\x81\x3c\xdf\x7b\xba\xd9\x43\x81\xeb\x71\x58\xb1\x18\xcd\x52\xb0\x62\x77\xb4\xb4\x81\x33\x0d\x03\xe7\xc3\xe1\xfe\x38\xb8\xbf\x35\xfe\x8c\x61\x27\x3e\x8d\xa5\x66\x6a\x71\x9f\x6a\x13\x61\xe3\x54\x13\xc8\xdc\x30\x7a\xab\xb3\x44\x3d\xb5\x40\x0d\xb9\x86\x12\xcd\xb5\x2d\x33\x63\x8b\xb9\x73\x67\x36\x35\x30\x40\x0b\x58\x10\x97\x50\xe0\x0e\xe1\xa2\x23\x25\x8e\x61\xf1\xad\x1d\x45\x28\x05\x16\xc4\xce\xf8\xcd\x01\x69\x41\x84\x0b\x40\x77\xb0\xc0\xd2\xe2\xee\x39\x14\x2e\x3e\xba\x87\x12\x59\xc2\x57\x64\x09\x11\xde\x92\x95\xd3\x3a\xbc\x21\x2b\xab\x8f\xf3\xc6\x1a\xf8\xca\x28\x47\x15\xec\x6f\xe3\x74\x84\x6c\xc7\xa6\x13\x37\xce\x03\x54\x56\x5d\xaa\x70\xc7\x1b\xab\x36\x64\x33\xb6\xbd\xd6\x07\x34\xa4\xf2\x96\xcc\x0f\x66\xb8\xf1\x1e\xa5\xf1\xaa\xc6\xba\xb5\xcc\x73\xbf\x7d\x2f\xc3\xcc\xc6\x9a\x0f\xed\x56\x2c\x0d\x5d\x62\xfb\x24\xc8\x69\x6f\x7c\x00\x83\x6b\x3b\x52\x90\xef\x7f\x5f\x38\xf8\x7d\x18\x12\x7f\x9f\xa7\x53\xcd\xd7\xc9\xcc\x78\x16\x9f\xc1\x01\x9d\x59\x82\x2e\x80\xa7\x73\x2b\x54\xb7\x50\x2f\x28\x70\xdd\xe9\xcb\xfe\xac\xcd\x53\x86\xc7\x8d\x75\x5d\xd6\xc7\x77\x80\x96\x15\xca\xfe\x9e\x18\x49\xef\xfc\xcf\xcf\xdb\x71\x4f\xb9\x87\xd6\x0a\xb8\x83\x56\x76\xbf\x9a\x81\x27\xed\xa6\x6e\x71\x49\x2e\x4d\x6d\x22\xf9\x4a\xef\x25\x94\x28\xd9\x16\x4d\x50\x7a\x19\x84\x27\x5f\xe9\x7d\x4a\x5b\x2c\x33\x9a\xe3\x7d\x21\x68\x91\xbe\x84\xfa\x05\xb5\xa8\x45\x89\xe4\x42\x1d\x15\x6d\xfc\x26\x25\x1a\x78\x42\xcd\x4f\xab\xbd\x51\x99\xac\x58\xa5\xa8\x18\xac\x34\xdc\x6b\x76\xba\xd7\x3f\x10\x1e\x1a\xbd\x38\x66\x7d\xcb\x4f\xce\xa1\xe0\x8a\x4c\x9f\x16\x6e\xaf\x17\x45\x36\xcd\x35\x65\x69\xe9\x1e\xf0\x8a\x50\xef\x1a\x26\x00\x65\xb3\xdc\xcf\x5e\x8d\xe1\x6a\x01\x26\x60\xbc\x4a\x01\x08\xac\xc8\x9d\x15\x2a\x6f\xab\x4a\xa8\x5f\xfc\xa0\x9d\xde\xfc\x00\xf8\xf2\xc4\x98\x9f\x56\x66\x10\xe6\xa4\x29\x84\xa4\xaf\x2a\x5e\x28\xa8\x7a\xad\x43\xe3\x33\xed\x36\xcc\xd5\x36\xff\x4c\xa7\x56\xbf\xb3\xa3\x4c\x4c\xac\x1d\x80\x13\x83\x41\xe0\x32\xee\x8c\xf3\x30\x7a\x19\xf3\xce\x56\xd7\x3d\x4d\x6f\x7a\x9a\x6c\xd0\x06\x84\x8d\x72\xad\x10\x5b\x67\xe1\x65\xd4\xe6\x92\x5a\x0b\x80\x6d\x01\x6d\x9f\x36\x09\xda\x54\xc5\x92\xc2\x0b\x3d\xe4\x60\x86\x1f\x2c\xd4\x41\xf1\xe6\x62\x8d\xcf\xc8\x9d\xca\x68\x1e\xc6\xb9\x1f\xfc\x76\x70\xc2\xc3\x8d\x9c\xe6\x2e\x98\xd5\x5b\xd0\xd3\xcd\x86\xca\xc2\x3a\x9d\x12\xc4\x55\x6b\x1c\x31\x96\x8c\xa0\x5e\xa3\x9d\xa1\x58\x18\x7a\x3c\x8d\x52\x37\x54\x36\x55\x33\x74\x96\xba\xc1\xce\x08\xb4\x25\x37\x51\x7e\xa1\x1b\xed\x73\xea\x3b\x3b\xb6\x66\x4d\x4e\x54\xd6\xe4\x63\x95\x95\xf9\xc5\x77\x13\x66\x7e\x70\x9d\xc9\x9c\xe8\xe0\x41\x2e\x54\x26\xf3\x09\xcb\x8a\x3c\x55\xd9\x1b\x28\x51\x8e\x83\xbd\xb8\x1a\xe8\xd5\x33\x21\x8a\xfb\xa4\x11\x5c\x71\x75\xdf\xd0\x64\xc5\xea\x72\x61\x7f\x6c\xe9\xd2\x29\x99\x1a\xe6\x79\xcf\x3d\x0b\xd9\x0a\x9e\x9b\xe2\xb5\x66\x41\x67\xe6\xfa\xa6\x73\x66\x81\x66\x2a\xd7\xf1\x67\xeb\x73\x09\x8d\xe1\xe3\x70\xdd\xc4\x9e\xd5\xa1\x02\xbd\x83\x2e\x03\xb5\x15\x04\x6b\xaa\x8d\xf3\xd7\x16\x54\x56\x6c\x49\xe1\x14\x3f\xd7\x41\x4a\x5d\x6c\x29\xc0\x0c\x75\x33\xd6\xc9\x8a\x8b\x97\xc5\x72\xd3\x23\xaa\xd0\x5e\x25\xfe\x2d\x8e\x97\xbc\x96\xbc\xa2\xc9\x6d\x21\x6a\x08\xbe\x6c\x79\xc9\x56\x8c\x8a\x0e\xe2\x4b\xc4\x64\x54\xd2\x46\xd0\x65\xa1\x68\x89\xa3\x9d\xa4\x51\x00\x56\x7f\x19\x01\x4f\x68\x3f\xef\xe1\xa0\x92\x55\x3d\x57\x09\xad\x8b\xeb\x8a\x96\x71\x4c\x21\xd3\xf1\x1e\x77\xda\x25\x93\xc6\xd6\x90\x37\x27\x4d\x08\xf7\x2d\x82\xae\xa8\xa0\xf5\x92\x0e\xe0\xba\x56\x6d\x37\x18\xe4\x58\x21\xd4\x22\x1c\xb8\xbd\xaf\xd0\x96\xda\xd4\x86\xc9\x44\xaa\x42\xd1\x84\xc9\x17\x54\x2a\xc1\xef\x69\xe9\xd3\xe0\x3d\xab\xa5\x2a\xea\x25\x4d\x4d\x0d\x5b\x6a\x63\x24\x75\xa6\x54\x08\xc1\x6f\x3f\xf4\xaf\xca\x95\xf5\xcd\xdb\xaa\x62\x4d\x43\xcb\x74\x34\xc3\x0e\xa1\x74\xdf\xb6\x73\x7a\x06\xe9\x3b\xd8\x23\x80\xcd\xa3\x25\xd1\x3e\x07\x64\xd0\xc4\x98\x01\xed\x6c\xc9\x8d\x1d\xc4\x1b\x4d\x89\xec\x3b\xf0\x99\x15\x1e\x99\x14\x0f\x66\xf1\x1b\x26\x13\x8d\x7e\x72\xad\xb3\xd0\x42\x30\x2a\x7d\x20\xfd\x18\x74\x53\x94\x3a\x65\xd5\x68\x72\xc1\xd6\xac\x2e\xaa\x9f\x3b\x74\xe9\x59\x0c\xdd\xee\x7e\x80\x21\x82\xe7\x08\x08\x86\xa3\x93\xf1\x89\x0f\x66\x09\x28\xae\x25\xaf\x76\x8a\x02\x4c\xc9\x3b\x3b\x6b\x87\x25\xa6\x08\x0f\x76\xfa\xb9\xa9\x5d\x96\x8b\x01\x51\xbc\xfe\xd8\x94\x85\xd2\xee\x26\x85\xe7\xc0\xc9\x68\x8a\x8f\x46\xd8\x1e\x13\x43\xf7\xc2\xf5\x69\x60\x71\x68\x22\xf9\x96\x9e\x78\x6d\x1d\x97\x69\x75\x34\x4e\xdb\x69\x41\x50\x2e\xd7\x86\x6d\x50\xac\xf8\x51\x0f\x5c\x71\x01\xad\x5b\xc9\x46\x33\x0c\xb6\x12\x60\xf0\x89\x5e\x7f\x65\xda\x1a\xbf\xe5\xff\x04\x18\x5c\x82\xdc\xc4\x7c\xcb\x4d\x21\x9e\x29\x38\x45\x89\xe2\x1f\x35\xaf\x9e\x17\x92\x42\x34\xa6\xce\x22\xcc\xb4\xc5\x9f\xce\xeb\xa7\xca\x39\xfe\xc9\x6c\x5e\x8f\xc7\xde\x67\xab\xac\xce\xb1\x20\x6c\x01\xc0\x98\x8d\x79\x6a\x4b\x97\x67\x8e\x8d\xce\x95\x8a\x12\xa3\x29\x99\xc8\xbd\x45\x14\x6d\x50\x14\xef\x69\xfa\x19\xf6\x3e\xeb\xac\x22\x6a\x8e\x7f\x3a\xde\x4c\x50\x34\x4d\x75\x6f\xb4\x0f\x68\x93\x11\x48\x50\x22\xe8\x96\xdf\xd0\x67\x5e\x1f\x21\xb8\x9b\x74\xe2\x03\x50\xa8\x0d\x16\x47\x13\xe2\x11\x00\xce\xf4\xf4\xa2\x75\xae\x57\xf1\xe6\x6c\x47\xf6\x23\x04\x4a\x14\xb5\x5c\x71\xb1\x05\x28\x27\xc0\x2f\x5b\x32\xa9\x37\xf9\xe5\x0d\xad\xd5\x1b\x26\x15\xad\xa9\x90\x10\x0d\x25\xca\xe2\x7f\x59\x3b\x0e\xc4\x71\xb8\x40\x7f\x6e\xe0\xe0\xcc\x19\x44\x48\xbf\x9d\xad\x67\xf0\x8b\xbe\x3c\x69\x63\xfb\x2e\xe9\xa2\x61\xf5\x43\x2c\xec\x2e\xa6\x74\xde\x24\x45\x59\x0e\x70\x34\xde\x65\xdf\x14\x52\xb2\x1b\x9a\x8e\xa6\x2d\xc2\xe2\x70\x78\x01\x6b\xd8\x84\x27\x19\x6e\x1d\xcc\x92\x66\x27\x37\xb0\x09\x44\xf7\xa7\x1e\x0b\x9e\xec\x8c\x8e\x99\x2a\x17\x61\x3e\xb5\x3e\x59\x13\x08\x2a\xd9\x3f\x29\xc0\x83\x01\x43\x34\x5c\x55\xac\x0e\xce\x00\x5e\x40\x81\x7d\x11\xf5\x68\x28\x4f\xc2\x6c\x54\xa2\xae\xc1\x19\x38\x22\x30\x4f\xa8\x46\x42\xbe\xb4\xda\xa8\x85\x2f\x70\x19\xcf\x20\xda\x07\x52\x3a\x00\x3d\x1c\x02\x83\x41\x7e\x82\x8f\x58\x5a\x7c\x6c\xf1\xe5\x72\x43\xcb\x5d\x45\xad\xf1\x09\x93\xf1\xb7\x03\x3b\xe2\x58\x65\x77\xfe\x01\x6e\xa9\x90\x64\x84\xd5\x90\xe6\x53\x5f\x4f\xd1\x9e\x9e\x9f\xd1\x33\x71\x38\x63\x8b\x86\x0d\x44\xeb\xf3\xf1\x32\x24\xcb\xbb\x26\xcf\x5c\x07\x77\xc4\xdf\x19\x0e\x4e\xe5\x5e\x3f\xc2\xdf\x38\x86\x8e\xfc\xa5\x76\xc7\xd5\xb3\x9a\x6d\x0b\x3d\xea\x95\x28\xb6\x14\x9e\xe3\x63\xc0\x67\xf2\xf6\xec\x86\x98\xbe\x90\xdf\xef\x83\xf3\x5d\x30\x22\x84\xc6\xf1\x88\xc9\x77\xc5\x3b\x18\x64\x0b\x14\xa1\x38\x66\xf2\x15\xab\x99\x31\xfc\xfd\xf0\x8f\x76\xbb\xc2\xdc\x50\xa1\x53\x9e\x77\x69\x11\x00\x73\x17\x4f\xbb\x28\xd8\x47\xbe\xb6\xb0\x8c\xbb\x30\xdb\x65\x05\x67\xe2\xed\x38\x7e\x0f\x55\xc6\x73\x6d\x06\x19\x31\x85\x3b\x4c\x9d\x21\xe2\x3a\x6a\xe6\xf9\x98\x85\x5e\xe4\x6f\xff\x4b\x1c\xf5\x0c\xf3\xd1\xcc\x44\x93\xf4\xc4\xba\x72\x1d\x30\x4b\xaa\x82\x16\x6c\xd0\x09\x97\x7c\x35\x4c\x06\x8f\x83\xdd\xd0\x17\x7a\x35\x76\xce\x0f\xd7\x64\x34\x62\x71\x7c\xc6\x83\x76\xbe\x55\x0f\xd3\x41\xb2\x86\xea\x63\xc9\xc4\x14\x63\x9f\x32\xfb\x6b\x4b\xa6\xa3\xae\xc0\x01\xbe\x80\xb1\x1a\x83\x2f\x60\x7e\x14\xdc\x9a\x93\xa1\x2f\x60\x0c\x22\xef\x6d\x74\x70\x2b\xe8\x3f\x76\x4c\xd0\x32\xba\xbe\x8f\xc0\x58\x0c\x7a\xeb\xc8\xac\x10\x29\x1e\xdd\x72\xf1\x15\x47\xd7\x34\x92\x3b\x41\x75\x03\xab\x97\xd5\xae\xa4\x11\x53\xd1\x35\x5d\x71\x41\xed\xe8\x11\x40\xed\x69\xc6\xf8\x5b\x20\x7b\xb4\x2e\xdd\x79\x8d\x54\x85\xd0\x69\x92\xfd\xb5\x6d\xba\x37\x0d\x4e\xc7\xfe\xf2\x2f\x1c\x66\x86\x07\x79\x33\x1d\x2f\x54\x7d\x6a\x41\x75\xde\x5c\xf9\x30\x81\x8f\x67\x28\x59\xf2\x7a\x59\x28\xd8\x35\x4e\x31\x47\xc1\x3d\x09\x96\x08\x7a\x43\x85\x0e\x2f\x52\xd6\x23\xf6\x8f\xa1\xf3\xa9\x09\x4d\xb6\xe6\xc8\xe3\x02\xc2\x45\xfa\x79\x72\xf8\x3c\x46\x8b\xcf\xe5\x93\xcf\x89\xfe\x8b\x60\xf2\x04\x5d\x20\x2c\xc8\xb8\xd6\x38\x35\xa4\xce\xbe\xcb\xcd\xc6\x89\xbe\x7e\xc4\x56\x50\xa7\x36\x4d\x5f\x61\xfa\x0f\xe0\x4a\x76\x72\x2e\x6f\x99\x9e\xbe\x41\xfb\x65\x21\x29\xf8\x8f\x06\xa4\x92\xf0\xf9\xb5\xa0\xc5\xd7\xb9\x6d\x02\xa9\xfd\x15\x20\x2d\xe9\xaa\xd8\x55\x2a\x95\x84\xcd\x5b\x3d\x41\x49\x36\x50\x76\x64\x95\x99\xca\x2f\x66\xd3\xe9\x13\xd1\x9a\x5a\xe7\x46\xb3\xbf\x39\x1c\xc0\x8d\x29\x3c\x36\xc8\x15\x39\x1d\x78\x41\x3c\xc8\xe2\x17\xf8\x50\x29\xf2\xf7\x94\x95\xd3\x6f\x0e\x7f\xac\x7a\x5d\x38\x94\xbb\xb8\xab\xdb\x8d\x3f\x1f\xef\x46\x36\xc5\xd3\xfc\x9b\x39\x3d\xd3\x39\xbd\x2f\xff\x5c\xc0\xcf\xe3\xc3\xe7\x09\xba\x78\xb0\xe4\x45\x13\x25\xd8\x16\x6a\x47\x21\x83\x5d\xba\x82\xcd\x99\x0c\x77\x62\xef\xe9\x48\x5a\x08\x2d\x15\xf8\xf0\x59\x5e\xa0\x16\xa1\x79\x93\xc9\x3c\x8e\x4d\x2d\x51\x3f\xf6\x9b\x8d\x75\xc0\x37\x54\xda\x4b\x9b\x10\x44\x92\x36\x85\xd0\x51\xba\x56\xd3\xdb\x0d\x53\x34\x92\x4d\xb1\xa4\x50\x22\x73\x8d\xea\x38\x45\x2d\xa2\x25\xdf\x6e\x8b\x08\x62\x14\xe9\x34\x8f\x16\x65\xe2\x12\xd5\x92\x5c\x7c\x96\x4f\xf0\x67\xf9\xe4\xf0\x59\x8e\x2f\x70\x41\x0c\x2a\x72\x91\x35\x79\x9a\x35\x9d\x12\xc8\x4e\x33\x32\x83\xa6\x65\x52\x89\xb2\x69\x9e\x23\x7c\xd4\x36\xcb\x73\x0f\xed\x67\x90\xe3\x19\x42\x79\x2f\x41\xc5\x11\x57\xfb\xbd\x82\xd6\x02\x8f\x44\x2a\xd0\x69\xf5\xa4\x21\xa3\x59\x58\x31\x2a\x77\x4b\x7a\xb6\xaa\x08\x8c\x09\xc9\x68\x17\xf1\x1b\x2e\x9b\xed\x1f\x03\x0c\x26\xc1\xbe\x2b\xb4\x80\x03\x48\xa2\xf4\x3a\x53\x4c\x51\xda\x1c\x75\x8d\x6d\xdf\x4c\xf7\x51\x4f\xa4\x42\x2d\xce\xf2\x07\x05\x45\x1b\x87\xda\x9e\xed\x6a\x61\x29\xce\xc4\x26\x1a\x6f\x7a\xc6\x37\x69\xb6\xbc\x37\xce\x0f\xd6\x99\xca\xc7\x84\x3f\x81\x60\x62\x49\x63\x93\x59\x6e\x2e\x1e\x20\x3b\x6f\x60\x61\xff\x19\xdc\x22\xc0\x8c\x28\x97\x4a\xe2\x7a\x90\xa1\x8a\xee\x74\x52\xea\xc0\xd8\x67\xa6\x92\x88\x20\x7c\x28\x49\x7d\x54\x47\xeb\x1c\xd7\x7b\x38\x66\x68\x91\x8d\x19\x9e\xe6\xe9\x9f\x21\xc3\x0d\x96\xb8\x44\x4e\xaf\x08\x21\xe5\x02\xba\x82\x3e\xcf\xa6\xb9\x3b\x23\x98\x10\x9e\xcd\x72\xe4\xcb\x84\x0f\x81\x8d\x3d\x98\xbb\x75\x62\x80\x7c\x87\x81\x32\x07\x76\x0e\xc8\x85\x0d\xf6\x78\xed\x0c\xa0\x9b\x4d\x67\xd8\x36\x19\x6f\x30\x6d\x7d\x86\xf9\x57\xf2\xb6\x50\x9b\x64\xcb\x6a\xfc\xab\x7d\x5c\x55\x9c\x0b\xfc\x8b\x6b\x2f\xee\xf0\x7f\x93\x0c\xd4\x85\x62\x37\x34\x5a\xf2\x92\x02\xdc\xdd\xd9\x7b\xbb\x53\x26\x3a\xbb\xbc\x96\x54\xdc\x50\xf1\x9c\xd7\x52\x89\xdd\x52\x71\x91\x83\x1c\xff\x17\x39\x23\x10\xff\x7d\xe4\xe4\x55\x6f\x25\x9e\x42\x7a\x38\x00\x80\xba\xbb\x7f\x10\x05\x72\xda\xa2\x16\xff\x9d\x3c\x9c\x9a\xe2\x3f\x92\x0c\xbc\x2c\xd7\x1a\xc1\x2b\xc1\x4a\x9d\x09\x62\xf0\x8a\x09\xba\xe2\x77\x20\xc7\x94\x92\x29\x56\x94\x4c\xe7\x8a\x3e\xfd\xa3\x93\xea\xb9\xa2\x63\x32\x43\x6c\x05\xff\x1e\xc7\xd3\xa7\xa4\x2e\x6e\xd8\xba\x50\x5c\x24\x3b\x49\xc5\xb3\xb5\xb6\xc6\x1e\x85\x3f\x66\x8a\xe6\x08\xed\x29\x25\x33\xeb\x6c\x8c\x3b\x61\x98\x53\xf2\xf7\x38\xfe\x2f\x1f\xba\x1e\x73\x05\x61\x46\x09\xa7\x8b\xd3\x7a\xfe\x68\x86\x39\x99\x62\x76\x7c\xd9\xca\xf9\x01\x08\x64\x53\xd4\xe6\xe6\x41\x4d\x6f\x4f\xb8\x0d\x83\xbb\x96\x54\x27\x98\x64\x34\x6b\x83\x8a\x1f\xb7\x70\x90\xe1\x7d\x50\xb8\x32\x89\x5d\x30\x52\xe9\x9c\x46\x2b\x3c\x1b\x46\x78\xe0\x6e\x62\x08\x07\x98\x23\x3c\x1e\x73\xd4\xb6\xe9\x39\x0a\xba\x6b\x59\x67\xa6\x94\x54\x5d\xb1\x2d\xe5\x3b\x15\xe2\x6a\xe8\xa6\x10\xb5\x98\x9a\x7a\x0a\xae\x29\x09\xba\xdd\x7c\xbe\xbc\xc9\x4c\xc8\x6b\xac\x57\xbf\x39\x45\xd3\xfc\x95\x0a\xc9\x78\xdd\xfb\x8c\xb7\x1f\x5e\xbf\x8c\x66\x53\x80\x10\x66\x2d\x16\xc1\x9c\xdd\x85\x3b\x48\x23\x5f\xe9\xe3\xab\x48\x21\xa4\x36\x82\xdf\x46\x9a\xb9\x57\xf7\x0d\x7d\x29\x04\x17\x10\x3c\x2f\xea\x9a\xab\x68\x59\x54\x95\xf6\x1e\x55\x21\x65\x54\xc8\xa8\xe8\x48\x04\xa8\xc5\xcd\x00\xe7\xf0\x76\xab\x5e\xcc\x6b\x17\x3f\xaa\xbd\x98\xca\x0b\xb7\x45\x17\x9e\xd0\x7a\xb7\xa5\x42\xc7\xb2\x24\x7c\x39\x1c\xb4\x5c\x68\xfb\xba\x62\xeb\x9d\xed\x1f\x4d\x31\xb8\x29\xaa\x1d\x05\x3a\x14\x35\xf5\xd3\x5b\xc1\x94\xeb\x43\xd8\x05\xfa\x56\x35\x7e\x16\xbc\xa1\x42\xdd\x43\x8a\xb9\x39\xd8\xe1\x5d\x24\xda\xab\xdd\xe0\x32\x93\x0e\xab\xa1\xea\x6b\xdd\x7a\xcf\x99\x69\xd3\xa9\xbe\x6a\x5b\x88\xb0\x1c\x72\x54\x47\xfa\x3e\x32\xd4\xf1\x31\x5d\x3c\x84\x83\xc2\x7b\x83\x7a\xca\x71\x4f\x64\x3a\x9a\xe2\x90\x42\xfd\xee\x29\x32\x32\x9a\x9a\xb2\x38\xc7\xb4\xc5\x25\xf5\x07\x71\x85\x94\x6c\x5d\x1f\x0e\xa1\x20\x76\xb5\x32\xcc\xc9\x6c\xce\x4f\x42\xe3\x39\x1f\x8f\x91\x07\x62\x1a\x55\x15\xc4\xc8\x3c\xc7\x0a\xb9\xd9\xfb\x52\xff\xa6\x90\x97\xb7\xb5\xa7\xc1\x5e\x41\xd6\xac\x88\x63\x48\x33\xa6\x13\x2e\x96\xf7\xd5\xfa\x16\x17\x94\x64\xe6\x0c\x74\x62\x23\x78\x6c\x0f\x44\xed\xcf\x44\x47\xf2\x26\xd7\xeb\x7a\x6d\xde\xa7\x5b\x6c\x9f\x3d\xe4\xf4\xbd\x3e\x44\xb3\xad\x16\xc2\x1d\x64\x86\x2f\x7d\xab\x1f\x68\x5c\x8c\x05\xb1\xa7\x32\xb6\xc5\x76\xe7\xb8\xa2\xa4\xf0\xa1\xfd\x9f\x10\x5e\x51\xb2\x7f\xf5\xe6\xf5\xcf\x29\x58\x55\xac\x01\xf8\xf9\x9b\xcb\xe7\x7f\xf9\xf4\xfa\xc3\xcb\x14\x2c\x2b\xbe\xfc\x7a\xcb\x24\x05\xf8\xf9\xe5\xc7\x77\x57\x2f\xdf\x87\x9d\x7c\x57\x2b\x2a\x7a\x98\x16\x6f\xcf\x6b\x83\x82\x41\x1c\x6a\x4a\xeb\xe2\xf7\x5f\x42\x5c\x84\x2f\xe9\xbe\x9d\x0b\x5b\x00\xc0\x0a\x9d\x2b\xa8\x9c\x31\x21\x3a\xa1\xa3\x52\x1d\x15\x11\x6a\x57\xd8\x40\xad\x9d\xc6\xbe\x11\xe6\xca\x0b\xf6\x35\xb9\x36\x07\x45\x1b\x26\xd1\xb0\x72\x47\xec\x5d\x34\x95\xbc\xb0\x69\x85\xc4\x62\x50\x82\xd8\x07\x55\xcd\x74\x34\xc3\x5d\x51\x59\xbf\x0c\xca\x27\x69\x96\xb7\x47\xa5\x7a\xc2\x93\xdf\xfe\xb1\xa3\xe2\x7e\xa1\xbd\x77\xca\xc3\xb2\x23\x61\xbe\x8f\xe9\x3e\xf6\x40\xa1\x9e\xec\x5b\x1c\x66\xfd\xc7\xe8\x06\xf5\x55\xd1\x3f\xa3\x33\xb5\x01\x8a\xf6\xf5\xe9\xfc\x19\xcd\x4f\x58\x30\xe8\x3d\x1c\xf6\x6d\x38\xf5\x42\x0c\xba\xd3\xbd\x89\xd7\x86\xa5\xde\xc1\x39\xfb\x79\xba\x1e\x3d\x7c\xaf\x8b\x2d\x4d\x69\x8b\xcf\xe3\xfb\xf8\x01\xbc\xab\x21\x4c\x94\xaf\x21\x1c\xe1\xf6\xc0\xc1\x59\x70\x9e\xa5\x12\x5e\xbf\xe1\x45\x89\xe2\xd8\x3f\xc2\x3a\x88\x22\x6b\x1f\x5c\xd6\x7d\x6d\x10\xd7\xae\x2c\xe5\xd7\xb3\x62\xe7\xae\x00\x36\x64\xc0\x84\x41\x89\x6c\xde\xb8\x62\xb1\xc5\xe0\x7c\x99\xf9\x4c\x69\x8d\x34\xdd\x8d\x13\x6d\xd6\x33\x73\x63\x01\xd8\x65\x01\xb6\x06\xfa\x54\x85\xbe\x3a\xdb\xa7\x15\xa1\x6d\xb1\x1d\x54\x5a\x01\x7f\x78\xd4\xcf\xe7\x46\x9d\xc3\xf7\xe1\x29\x9e\x9d\x5d\xf8\x5c\x69\xfd\xe1\x39\x5e\x0f\xe6\xc8\xb5\x23\x83\x9d\xd5\xde\xd2\xe4\xa3\x62\x95\x24\xe1\x69\x07\x19\x86\x94\x8b\x75\xc5\xaf\x8b\xca\x7d\x71\x80\xdc\xe7\x2e\x66\x18\xde\x06\xd9\x84\x24\x05\xd5\x0d\x5e\x21\xc8\xbe\xeb\xea\xc2\x73\x3c\xd8\x0d\xed\xee\x8e\xce\x00\xcc\x39\xa2\x3b\x68\x0a\x89\x69\xb1\x3f\xb0\x1a\xb6\x76\x12\x9a\xee\xe5\x86\xad\x54\xba\x37\x02\x9c\xce\xa6\x53\x4c\xfb\x55\x56\xf5\x99\xb0\x2d\xcc\x84\x38\x51\xc3\x24\xc7\x24\x4d\xc3\x7b\x20\x6c\x05\x83\xea\x8e\xcf\x9b\x04\x09\xc5\xbc\x21\x75\x9f\x45\xb9\x6c\xb3\x73\x54\xda\xe1\x0d\x2e\x10\x94\x44\x0e\xef\x0b\x14\xba\xe1\xe8\x6a\x00\xae\xc8\xde\x38\xaf\x54\x1a\x93\x53\x62\x91\x95\x3a\x93\xa9\xcb\x41\xcb\x58\x64\x45\x3e\x69\xb2\x22\x47\xe1\xf9\xab\x33\x9c\xd6\x5c\x35\xb8\xd2\x4e\xbb\xbb\x26\xdf\xb6\xee\xd4\xd6\xf3\xed\xbb\x13\xbe\xfd\xd3\x43\x4c\x5b\xdc\x08\xb3\x7f\x97\xee\xa3\x1a\x3f\xe8\x4f\x8f\x30\xbb\xbf\xcb\xae\x4e\xcf\x59\xed\x87\x26\x3e\x1a\xf5\xc7\x3a\xf3\xa0\xa9\xf7\x0a\xa6\xec\x09\x39\x11\x90\x23\x7f\xc4\x7e\x7b\x3a\x1a\x9f\x1b\x8c\x95\x3f\xb5\xc5\x1c\xcd\x43\x44\x08\x9b\x3b\xc7\x9c\x34\x82\x71\xc1\xd4\xbd\x29\x01\x0d\xb9\x87\xb5\x30\x0b\xb6\x2d\xc4\xfd\x89\x1c\x71\xd2\x64\xb4\xcb\x89\xf5\xf3\x53\x96\xd1\x3c\x8e\x47\x2a\xa1\x72\x59\x34\xf4\x13\x53\x9b\xf7\x1e\x15\x43\xc3\x2f\x50\xc3\x61\x0d\x87\x74\x4c\x69\x2f\x76\x73\xd4\x62\x49\x97\x5c\xa3\x76\x6e\x9d\x3e\x5f\xa6\x43\xa9\xa9\x49\x93\xf1\x01\x0a\x3f\x7c\x03\x85\x9a\xfc\x15\xea\x31\x06\x85\x09\x0c\x67\x76\xb7\xc7\xba\x2b\x60\xa8\xc3\x90\xe3\x1a\xb5\xed\x23\x97\x29\x3a\xcd\x32\x15\xa4\xcc\x87\x5f\x43\xb1\xa7\x68\x01\x3a\x2a\x41\x0a\x1c\x5f\xc1\xbc\xe9\x64\x54\x66\x2a\x37\xa7\xcc\xa7\x67\xe0\x26\x6d\xc7\x7e\xab\xd2\x6e\x0d\x31\x38\x4b\x70\x1a\x97\x63\xb7\xeb\xe9\xf7\xf8\x44\xf6\xd2\xe1\x85\xcd\x16\x7f\xa5\xb4\xb9\xe2\x6b\xaa\x36\x54\x78\xc9\xfe\x3f\xbf\xcb\x8c\x78\x53\xa0\x65\xdc\x09\x8c\xb6\x1e\x81\xf3\x0b\x6d\xcd\x91\x9d\x11\xe4\x57\xdc\x78\x53\x31\x44\xbf\xe3\x99\xb9\x3a\xd8\x2c\x1c\x95\xbd\x35\x2d\x75\xe3\xd0\x7e\x34\x0f\x5e\x2d\xe2\x99\xcc\x9f\x0a\xc8\xb4\xf1\xd0\x21\xfc\x11\x67\xb3\x32\x27\xae\x77\xc2\xb5\x11\xc1\x3c\x2b\xf3\x1f\x74\x93\x7c\x74\x80\xd4\x32\xac\x2d\x89\xb9\x27\xe2\x39\xf7\xfd\x63\x36\xc1\x5e\x2d\x1a\xbd\x0a\xd5\x37\x3c\xf2\xd6\x13\x01\x0c\xc2\x1d\x39\xbe\x57\xc9\x74\xb6\xe8\xbe\xfd\x62\x2b\x08\xa4\xa9\x95\xf4\xbe\x8b\x99\x25\x18\x39\x31\x10\x89\x89\x1f\x3f\xd0\x8a\x2e\x15\x17\x90\x21\x3c\x62\xfd\xd4\xf6\x66\xaa\xf9\x2e\xed\x64\x60\x77\x8f\x9b\x75\xb8\x0c\xab\xb3\x9f\x9e\xbd\x7f\xf7\xfa\xdd\x9f\xd3\xe8\x8b\xa1\xc0\xe3\xf7\x25\xda\xee\xa4\x8a\xae\x69\xb4\xdc\xb0\xaa\x8c\x34\x76\x4a\x46\x76\xd6\xc8\x01\x8d\x00\xc2\x74\xee\x9d\xcb\xc3\xc2\xf2\xbb\x0a\x76\x4e\x9a\x06\xba\x31\x90\xa6\x82\x94\xa7\x95\xd5\x4a\x37\x06\x1f\xd7\xe0\x95\xb9\xf7\xfb\x86\xdf\xfa\xfb\x14\x78\xab\x41\x42\x81\x5b\xea\x86\x6b\xff\xa1\x8d\xd3\xc2\x35\xb9\x84\x0c\x65\x45\x3e\x97\xd9\x32\x9f\xac\x9f\x36\xd9\x2a\x3f\x27\x41\xab\x7c\x42\x74\xdf\x04\x5a\x40\x6d\x6a\xb2\x55\x3e\x5e\xff\xd0\x64\xcb\x07\x46\x8c\x89\x05\x99\x68\x10\xeb\x08\x76\xb6\x49\x66\x45\x7e\xf1\xdd\x64\x7d\xf1\x1d\xbe\x26\xea\x8c\x6b\xe8\x3e\x14\xaa\x50\x77\xd5\x10\x34\x77\x00\x03\x80\xf0\x3d\xd9\x4d\x36\x27\x0b\x22\x8d\xdd\xb5\x57\xa1\x7b\xf2\x0b\xd4\x86\xb3\xc8\x27\x6b\x7c\x8f\xf0\x54\x1b\x28\xb3\xd7\xfe\xe8\x97\x05\x16\xcb\x74\xe8\x54\xe4\xa8\x29\x5b\xe5\xb6\xae\x28\xb4\x4d\x82\xf7\xe8\x04\x60\x9b\x13\x00\xb4\xa1\xa3\xde\x56\x65\x77\x13\xd3\x95\x03\x7b\xe5\xca\xab\xd9\xff\xfd\x86\xeb\x65\x2b\xf8\xe9\x01\x2d\x33\x87\x29\xa1\x5a\xb1\x15\xa4\x89\xbb\xcf\x15\xc7\xe1\x25\x2c\x42\xce\xdc\x75\x1a\xea\x23\xff\x57\x9c\xf1\x99\x90\x00\x99\x2b\x42\x0f\xc9\x7f\x4d\xde\x68\x8d\x15\x0f\x81\xcc\xf2\xc3\x01\x00\xdc\x90\x2c\xf7\x07\x64\x2a\xb9\xa6\x9b\xe2\x86\x71\x61\x4f\xca\xa2\x15\x4d\x4c\xc2\xdf\x90\x8c\xe1\x3a\x0f\x4e\xcc\x74\x57\x9f\xe2\x37\xe4\x2f\x90\xa1\xe3\xee\xe3\x42\x80\x81\xc2\xa3\xa9\x07\xf4\x07\x6d\x0d\xe9\x17\x9e\xf7\xd7\xdc\x4f\x7c\xa6\xc4\xa5\xb5\x54\x23\x42\xe4\xe1\xd0\xb8\x72\x00\x21\xa4\x1c\xcf\x82\xbd\xf9\x26\x53\xcc\x2e\x14\xa7\xa1\x4b\x45\xce\x5d\x34\x5b\x91\x5f\xf1\x96\x74\x75\x7a\x16\xc7\x2b\x58\xd8\xeb\xe5\xe8\x87\x15\xb4\xd7\xf4\xd1\xe1\xd0\x47\x06\x0e\xc4\xb4\x3f\xd5\x10\x16\xf8\x70\xf0\xf5\x79\x07\x60\x8d\x81\x9d\x44\xf1\x46\x03\xf4\xb5\x79\x07\xa3\xdb\xcd\x1c\x0e\x18\x2f\x49\x38\xb7\xfd\x68\x04\xe1\x0d\x19\x20\xe5\x3e\xfa\x42\x78\x4d\x82\x49\xcc\xa7\x27\x08\xef\xc8\x70\x71\xde\xcd\x7d\x3d\x20\x73\x79\x44\xd3\x66\x40\xc0\xfa\x18\xdb\x1d\xbe\xff\x86\x6f\x66\x08\xdf\x92\xd1\x48\x19\xcd\xf9\x6b\x21\x98\xa9\xb2\xc8\x38\x86\xf7\xf6\x3b\x6e\xf7\xc9\x88\x59\x5a\x37\xb9\x03\x6f\x61\xd6\x1e\x1d\x03\xad\x5d\x5b\x0f\xb5\x43\x73\xb8\x3d\x1c\xae\x0f\x87\x5b\xeb\x86\x9d\x8a\x92\xd1\x14\x9b\x0e\x7b\x1f\xa2\xc9\xca\xf1\x2c\x47\xf8\x36\x8e\xa1\x20\xbf\x41\x81\x86\x17\x29\xd9\x18\x0a\x73\x7d\x5d\xa4\x00\x9c\x09\xac\xdc\xe7\x85\xc7\xe2\xf3\xe1\xac\x56\x3f\x7e\x73\x11\x99\x5b\x89\x67\xad\x0e\xc5\xb6\xce\x66\x83\xbb\x16\x7b\x15\xf1\xe5\xb7\x47\x03\xb6\xee\x13\x9c\x16\x1b\xe3\xe5\x8d\xe0\x7f\x86\x46\x70\xf6\xaf\x25\x7b\xbd\x5f\xad\x09\xf3\x84\x0a\xc2\x06\xb9\xdd\xe3\x7e\x95\x23\x93\xf3\x91\x5e\x58\x4e\xae\xb0\x04\xd7\xbc\x07\x7e\x34\x27\x22\xe3\xda\x0d\x2e\x4c\xcf\x51\x04\x97\xa7\xd3\xe1\x5e\xbe\x81\x67\xae\x87\x92\x8d\xf6\xec\x3a\x10\xdb\xb0\x92\x7a\xde\xfc\xbf\x47\x23\xd8\xc7\xa2\x30\x3d\x0b\xc0\xe0\x28\xe7\x3b\x8e\xc3\xd4\x59\xf3\xc2\xc9\xd5\xf9\x59\x4f\x6b\x57\x27\x0b\xd8\xfb\x7a\xc5\x96\xb6\x28\x70\x0f\xda\x3b\xf9\x0f\x58\x9e\x1a\x9d\x3f\x1c\xec\xa7\x2b\x3f\x38\xbb\xa0\xdf\x15\x6f\x7e\xf0\x9a\xaf\xdf\x4d\xc7\x53\x67\x4f\x0c\xb5\x53\x33\xbd\xa6\xad\xa7\xc3\xbe\x9b\xf3\xde\xa4\x3f\x24\xca\xc0\xdd\x84\xef\xd4\x84\xaf\x26\x3d\x1a\x40\x7b\x66\x13\x24\x9a\xd9\x66\x8f\xcc\x36\xfb\x5d\xb3\x8d\x66\x61\x32\xbe\xb4\x5f\x82\x98\xeb\x9e\xdd\x0e\x7e\xff\xfb\xb2\xeb\x3b\x93\x74\xdc\xe3\xfa\xd4\x07\x34\xff\x8b\xed\x08\xee\x9b\x86\x3b\xb1\x6e\x76\xcf\x96\x4b\x5a\x51\x61\xec\xdb\xbc\xab\x56\x37\xc7\x77\x14\xfa\x28\xf8\x68\xcc\x97\xc8\xd6\xf2\xa2\x2d\xbf\xa1\x65\xa4\x78\xf4\x25\xa4\xf7\x4b\x7f\xd3\xa8\xa8\xcb\xe8\x96\x55\x55\x54\x73\x65\x2f\x19\x35\x5a\xef\x69\x19\xb1\x3a\x5a\xed\xd4\x4e\xd0\xe8\xc6\x1e\x7d\x49\x1d\x4e\xdb\x92\x54\xf2\x9b\xf4\xb7\xef\x25\x2e\x71\xd1\x7f\x1c\xd0\x2c\xd4\x31\x01\x69\x83\x2b\x72\xae\xfa\x80\x57\x64\x0d\x2b\x1d\xea\xee\xfd\xa5\xd8\xb4\xee\xee\xc7\xb6\x78\xe9\x3e\x72\xf9\x15\xd6\xce\x4d\x29\xde\x98\x37\xe3\x87\xdc\x67\x2e\xfa\xdd\x3b\x20\xfb\x19\x8c\x6e\xb1\xfe\xab\xc5\x1b\x12\x78\x19\xee\xbf\x21\xf1\x59\xdd\x2e\xc8\xf4\x59\x67\x26\x5c\x6c\x7d\x4d\x86\x77\x6e\xb5\x5e\x94\xc1\x74\x9b\xc5\xc4\x7f\xc6\x37\xf6\xdf\xb1\xa6\xe6\xdb\x56\x2c\xfb\x79\x77\x1a\xca\x58\x99\xb1\xfb\xa4\x35\xb5\x9f\xb9\xe2\x22\x8e\xaf\xd1\x36\xbb\xce\x89\x5d\xa5\x2a\x14\xfd\x53\x09\xc1\x58\x8e\x41\x73\x87\x23\x30\x2e\xed\xc3\x14\x01\xbc\xcd\x36\x39\x99\xe2\x6d\xb6\x33\x3f\x89\xde\xb3\xe7\x9b\xa2\x5e\x53\x12\xe0\xd8\x7f\x25\x79\x3f\x44\x74\x96\xce\xf0\xed\x00\xa9\x59\x3a\x9b\x9b\x49\xcb\x27\xf7\x76\x5a\xf9\xe4\x76\x38\xf1\x66\x0c\x34\x16\x3b\x73\xec\xfc\x92\xec\xff\x2d\xb8\xf4\xfc\x6f\x69\x60\x25\x83\xef\x8a\x7a\x35\x74\x6e\xee\xe5\x40\x37\xbb\x6b\x84\xbe\x7b\xdb\x35\x74\x01\xfe\x87\xb0\xff\x28\x5e\x1f\xc2\x18\xbf\x76\x2c\x6d\xa3\x29\xbe\xeb\x77\xf8\xde\x6f\x67\x8b\x7b\x75\xf3\xfa\xfe\xff\x1f\xb5\xd8\x8e\xa6\x8f\x67\xdd\xb2\xc7\xf9\x6f\x67\x7b\x87\x04\x87\x69\x4b\x1c\x87\x67\x0f\x43\x6a\xba\xf3\xa9\x8f\x70\x38\xe8\x0c\xd5\xb6\xee\x9f\x0e\x4f\x48\xc3\xaf\x2d\xef\x60\x8d\x15\xa6\x08\x37\xe4\x06\xf2\xf0\x26\x8a\x6e\xc6\xfc\xdb\x5f\x66\x9c\x80\xf8\xcf\x31\xba\x1b\x7a\x27\x27\xf8\xfd\x9d\x78\xdc\x20\xfc\x11\x2a\xdc\x6b\x76\xff\x25\x85\xf9\x64\xe6\x64\xdf\xac\x09\x69\xdb\x16\x6b\x2b\x38\xff\xc3\xc5\xc5\xbf\x47\xf6\x7f\xa5\x7a\x5b\x34\x0d\xab\xd7\x1f\xdf\xbf\x21\xae\x3e\xb0\x65\x75\xf2\x9b\x4c\xb6\x45\xf3\x87\xff\x09\x00\x00\xff\xff\x61\x7e\xfa\xa8\x24\x4b\x00\x00")
-
-func resPopperMinJsBytes() ([]byte, error) {
-	return bindataRead(
-		_resPopperMinJs,
-		"res/popper.min.js",
-	)
-}
-
-func resPopperMinJs() (*asset, error) {
-	bytes, err := resPopperMinJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "res/popper.min.js", size: 19236, mode: os.FileMode(420), modTime: time.Unix(1510060966, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resPrismCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\xcd\x6e\xdb\x48\x12\x3e\x93\x4f\xd1\x48\xe0\xc4\x96\x45\xea\x27\xb6\x6c\x51\x70\x76\x17\xb9\xec\x2e\x92\x93\x67\x4e\x83\x1c\x8a\x64\x89\xec\xa8\xd9\x45\x74\x37\x25\x2b\x03\x03\xf3\x1a\xf3\x7a\xf3\x24\x83\x6e\xfe\xcb\xf2\x4c\x30\x00\x2f\x5d\xac\xff\xfa\xea\x67\x36\x61\xb9\x31\x65\x34\x9b\x95\x8a\xeb\xe2\x9b\x0e\x13\x2a\x66\x29\x1d\xa4\x20\x48\xc3\xdc\x14\xe2\x5f\x26\xc7\x02\xf5\x83\x63\x08\x68\x07\x3c\xe5\xf0\x4e\x80\xcc\x2a\xc8\x50\x3f\x14\xa0\x76\x55\x79\x9d\x68\x7d\x9d\x08\xbe\xc3\xeb\x6f\xb0\x07\x9d\x28\x5e\x9a\xeb\x8c\xde\x95\xa2\xca\xb8\xd4\x0f\x82\x4b\x0c\x72\x9e\xe5\x82\x67\xb9\xb9\x76\x4f\x59\x15\x31\x2a\x7d\xdd\x91\x83\x1d\x1e\x0f\xa4\x52\xcd\x26\x33\x7f\x36\x99\xf8\x6c\xc2\x1a\x8b\xcc\xb9\xc1\xb6\xa4\xd8\xff\x61\x0f\x8f\xce\xc0\x94\x7d\x7a\x7c\x64\x20\x53\xf6\xdf\x9f\xbe\x7c\xb6\xdc\x9f\x89\x34\x8a\x23\x8b\x41\x63\xca\x48\xb2\x2f\x24\xad\x06\x66\xf0\xc9\x14\x60\xb0\xd1\x13\x1f\xdb\xc0\x0f\x87\x43\x58\xd4\x4c\xa1\x14\x33\xab\xe4\xdf\x50\x99\x9c\x14\xa3\x84\x52\x0e\xbe\x75\xc6\x4f\x28\xc5\x5f\x12\x01\x5a\x4f\x1e\xde\xb4\xd1\x07\x6f\xbe\x4e\xfd\x52\x9d\xfd\xc1\x7e\xf5\xbd\x84\x04\xa9\x88\xbd\xdd\xde\x6f\xef\xb7\xcb\x8d\xef\xc5\x90\xec\x32\x45\x95\x4c\x23\x26\x49\xe2\xc6\xf7\xac\x63\x81\xce\x21\xa5\x43\xc4\xe6\x6c\x51\x3e\x31\x95\xc5\x70\x39\x9f\xb2\xe6\x0b\x3f\x5c\x6d\x7c\x6f\x4b\xd2\x04\x5b\x28\xb8\x38\x46\xec\x13\x49\x4d\x02\xf4\xd4\xc6\x07\x09\x4d\xd9\xfb\xff\xc8\x14\x04\xba\x78\xdf\x4f\xd9\xfb\x9f\xe3\x4a\x9a\xaa\x7d\xda\x00\x75\x09\x49\x67\x10\x04\xcf\x64\xc4\x04\x6e\xcd\xc6\xf7\x0e\x39\x37\x18\x38\x86\x88\x95\xca\x72\xd9\x32\x38\x0a\x97\x99\xf5\x55\x15\x20\x5a\x72\xac\x10\x76\xa7\xc4\x83\x82\x72\x40\xab\xeb\x8d\xb6\xaa\x11\x5b\x84\xb7\x1b\xdf\xf7\x82\x82\xbe\x07\x06\xe2\x40\xf3\xef\x18\xb1\x9b\x8d\xef\x05\x74\x42\x18\xbd\x7c\x2f\x38\x60\xbc\xe3\x26\xc8\x8f\x65\x8e\x52\x77\x59\x73\xaa\x5e\x12\xf5\x0b\xda\xc9\xfb\xd9\xf7\x67\x13\xf6\x89\x52\x64\xb1\xa0\x64\xe7\x90\xf6\x17\x15\x2c\x21\x4d\x5d\x06\x16\x58\x6c\x7c\xaf\x00\x95\x71\x19\xb1\xf0\x16\x0b\x36\xdf\xf8\x1e\xed\x51\x6d\x85\x2d\x1d\x54\x86\x6c\x85\x49\xa5\xa8\x02\x05\x29\xaf\x74\x64\x8b\x67\x05\x9f\x7d\x3f\x92\x64\x2e\x4b\x85\x57\xec\x23\xfb\x47\x60\x1a\x62\xe7\xed\xf2\x6e\x79\xbf\x5c\xb6\xf1\xfc\x4f\xda\x74\x3b\xb5\x36\x9e\x1f\x31\x35\x0a\x2e\xac\xa3\x3b\xf1\xbd\x76\x7d\x0c\x8e\xb6\xbe\xcf\xbe\x1f\x1a\xda\xa1\xb4\x03\xa3\x40\x69\xa6\xed\xbb\x54\x24\x28\xeb\x9e\x29\x25\xe6\x58\x62\xf7\x4e\x52\x30\x30\xe8\x0d\x2d\xc0\x60\xa6\xe0\x38\xd4\x59\x56\x32\x31\x15\x18\x4e\xf2\x5c\x1b\x59\x46\x09\x05\x3a\x9f\x2c\x03\x59\x9c\x9a\x63\xc4\xc2\xbb\x91\x1a\x45\x25\x2a\x73\xec\x8c\x1b\xe8\x1d\x4b\x48\x6a\x03\x03\xc7\xf5\xb1\x88\x49\xf4\x8e\xa3\x40\x83\xe9\xc8\xfe\x7a\xb9\xba\x5b\x0e\x2d\xc4\x44\x02\x41\x76\x42\xf5\x44\x1b\xca\x00\xde\x2f\xb6\xdb\xa1\x8c\x46\x81\x89\x21\xd5\x09\x81\x31\x2a\xb0\xf1\xf4\xae\x18\xc5\xe5\xc0\xd5\x1c\x7a\xee\xb8\xe2\xc2\xf0\xde\x24\x97\x1a\xd5\x89\xa3\xb0\xc2\xe5\x12\x87\x46\x6d\x22\x60\x68\x14\xa5\xe1\x83\xcc\x54\xca\x46\xde\xe1\x23\xd1\x9a\x9d\xfa\xa2\xcd\x51\xe0\x0b\x6a\xfd\xdc\x83\xe2\x10\x0b\x7c\xad\x5a\x6d\xa0\xaa\x12\x38\x8e\x7b\x0f\xa2\xea\x49\x5b\x5b\xf8\x93\xaa\xe3\x2a\x8d\xef\x6e\x86\x7a\x9a\x2d\x31\x64\x5a\xad\xd2\x35\x8e\xd2\xac\x30\xc3\xa7\x3e\x4b\x45\x49\xca\x96\x7b\xe4\x61\xba\xbe\x5b\x8c\x84\x3a\xb6\x3e\xdd\x24\x9c\x21\x37\x7e\x0f\xcd\x3c\xb3\x44\x2b\xd6\x4a\x19\x10\x3c\xe9\xb8\x5c\xa2\x22\x56\x53\x87\xda\xeb\x9c\x3b\x0f\x2a\xa5\xad\x0b\x39\x8a\xd2\x71\xd8\xbe\xb7\x9d\x11\xd8\x46\xae\x9b\x93\x34\xb7\xa9\x88\x98\x42\x01\x86\xef\xed\x34\x1b\x8e\x23\xbb\x2c\xb0\x60\xed\x7c\x09\xc7\x1b\x76\xac\x02\x62\x4d\xa2\x32\x56\x85\x1d\xf9\x91\x9b\x5d\xaa\x0e\x66\x3e\xd4\xcb\x65\x8e\x8a\x1b\x47\xac\x87\x5d\x60\xa8\xac\xc7\x1f\x9b\x4d\xd8\x23\x14\xc8\x40\xb3\xd0\x9d\x03\x7f\xfc\xf6\xbb\x66\x8d\xac\xe5\x73\x9b\x72\x34\xa8\x72\x2d\xe0\x72\x79\x33\x65\xcb\xf9\xc5\x94\xdd\xce\x2f\xa6\xe1\xfc\xfe\xea\x64\x13\x5a\xcf\x41\x05\x99\x1d\x3c\x28\xcd\xa5\x21\xe6\x7c\x9b\x9e\x13\x5f\x5c\xb1\x3b\xfb\x78\xf9\x6b\x7e\x75\x65\x57\x46\x49\x5c\x1a\x54\x01\xee\x51\x9a\x6e\xf0\x9f\x6c\xa4\x26\xce\xb3\xbb\xef\xd9\xf7\xbd\x93\x6c\x46\x31\x6e\x49\xe1\xf4\xc5\x8f\xba\x68\x28\xd3\xaf\x11\x6c\x4d\xdd\xfa\x5e\x42\xd2\xa0\x34\x11\xb3\x08\xbf\x74\x1c\xda\x80\x32\x36\xee\xf3\x45\xf1\x5c\x92\xc3\x1b\x37\x70\x9b\x12\x85\xab\xfa\x55\x70\x19\x1c\x78\x6a\xf2\x76\x09\xf5\xd5\x9a\xbb\x45\x64\x49\x7d\x3a\x83\x06\xdd\x67\x52\x77\xe3\x1c\xe8\xff\xf7\xbf\xd7\xb7\x17\xee\x9f\xc5\x6f\x0d\x6f\xb6\xba\xbd\x98\x2d\xc2\x5b\xa6\x41\xea\x40\xa3\xe2\x5b\xe7\xe7\xe0\x78\x48\xd0\xe6\xd9\x52\xf7\xa8\x0c\x4f\x40\xb4\x7f\x9a\xcd\x71\xba\x50\xd6\xeb\x75\xf9\xd4\x69\x69\x6f\x9e\x66\x51\x7b\x31\x3d\x9d\xdc\x41\xae\x34\x1b\xdf\x3b\x53\x90\x1f\xcd\x3b\xca\xf4\xaa\xcb\x6f\xb3\xa2\xbd\x98\x8c\xa1\xa2\xcb\x77\xdd\x7e\xe1\xf0\x24\xfd\xbb\x06\x0c\xea\x12\x7d\x08\xef\x9d\x86\x84\x2a\x07\x39\x85\x1a\x4d\x8d\xe7\x5a\x51\xdb\xdb\x63\xe5\xf5\x56\x7e\xcd\x06\x63\x8c\x8d\x40\xd9\x61\xb5\xeb\xf2\x56\xd1\xe8\x15\x28\x3a\xe8\x57\xdb\xfe\x7c\x57\xd4\x79\x99\xb7\xe7\x65\x7d\x78\x2d\xe6\xf3\x8b\x6e\x50\x04\x6d\x8c\x0d\x04\x3f\x34\x83\xe0\x40\x6a\xa7\xdd\x39\x3e\xf2\x28\x46\x41\x07\xab\x61\xee\xe8\xee\xbc\xf2\x04\x1a\x6b\xba\xbb\x26\x83\x85\x83\x41\x8b\x8e\xe6\x46\x2c\x9f\x98\x26\xc1\x53\xf6\x76\xbd\x5e\x0f\x6f\xbf\x4a\x5b\x61\xb7\x35\xc7\xf7\xdf\xf9\x1f\xfa\x2c\xfd\x0c\x6d\xd0\xe8\xa3\x14\x7e\x64\xba\x04\xb7\x82\x5e\xcb\x9a\x97\x72\x5d\x0a\x38\x46\xf5\x11\x59\x77\x55\x0d\x01\x2e\x13\x85\x85\x43\xe1\x10\x06\x0e\xc3\xaf\x1b\x6b\xe6\x8b\xb3\xd9\xc3\xb8\xd1\x79\xd9\x2b\x72\x58\xee\xf6\x97\xcb\x93\x77\xce\x9b\x0e\xa7\xed\x8c\x6f\x8a\x38\x6e\x60\xf7\xd3\x52\x9f\xfd\x3f\x03\x00\x00\xff\xff\xb9\x30\x69\x72\x09\x0e\x00\x00")
-
-func resPrismCssBytes() ([]byte, error) {
-	return bindataRead(
-		_resPrismCss,
-		"res/prism.css",
-	)
-}
-
-func resPrismCss() (*asset, error) {
-	bytes, err := resPrismCssBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "res/prism.css", size: 3593, mode: os.FileMode(420), modTime: time.Unix(1510060966, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resPrismJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x7b\x7b\x93\xdb\x36\x12\xe7\xff\xfb\x29\x46\x3c\x2f\x0d\x0c\x21\x52\x93\xec\xe6\xb2\xd4\x60\x78\x8e\x63\xef\xe6\x2a\x89\x53\xeb\xd4\xbd\x48\x7a\x02\x91\x90\x04\x0f\x44\x68\x41\x70\x1e\x11\x78\x9f\xfd\x0a\x00\x49\x51\x8f\xb1\x9d\xdd\xb3\xab\x86\x24\x1e\x8d\x46\xa3\xfb\xd7\x0d\xa0\x15\x5d\x5e\xac\x95\xda\xc6\x51\xb4\x95\xac\xde\x7c\xac\xc3\x42\x6c\xa2\x52\x3c\x54\x5c\x90\x32\x5c\xab\x0d\x4f\xd4\x9a\x6e\x68\x8d\x6d\x83\xa9\xb8\x23\xac\x64\xc4\xe7\xa4\x5a\x35\x64\x45\x6b\xbc\x21\xf2\xae\xd9\x06\x45\x5d\x07\x05\x67\x77\x34\xf8\x48\xee\x49\x5d\x48\xb6\x55\xc1\x4a\xf8\x5b\xde\xac\x58\x55\x63\xce\x2a\x3a\x5d\xb3\xd5\x9a\xb3\xd5\x5a\x05\xf6\xb3\x6a\x36\x0b\x2a\xeb\x60\x28\x9e\xde\xd1\xa7\x07\x21\xcb\xfa\xe2\x32\xfa\xd3\x3d\x91\x17\xb7\x35\xe5\x4b\xec\x35\x55\x49\x97\xac\xa2\xa5\x37\xc1\xea\x69\x4b\xc5\xf2\xe2\x81\x55\xa5\x78\x48\xdc\x23\x3e\xd7\xe2\x7f\x0a\x79\x47\xe5\xdf\xb9\x58\x10\xfe\xbe\x10\x5b\xea\xfb\x86\xda\x05\xab\x6a\x45\xaa\xe2\x6c\x93\xc4\xb4\x88\x77\x2d\xfa\xc5\xcc\x16\x2f\x9b\xaa\x50\x4c\x54\x00\xee\x0c\x37\x14\x47\xd9\xc2\xcc\x1c\x24\xb1\x99\x3c\x4c\xa6\x20\x7b\x08\x60\xb6\x88\x18\x52\x78\x86\x2a\x6c\x19\x0e\x5d\xef\xdd\x86\x54\x0d\xe1\xf1\xa8\xcc\xf7\x47\x1f\xa1\xab\x47\x25\xab\xc9\x82\x53\xc7\xcc\x4f\xb4\xae\xc9\x8a\xfe\x83\x54\x25\xa7\xf2\xf9\xbe\x9f\xe8\x84\x1a\xc5\x78\xbc\xa3\x55\x21\x4a\x1a\x0f\x73\xa0\x70\x27\xa9\x6a\x64\x75\x41\xc7\x32\x20\x49\x45\x1f\x2e\x08\xa0\xa1\x91\x1b\xaa\x42\xd3\x3b\x74\x9d\x01\x0d\x0b\x51\x29\x5a\x29\x88\x68\x48\x38\x23\x35\x8c\xbd\x57\x52\x92\x27\x0f\x63\xdc\xb5\x35\xfd\x00\x85\x09\x0d\x37\x64\x0b\x0e\x08\xc0\x98\x86\x92\x6e\x39\x29\x28\x88\xfc\x68\x85\x3c\x9f\x6c\xb6\x73\x0f\xee\x4b\xaf\x6d\x29\x57\x07\x85\x59\x33\x9b\x91\x99\xa9\xb9\xf0\x60\x8b\xcc\x08\xe7\x26\xf2\x6e\xf1\x91\x16\x2a\xdc\x4a\xa1\x84\x69\x13\x2a\xf1\x5e\x49\x56\xad\xc2\x82\x70\x0e\x28\x0c\x37\x44\x15\x6b\x10\x65\xa9\xb0\x4d\x2f\xdc\x7a\xe5\x11\x4c\xaf\xf2\x16\x89\xc5\xc7\x1f\xca\xb3\x12\x0a\x6f\x6f\x59\xa9\x75\x37\x80\xd3\xad\x5f\xa4\xd8\x52\xa9\x9e\x00\x45\x9e\xa9\xf6\xd0\xee\x9e\xf0\x86\xc6\x41\xa0\x5a\x23\x20\x53\xd8\xa2\x82\x8b\xea\x90\x5b\xa3\x3b\xea\x48\x5a\xf3\xfa\x81\x19\xd6\x14\xdc\x15\xa4\xa6\x9e\x1b\xc9\x8b\x4d\x5b\x82\x77\xed\x7c\x29\x24\x30\x1f\xf2\x82\x55\x17\x14\xd2\x70\x4d\xea\x77\x0f\xd5\xc0\x84\x84\xbe\x0f\x48\x2a\xf3\x9e\xb0\x1d\x17\xd0\x54\xe6\x10\xce\xbb\x79\x90\xb9\x25\xee\x56\x2c\x1e\x26\x67\xd6\xe9\xcc\xac\x0f\x09\xc1\x16\xb6\x7d\x8f\xb6\x45\x83\xc5\xc7\x3b\xfa\xa8\x68\x35\x96\x1b\x52\x6e\x92\xe4\x90\x97\x2a\x1c\x3a\xa5\x34\x87\x87\x53\x52\xd0\x32\xaf\x52\x99\x0f\xdc\xb6\x88\x55\x35\x95\xea\x3b\xba\x14\x92\x1e\x0c\x80\x08\x92\x70\x27\xb1\xd4\x7a\x44\x76\x6e\xc8\x71\x2c\x53\x9a\xcf\xd9\x12\x7c\x85\x31\x91\xab\x66\x43\x2b\x55\x87\x9c\x56\x2b\xb5\x86\x3b\xb2\x2f\x4b\xaf\xf2\x81\x09\x66\x98\x20\x90\x1c\xcb\x95\x19\xb9\xf2\x94\xe5\x98\xa4\x2c\x1f\x24\xc9\x5b\xd3\x49\x8c\x57\xa6\x36\x14\x38\x64\x4b\xc0\x8f\x89\xd4\x10\xee\xd8\x12\xd4\x18\x2b\xf8\x45\x03\x8a\xfd\x80\x22\xad\x73\xcc\xd3\x3a\x6f\x87\x65\x19\xe6\x1b\x7e\xff\xf6\xfd\x58\xac\x68\x10\x91\x42\x15\xdc\x55\x18\x5b\x59\xf8\xbe\x9a\x60\xea\xfb\x40\xad\x59\x9d\xaa\x1c\x0b\xd8\x42\x64\x6a\xb0\x68\xd1\xf7\x6f\xdf\x3f\x27\xda\xd1\xec\xf8\x33\x7a\xc7\x0d\xbb\xaa\x33\x30\xc4\x11\x4d\x79\x8e\x88\xd6\x1c\xa2\x5e\x89\x27\x47\xd0\x90\xf2\x1c\x6a\x2d\xd3\xae\xd0\x5a\x9d\x2b\xcd\x93\x4e\x37\xff\x40\x17\xad\xc1\xd9\x72\x3c\x99\xa1\x63\x51\x59\xe6\x14\xe2\x48\x42\x18\xff\xe1\x6e\x55\xc3\x6d\x4f\xd8\xb6\xa8\xf3\x63\xc6\x37\x0c\xee\xea\x15\xe7\x67\x8d\x60\x67\x84\xb3\x20\xc5\x5d\xac\x50\x4d\x39\x2d\x94\x90\xf1\x4b\x83\x87\x69\xc1\x49\x5d\x5f\x62\xaf\x1f\x6f\xea\xe5\xe8\xe2\x5c\xe9\x85\x69\x8e\x2e\x4e\x3a\x9d\x76\xe8\x1b\xbf\x6c\xe7\x55\xb8\x16\xe2\xae\x0e\x65\x53\x01\x6f\x61\x8d\x68\xef\x73\x09\xe7\x1e\x22\x23\x2b\x44\x1c\x93\x90\x72\x6a\x4d\x43\xeb\x52\x14\xd6\x4a\xc2\x7f\x35\x54\x3e\xbd\xef\xf8\x7e\xc5\x39\x20\x61\x3f\x0b\x88\x18\x9e\xcd\x25\xe6\x29\x0b\x82\x7c\x0e\xab\x70\x20\xff\xc6\x11\x02\x12\x51\x8c\x8d\x50\x49\xd8\x8b\x01\x8e\x64\xd6\x35\x8b\x47\x8a\x6b\xd5\x6f\x50\x3b\xc4\x90\xc0\x6a\x2e\x7c\x7f\x42\x43\x45\x6b\x05\x44\x68\xa7\xfb\x33\xd9\x50\x38\x87\x02\x8b\x70\x4b\x24\xad\xd4\xcf\xa2\xa4\xa6\x1d\xe0\x78\xdc\xa6\xc3\x7c\x0a\xb5\x4e\x91\xe7\xe5\x06\xea\x43\x25\x7e\x14\x0f\x54\xbe\x26\x35\x05\x66\x12\x63\x68\xe2\x39\x44\x6a\xdf\x1f\x8f\xde\x07\x8f\x44\x91\x77\xe0\x9f\xea\xa0\x73\x4e\x81\x77\xb1\x5f\xb5\x80\x23\x35\x62\xce\xd8\x35\x1e\x17\xa0\x68\x2b\x69\xc4\xfa\x69\x55\xa2\xa4\x76\x56\xa6\xe1\x88\x01\xf1\x1f\x30\x00\xa1\xc5\xc4\x1a\xab\x50\xd1\x47\xf5\xda\xb9\x6f\xb4\xc2\xbb\x6e\xa5\x63\x35\x40\x79\xcc\xd1\x4a\x92\xcd\x86\xc8\x98\x21\x1b\x2c\xd4\xad\x81\xd1\x73\x6a\x54\x93\x8a\xa9\xa7\x69\xb1\xa6\xc5\x9d\x87\x56\x10\x4d\x56\xa1\xe9\xa2\xf5\x64\x15\x76\x54\x60\x07\x58\xae\xc6\xf7\xcf\x12\x1a\x34\xc1\x52\x59\xf5\x0a\x38\xe6\x16\x3b\x02\xe8\xa0\x3b\x59\x2a\x2a\x0f\x7b\xc3\xc3\x16\x85\xd8\x6c\x39\x55\xd4\x12\xbe\x17\xac\xbc\x98\x3d\x37\x9b\x43\x26\x48\x1f\x5a\xb9\x68\xca\x99\x71\x83\x4d\x5c\xe4\x4a\x40\x15\x2e\x19\xa7\x95\xd5\xc0\x26\x14\xd5\xc6\x05\x5c\x78\xec\x44\x57\x7b\x5b\xa0\xe5\x6b\x51\x52\x4c\xc3\x92\x28\x82\xce\x31\xe0\xfc\xdc\x91\x08\x58\x55\x51\xf9\x8f\x5f\x7f\xfa\x11\x9f\xd0\x42\xd2\xf7\xa5\x03\xdd\xa1\x3d\xfc\xac\x80\x9e\x97\x4f\x8b\x9a\x70\x2b\x6a\xd5\x45\x8e\xe0\xbf\xbf\x7f\xf7\x73\x58\xdb\xd8\x89\x2d\x9f\xc0\x6e\x50\x91\xd5\x60\x28\x4e\x43\xba\xa5\x61\x9b\x0d\x2d\x19\x51\xf4\x35\x17\x35\x8d\x27\xb3\x16\xc2\x96\xf2\x9a\x5e\x9c\x8a\x61\x04\x12\xa0\xeb\x3e\xa8\x0c\xda\xd3\x87\xff\xbf\x05\xf5\x1f\x0a\x68\x68\x78\xe8\x2c\x3b\xf5\xe0\xb8\x0a\x95\xb8\xa3\x15\xfb\x9d\x5a\xec\x1f\x82\x98\x91\x18\x0f\x83\x69\x0e\x91\x84\x2d\xb2\xf0\xf4\xf7\xce\xee\x8e\xfd\x30\xb2\xf8\xe7\x86\xa8\x71\x15\xfe\x6a\x86\x18\x20\x7b\xe5\x42\x08\xb6\x04\x27\x51\xc4\x0a\xfa\x3e\x49\x57\xb9\x8d\x3b\x56\x18\x8b\xce\x18\xe7\x4e\x97\x4d\xd5\xbc\xc1\xe7\x63\xf7\x06\x26\x4d\x9c\x36\xfb\xd8\xa8\xc0\xb3\x79\x71\xdd\x74\x21\xd4\x3c\x08\x0a\xc7\xd1\x1a\x37\x69\x91\xa3\x25\x5e\x87\xac\xaa\x59\x49\x51\x89\x27\x93\x75\xc8\x85\xb8\x5b\xd0\x35\xab\x4a\xb4\xb1\x05\x2b\x49\x69\xf9\x84\xb6\x78\x86\x9e\xf0\xda\xed\x1c\x8c\x2d\x6e\x7c\x7f\xb2\x0e\xb7\x44\x29\x2a\xab\x70\x65\x37\x5e\x8e\xf4\x3d\xde\x97\xf7\x51\x3c\x18\xe2\xf7\x94\x6d\x9a\xa7\xfc\xf2\x45\x04\xd3\x59\x3e\x1f\x5a\xe2\x7f\xd2\xd5\x9b\xc7\x2d\xd8\x77\xad\x45\x23\x0b\x8a\xee\x03\x6f\xe5\xc1\x76\xbd\x27\xaa\xf5\x7a\x98\xde\x02\x4b\x74\x87\xf9\x7c\x71\xad\xfa\x29\xde\x05\x58\xa5\x8b\xbc\xfb\x44\x41\xb0\x70\x6c\x3d\xd8\x62\xc3\x7a\xdf\xf4\x86\xf6\x91\x65\x27\x60\xb6\x04\x13\xf0\x30\xde\x52\x99\xe8\x6f\x1d\x72\x52\xab\x1f\xaa\x92\x3e\xe2\x99\x5d\x84\x5b\xbc\x0e\xe9\x23\x2d\xc0\x03\x44\xbf\xe0\x2b\xdb\xef\xd6\xf7\x37\xbe\xbf\x98\xe0\x9e\xfa\xf4\xca\x2e\xe0\xb8\xf7\x1d\x1a\x7a\x52\x88\x26\xb7\x70\x21\x29\xb9\x1b\x26\xf3\x0a\xdf\x86\xcc\x34\x0c\x40\x99\xdc\x1a\x5f\xe7\x28\xc5\x33\x88\x3e\x0e\x75\xb7\xe9\x6c\x98\xdc\x23\x5e\xa0\x77\xf8\x0e\xfd\x3c\x8c\x3a\xff\xf9\xe6\xd1\xf7\xc1\xc7\x9b\x77\x5a\x4f\x54\xfa\x98\x5b\xbd\xf0\x7d\xf3\x3e\xbd\xca\xbb\xe5\x84\xf3\x20\x78\x84\xef\x8c\xa4\x1e\x07\x62\xaf\x6e\xf0\x3b\xdf\x07\x41\xb0\x40\x77\xf8\x1d\xb4\x92\x4a\x17\xf9\x58\x1a\x5a\x1f\x92\x31\x5b\x4a\x56\x35\x74\xfe\x0b\x7e\x9c\x2e\xd0\x03\xa6\x61\xcd\x59\x41\xc1\x1d\x7a\x07\x51\xc7\xf1\x14\xdf\xb5\x6c\x09\x6e\xe1\xae\xf4\x7d\xb0\xc5\xa3\x99\x39\x0f\xb7\x9f\xf7\x16\xdd\x62\x3b\x3f\x47\x65\x6b\xe6\xfd\x2a\xb8\xed\x39\x7c\x8f\x1f\xba\x9a\x19\x7a\x05\xd1\xeb\xe1\xf3\x23\x44\x3f\xe1\x74\x81\x7e\xc9\xe7\xef\xfb\x29\x04\xf8\x7d\xdf\xef\xa7\x70\xdb\xd4\x6b\xf0\xbe\xf3\xa8\x6f\xac\x3f\xa8\xc1\x0a\x2d\x93\x91\xe9\xdf\xa2\x25\x8c\x6f\xd1\x13\xba\x45\x1b\x3b\xfb\xae\xdb\x1b\x88\x5e\xfb\x7e\xf7\xf1\x1a\x22\x6b\x78\xa3\x9d\x6a\xbd\x35\x3c\x84\x64\xbb\xe5\x4f\x40\xa1\x9f\x20\xba\x9a\xe0\x5f\x7c\xbf\x0a\xc7\xf8\xd0\xc1\xc2\x02\xdd\xa1\xc9\xcc\xe0\x15\x73\xab\xef\xa0\x96\x2d\x41\xff\x6d\xfe\xa1\x9e\xa9\xb3\x41\x69\x4a\x73\x24\xb1\x0a\x25\xad\x95\xe1\x73\x1c\x73\x19\x58\x91\x50\xa5\x3c\xc7\x32\xe5\xf9\xbc\xa4\x06\x06\x2f\x5c\xe3\xfd\x36\xe4\x88\x33\x82\x14\x9a\xa1\x19\x9a\x5c\x41\x44\x5a\x64\x81\x34\xde\x11\xce\x4d\x90\x4c\xca\xe7\x36\x88\x0e\x70\x09\xe7\x73\x62\x36\x24\xe6\x8f\xd6\x69\x8e\xcc\x8b\x13\x97\x82\x2d\x92\x4d\xf5\xb9\xfe\xdd\xa6\x8f\xf8\x3e\xe9\x35\x63\x1c\xdb\x9a\x38\x95\xa4\xdc\xc6\xa9\xd2\xd0\x6c\x5b\x44\x7a\x48\xc5\x07\xc0\x5b\xb9\x18\xd4\xec\x96\xac\xe6\x63\x8a\xec\x7b\x77\xf8\x81\x95\xfb\xb4\x30\x86\x2b\xf7\xe1\x46\xc4\x33\x0d\x88\xd6\x26\x36\xeb\xd4\xc6\x56\x3a\x4d\xc7\x93\x89\xb4\x01\xd5\xc8\x27\xe0\x63\x67\xc2\x96\xc0\x73\xb5\x1e\xee\x8f\xae\x68\x1f\x49\x51\xd3\xfb\xb9\x03\x17\x78\x7e\x3f\x5f\x0d\xfb\xf9\x03\x57\x84\x14\x32\x5b\xfa\xf0\xa3\x60\x15\xf0\x3c\xd8\x6d\x9d\x77\xf6\x6c\xa5\x3b\xfc\xe9\xe6\x1b\x8f\x3b\x0e\x47\x40\x96\x5d\xa4\xc8\x2a\xf6\xea\x2d\xa9\x3c\x64\x83\x54\x5a\xc7\xa9\x67\x15\xcf\x43\x8e\x4a\x8e\x88\x52\x92\x2d\x1a\x45\xed\x76\x69\x08\x25\x14\x72\x91\x70\xec\x84\xd2\x1f\x28\xd9\x85\x65\xcf\xb8\xa6\xbe\x51\xd2\xbd\xc4\x69\xf7\x92\xcf\x8f\x2d\xca\xa8\x4e\x67\x4f\x3c\xec\x78\x43\x0c\xb6\x07\x2e\xfe\x41\x92\xad\x87\xb8\x9b\xbd\xc0\xdd\xf9\xce\x1d\x7d\xaa\x01\x0f\xf7\x7c\xc3\xe7\x8e\x48\x68\xf0\x12\x7b\x2f\x83\x83\xc6\x56\x81\x0f\xa2\x73\xcf\x9e\x69\xfd\xab\x11\x6a\xee\xc1\xe0\xa5\xf7\x72\x90\xfb\x85\xd7\x07\x0a\xde\xb5\x17\xf0\x50\x91\x55\xf0\xf2\xc2\x72\x6b\xe8\x0e\x8c\xef\x9b\x9b\xee\x01\x10\x89\x77\xe1\x05\x22\xf6\x4c\xc0\x7f\x63\x7a\x76\xab\x12\x78\xd7\x51\x4f\xc8\xbb\xf1\x5a\x34\x71\xa1\x6c\xbf\x9b\xeb\x95\xc4\x95\x92\xb2\x7c\x73\x4f\x2b\xf5\x23\xab\x15\xad\xa8\x4c\x40\xf5\xa9\x93\x44\xad\xcf\x77\x03\x5e\x17\x00\x7b\xe8\xf4\x9c\xcb\x86\x92\x5b\x22\x6b\xb3\x7a\x26\x00\x86\x88\x18\x4f\xd3\x87\x91\x06\x84\x6c\x10\xc8\xb1\x0a\x0f\xe3\xc8\xb9\x1b\x6e\x1c\x98\x8e\x83\x47\x39\xde\xa8\xa7\x24\x47\x04\x42\xc4\xfb\xe0\xbd\x30\x14\x00\x6c\x2d\x1e\x8d\x8e\x4a\xe1\xf8\x10\xd5\xae\xbb\xc4\xc3\x5e\xb7\x68\xa4\x51\xc9\xf7\xf6\xbc\xda\xc0\x90\xf3\x0d\x2e\x80\x1c\x5a\xad\x68\xbf\x6f\xad\xbf\x7b\xfa\x95\xac\xcc\xce\x0c\x78\xee\x90\xdb\x83\x30\xdc\x8a\x2d\x18\x02\x40\x69\x37\x3e\xfd\x7e\x01\xcb\xb0\x96\x05\xaa\xba\xc3\x5e\xad\xa5\x89\xdc\x5e\xf5\xca\x03\x3c\x23\xa1\xa9\xab\xf4\xa0\xd6\xc0\xe3\x82\x94\x06\x0d\x26\x78\xcf\xa6\xa4\xa4\x7c\x7a\xaf\x88\xa2\xdd\x89\x77\x28\xe9\xbf\x1a\x5a\xab\x57\x15\xdb\x10\x23\xff\xb7\x92\x6c\x3e\x5d\x39\x16\xe5\x2b\xce\x61\xdc\x35\xae\xa9\xfa\x95\x6d\xa8\x68\xd4\x51\x0b\x74\xf5\x0d\x8c\x07\x16\x4e\x75\xe0\xfb\x77\x3f\x75\x1b\xb6\x1f\x05\x29\x69\xe9\xa1\xa3\x11\xe0\xc1\x3a\xb4\x00\xce\xcf\x9d\xd3\x6f\x44\xd9\x70\xea\xfb\xee\x19\xd2\xc7\xad\x90\xaa\xf6\x7d\x70\x58\x80\xdd\x62\xa2\x73\x24\x5c\x20\xe9\xfb\xc0\xbd\x74\xe7\xef\xae\xc3\xfc\x4f\xee\xc0\x7c\x7f\xc2\xe3\x6e\x2c\xf0\xae\x10\x1b\xbb\x21\x8e\xae\x27\xd3\x69\x9a\xd5\xd9\xfb\xfc\x32\x99\x4e\x6f\x22\xb4\x95\x82\x8b\x55\x1c\x5d\x67\x89\x2b\x0f\x92\x2c\xb9\x89\x50\x29\x0a\x0b\x97\xd1\xf5\xe4\xfb\x77\xaf\x7f\xfd\xdf\xbf\xbc\xe9\xab\x6f\x22\x86\x0a\xb3\x94\xa6\x2e\x4b\x5f\x7f\xff\xea\xd7\x57\x59\xda\x13\xcd\x73\x53\x6f\xa0\x73\xd7\x85\xa3\x86\x76\x94\x80\x64\x92\x95\x30\xfd\x90\xd5\x37\x59\x84\x5f\x5c\xe7\x01\x48\xe2\xac\x0e\xfa\x12\xfb\x8d\x41\x12\x03\x4f\xbf\x84\xa6\x2e\x73\x24\xb5\xe9\x79\x65\x7a\x66\x39\xbc\xcc\xae\xb4\xe9\xf1\xd2\xbb\xc1\x79\x00\x61\x02\x2f\xb3\xfa\x32\x8b\x2c\x53\x2e\x4e\x8f\x77\x87\x83\x7f\x30\xa3\x77\xa3\xe4\xc1\xa8\xd9\xd6\x98\x73\x63\x95\xa6\x6b\x15\x21\xa3\xc6\xf5\x96\x14\x34\x8e\x3e\x74\x7d\xe2\x3c\x88\xa3\xb6\x45\x9e\x41\xc1\xa9\x3d\x05\xf7\x46\xe4\xff\x28\xcb\xcf\x30\x90\x46\x1f\x70\x84\xf6\x64\xc1\x07\xed\xba\xa7\xde\xcb\x3c\x42\xfb\x5d\x87\xd9\x7f\xe6\x6d\x8b\x0e\xb8\xb7\x02\xe8\x38\x34\x53\x18\x33\xb8\x9f\xfa\x30\xf0\xf3\xb3\x6c\x5b\x44\x2b\xc5\xd4\x53\x1c\xf9\xff\x25\x49\xb3\x92\x4c\x7f\xcf\x77\x57\xe8\xdb\x76\x1e\xb1\xee\xaa\xe8\x44\xbd\x0c\x20\x77\x9b\xa4\x74\x2c\xa5\xbc\x2b\x0c\x1d\x49\xfc\x4c\x6f\x57\xdb\xd1\xee\x22\x9e\xb2\xec\xfd\xd7\x00\xba\x04\xee\x3c\xd7\xd4\x38\x4e\xd2\x85\xed\x80\x8c\xbc\x53\xa8\x98\xe2\x14\x93\xde\x61\x8c\xee\x63\xc8\x66\x3b\x8f\x90\xe7\x7b\x10\xb6\xf0\x64\x1e\x8f\x1b\xfe\x0c\x77\x27\x4d\xd7\xea\xcb\xdb\x6e\x88\x5a\x7f\x79\xeb\xfa\x7e\xf5\x4c\xd3\x53\xbb\x2e\xea\x7a\x64\xd4\x59\x94\x5d\xf6\xf6\x97\x5d\x66\x51\x84\x88\x92\x0d\xa7\x23\x2d\xf8\x6f\x69\xf6\x30\xcd\x83\x24\xbc\x4c\x40\x12\xcf\x35\x48\xb0\x31\x9c\x1d\x1c\x2b\xa4\xed\xd3\x37\x35\x3a\xdf\x48\x1e\x47\x8d\xe4\x19\x30\x5a\x6e\x54\xd1\xa9\xb9\xf9\x23\xb3\x4a\xbb\x41\xe1\x48\xdf\x4d\xb1\xd3\xf9\xf0\x32\x81\x99\xa1\x3e\x1c\x0b\x47\xe9\x87\x5d\x9b\xd5\xb9\x79\xcc\x73\xc3\x48\xc7\x43\x84\x5c\x0c\x36\xe2\x77\x6f\x52\x5f\x30\x56\x84\x5c\x10\x6a\x6c\xc3\x00\x9a\x3d\x26\x88\x23\x37\x0f\x37\x4a\x6c\xe7\xb9\x31\xf0\x4a\xac\xc8\xbe\x9b\x0c\x5f\xf6\x4e\xd3\xeb\x15\xcd\x8b\xa3\x74\x4a\xa6\xbf\xcf\xa6\x7f\x73\x9d\x81\xe9\x7a\x60\x6e\x29\x80\xbb\x76\x1e\xe7\xd1\xa9\x45\x14\x75\x1d\x3a\xe1\xf7\xca\x6f\xf6\x15\xdd\xba\x8e\xee\x8b\xce\xf4\x3b\x55\x4b\xb7\xfa\xbe\x7f\xd2\x7a\x7c\x75\x04\x3c\xd7\xcc\x43\x9e\x22\x2b\x0f\xed\x6a\xf5\x74\xb0\xf4\xe0\xda\x96\xf4\x0a\x72\x03\xfb\x37\x90\xe0\xeb\x2c\xb2\x95\x37\x66\x8e\x07\x20\xd3\x2b\xc5\x19\x46\x91\x0b\x4f\xf7\x47\xf8\x45\x5d\x7b\xed\x19\xb3\x3a\x64\xd3\x11\xf4\x0e\x80\x14\xed\x3c\x3b\xfe\xd4\x94\x8d\x51\x2b\xab\x2f\x6d\x05\xfe\x1c\xb6\x8e\xd4\xf7\x3c\xfe\x7d\xe8\x49\xed\x5b\x7e\x16\xc6\x8e\xe0\xd5\x90\x30\x4a\x94\xbe\xf4\x72\x6d\xfe\x64\xf5\xe5\x8b\xe8\x39\x8f\x10\x06\xcf\x8f\x54\xd4\xb5\xd9\x9a\x9d\x95\xdf\x27\xd0\x15\x9e\x71\xed\x36\x03\x61\x0f\x02\xe9\x19\xdf\x31\xc6\x05\x23\xc2\xcb\x2c\xd2\x2f\xe0\xb1\x37\x39\xf5\x3a\xb1\xed\x1a\x85\x97\x27\x8e\xe7\x8c\xa1\xfe\x21\x54\x38\xb0\x54\xcf\x6e\x00\x4e\x16\xcc\x60\x4d\xb6\x00\x49\x6c\xab\x35\xab\x14\x95\x4b\x52\x50\xed\xee\x62\x6b\xcd\x36\xdb\xfe\x26\x47\x49\xc2\x94\xde\x9f\xc0\xe8\x8a\x3e\xc0\xac\x0e\xcc\xe0\x71\x61\xf6\xf0\x59\x1d\x64\x00\xc2\x34\x7b\x08\xb3\xcc\xfa\xff\xb3\x6a\x7e\x18\x0d\xa4\xa6\xad\x01\xc0\x2e\x49\x23\x8e\x2c\x43\x6c\xa9\x29\xaf\xa9\x7e\x58\x33\x4e\x75\x29\xf4\x52\x48\xed\x42\x61\xcd\xaa\x31\x1b\x3d\x98\x18\x7e\xb4\x92\x4f\x5a\xad\xa5\x78\xd0\x96\x23\xbd\x64\x15\xe1\xfc\x49\x57\x0d\xe7\xda\x9e\x69\xe8\xfe\x9c\x08\x66\x8b\x08\x79\x0b\x21\x38\x25\x06\x89\xec\xb0\x4a\x36\x54\x2f\x09\xaf\xbb\xea\x31\x52\x39\xa0\xba\x1d\x21\x95\xcb\x34\x31\x5d\xa7\x66\xd1\x67\x8f\xd6\x8b\x2f\xf3\x40\x67\xe5\x65\x16\x26\x59\x69\x22\x2d\x9a\x06\xd3\xdc\xbc\xc3\xc4\xe5\x74\x18\xd0\x24\x16\xa5\xa7\xd3\x44\x67\x41\x16\x24\x7a\x82\x13\x9c\xe8\x6b\x9c\xe8\x1b\x9c\x68\x6c\xbf\x7c\x3f\xd1\x99\xce\x74\xa2\xb3\x44\x67\x97\x3a\x8b\xf4\xff\xd5\xd9\x07\xfd\xe7\xe8\x08\x23\x77\x6d\x9a\xe5\x73\x00\x51\x68\x80\xf2\x54\x7f\xf7\xb9\x33\x27\x5e\xcf\x2d\x34\xf0\xac\x8a\x7b\x68\x77\xb8\x0a\xa4\xd6\xa4\x7e\xaa\x0a\x4d\x1e\xcc\xe2\x77\x02\x24\x35\xed\xc4\xeb\xd4\xa6\x10\x55\xad\x06\xb9\xea\x92\x2e\x9a\xd5\x8a\x4a\x5d\xd2\x25\x69\xb8\xd2\xee\xdc\xc7\x2c\xa2\x5d\x52\x5a\x35\x1b\xed\xc2\xef\x41\xcf\xfa\x75\x32\xab\xbc\x94\x62\xb3\x5f\xd5\x15\x55\x9a\x2d\xc7\x8a\xe8\x9c\xc9\x91\x16\xec\x55\x97\x53\x65\x55\xc1\x2e\xb9\x58\xea\x2d\x29\xee\xc8\x8a\xea\xad\x64\xf7\x44\x99\xa7\x50\xb4\x50\xb4\xd4\xdb\x66\xc1\x59\xd1\x6b\x55\x4d\x95\xae\x15\x51\xac\xd0\x75\xb3\xa5\x52\xbb\x54\x0a\xad\xd6\xac\xee\x74\xca\x6a\x97\xdd\x1b\xe8\x7b\x22\xf5\xbd\x60\x65\xa7\xa0\x0f\x4c\xad\xf5\x13\xa3\xbc\xb4\x7a\x73\xac\x18\xe9\xe3\xff\xca\xd3\xac\x7c\x35\x7d\xeb\xd4\x63\x96\x2e\xbe\xcb\xd3\xd9\x95\x7d\x15\xef\xf2\x74\x36\xfd\xaf\x47\x5a\x93\xbe\xa1\xf9\x5e\x71\xf4\xcf\xe4\x67\xfd\x43\xb5\x64\x15\x53\x4f\xa7\xaa\x79\xfb\x82\x4c\x7f\x7f\x35\xfd\x3f\xd9\xe3\xab\xd9\x34\x6b\xde\xbe\x7d\xfb\x36\x1f\x0a\x67\xd3\xbf\x8d\xcb\x2f\xbb\x58\xc0\x6a\xf0\x48\x15\xd3\x29\xce\x8d\x36\xa6\x81\x79\x76\xfa\x78\x6d\xfe\xde\xdc\x24\x37\xe6\x69\xe2\x6f\x6c\xbe\x61\xa2\xfd\xd4\xb7\xcd\x75\xaa\xed\xf3\x32\xbb\x34\x4d\xb2\x08\x27\x56\x49\x71\xa2\xff\x8c\x9d\xe2\x86\xbb\xaf\xdb\xe8\xb3\x2e\x6b\xaf\xa3\x1e\xf2\x3a\x2d\xf4\xd0\x4e\xd2\x15\x7d\x8c\x8f\x61\x33\x32\xa8\x69\x30\x2f\x82\x20\x4b\xd3\x0f\x59\x6e\x61\x2f\xc8\x75\x96\x85\xb6\x41\x66\x9c\x98\x85\xc2\x20\x8b\xd2\x15\xdb\x3c\x35\xf9\x6e\x86\xfe\xda\xba\xd9\x83\x17\xda\x56\xa3\x70\xde\xc2\x1c\x1e\x03\xf5\x01\x74\xf6\x82\x9e\xde\x13\xc9\xc8\x82\x1f\x86\xfc\xff\x86\xe8\x2d\x03\xc9\x70\x0e\x99\x2d\x0c\x82\x66\x20\xfd\x00\x60\x7e\x99\x41\xfd\xc7\x68\x42\x43\xf1\xc6\x06\x97\x9d\xb3\x1b\x34\xe3\xf3\x81\xc2\x81\xd4\xbb\xf3\x42\xb4\xf3\x14\xdd\x6c\x39\x51\x74\xda\x15\x8d\x26\xfc\xdb\x38\x40\x30\x0e\xe7\xb7\x1c\x5e\xfe\x36\x72\x36\x03\xcc\x5b\x93\xdc\x0a\xee\x50\x6a\x14\x6f\xbc\xc8\x76\xe9\x87\x36\x0f\xb2\x76\xbf\x4f\xf2\x0e\x5a\x4f\x47\xf8\x76\x18\x60\xbc\xc8\x76\x3a\x6b\x5f\x44\xfd\x5c\xc7\x0d\x5b\x64\x02\xc0\x93\x40\x60\x3f\xc7\xb6\xed\x9d\x6a\xd4\xed\xb0\xcd\x3e\xec\xf9\x60\xf0\x8f\xc5\x82\x76\x88\xc3\x60\xd0\x16\x3d\x1b\x0d\xda\xda\x2f\x0f\x07\xf7\xf3\x38\x89\x6a\x46\xcb\x78\x6e\x3e\x1f\xeb\x13\xdc\xdf\xf7\x38\x75\x17\x2b\xf1\x07\xdd\xc4\xd8\x2f\xac\x49\x75\xea\x10\x7a\x3f\xb0\xa4\xd2\xf9\x80\x25\xe1\xdc\x60\x6a\xb3\x5a\x3b\xcc\x6f\xaa\x42\xaf\x84\xf1\xc0\x02\x26\x1d\xe0\x3b\x94\xef\x71\x7d\x43\xb6\x03\x96\x4b\x52\x99\xbf\x3d\x70\x9b\x4d\x8f\xae\x95\x6c\xcc\xa3\x43\xed\xa7\x2d\x35\x20\x6d\xb1\x72\xd1\x30\xae\x58\xd5\x73\x2b\x04\xd7\x8b\x27\x45\xb5\xbb\x99\x7d\x04\x49\xfc\xcd\x5f\xf4\xd5\x57\xdf\x42\x4d\xa5\x34\xdc\x70\x41\x14\x48\xe2\xaf\xbf\xd2\xdf\xfc\x05\x6a\xd9\x54\x54\x3b\xc5\xd1\x4d\xc2\x2a\x53\xf5\xad\xbe\xfa\x46\xbb\xfa\x44\x37\xac\x52\x5b\x25\x35\xd9\x6e\x69\x55\xea\x82\x6c\xb5\x3d\x36\xec\x07\xd0\x85\xd8\x3e\xf5\x6e\x90\x6d\xc8\x4a\x73\x5a\xe9\x0d\xb9\xa3\xd6\x53\x6d\x49\xc5\x0a\xe3\x9f\x2c\x65\x5e\xc1\x44\x4b\x4a\xb8\x96\xb4\x10\xf7\x54\x9e\x0d\x54\x6e\x35\x13\x8a\xe8\x8a\x71\x7d\x14\xb3\xec\x21\x3d\xbd\xcc\xa2\x3f\x7f\x98\xe0\x1c\x5b\x5c\xc7\x41\x9e\xe8\x69\x8a\xa7\x0e\xb7\xb1\xce\x13\xed\x1b\x48\xd7\xbe\x05\x6c\x98\xe8\x1b\x90\xc4\x36\xfe\x80\x89\xbe\x06\x49\x6c\xe2\x11\xac\xa7\x30\xd1\x31\xd6\x59\x68\xfe\x8f\x9d\x1b\x98\x26\x60\xf6\x98\x92\xe9\x32\x2b\xf3\x40\x83\xac\x0c\xac\xff\xba\x34\x6d\xcb\x00\x02\x9a\x4e\x83\x3e\xf4\x61\x5d\xf4\x73\x36\xac\xfd\x2d\x87\xe0\xd9\x6d\xc7\x08\x8d\x5b\x88\xba\x5b\xa4\x53\xa5\x4d\xc7\x41\x6e\x3e\xff\xd3\x64\x94\x67\xdb\xbf\x5e\xb8\x5b\xf5\xfe\xdc\xfd\xe4\xfa\x6c\x7f\x4a\x0b\xd4\x3e\x77\x09\x9e\x26\x2f\x51\x08\xdb\x81\xa8\x3a\x20\xaa\xb0\x77\xe1\x05\x2a\xf0\x2e\x3c\x04\xcc\x2b\xdd\x27\xde\x98\xc2\xd1\x89\x7e\x9a\x55\x99\xca\xbb\x94\x1b\x77\xef\xf8\x6e\x09\x14\xbc\x99\x5e\xed\xa9\x57\x80\xa2\x0a\xb1\xfd\xc5\x9a\xb0\x17\x4e\xc7\x49\x3b\x1e\xb4\xb7\x7f\x0a\x78\xc8\x83\x88\xe3\x80\x86\x2b\xaa\x8e\x8f\x84\x6d\x86\xb4\x58\x2e\x6b\xaa\x3c\xa8\xf5\x0c\x95\x58\x02\x98\xd8\x83\xf5\x1f\x2a\x15\xdb\x97\xb7\x46\xff\x51\x81\x4b\xb0\xa2\xea\xb5\xd8\x6c\x1b\x45\xcb\xf7\x66\x63\x07\x28\x0c\x0d\x89\x7f\x50\xb6\x5a\x2b\x88\x6a\x3c\x9b\x0b\x4c\xd2\xda\xde\x87\xed\x04\x16\x3d\x13\xd3\xee\x2e\xa8\xc1\x81\x48\x67\x39\xda\x98\xe7\x55\xae\x75\x83\xd6\xa3\x83\x72\x49\x89\xa2\x7d\x92\x97\x57\xb2\x7b\x0f\xce\xd7\x07\x59\x3b\x76\x8d\xc0\x66\xda\x04\x5f\x0d\xd7\x1d\x59\xe5\x41\xb4\x0e\xeb\x83\xf9\x19\xef\x3c\x5d\xb3\xb2\xa4\x95\x01\x65\xd9\x50\xdb\x68\x9f\x00\x05\x98\xbd\x50\x09\xbc\x8b\xc3\xb4\x71\x0f\x99\x05\xf4\xc6\xc9\xe3\xf6\xcc\xfc\x78\x00\x2b\xc0\x5a\x11\xa9\x3c\xd4\x40\xb4\xb9\x69\x7c\xff\x6c\x1b\x5a\x95\x1e\xda\x40\xcb\xa2\x91\x5a\xa8\xc4\x16\x83\x66\xca\xa7\x57\xf0\xb2\x08\xbc\xed\xe3\xd9\x21\x13\x7b\x71\x4b\xab\xf2\xf5\x9a\xf1\x12\xac\x61\x0c\xe8\xa1\xe6\x01\xaf\x10\x25\x35\xcc\x51\x78\xd4\xb6\xdd\x2b\x0c\x03\xfd\xa5\x09\x17\x85\x75\x8d\xe1\x9a\xd4\xeb\xee\x7e\xfa\x0a\xce\x29\xf0\x42\xe3\xe2\x85\x24\xf2\x29\x3c\x12\x06\x0c\x97\x42\xbe\x21\xc5\xfa\xe0\xa2\x8a\x8e\xf2\xcd\x42\x49\x37\xe2\x9e\xba\x91\x29\x6c\xdd\x42\x33\x0c\xd4\x90\x18\x1d\x82\x34\x2b\xd1\x34\x0f\xe0\x8b\x68\x9c\x31\x37\x67\x4b\xc0\x7c\x7f\x72\xe6\x0e\xe4\xbb\xa7\x1f\x4a\xa0\xa0\x63\x5d\x62\x35\xdc\xae\xab\x7d\xd6\xc2\xbb\x25\xf0\x42\x0f\x42\x24\xf0\x73\x14\x24\xb4\xc9\x7b\xe2\xdc\x7d\x88\x99\xa9\x11\x9e\x38\xb7\x68\xb6\xd2\x98\x11\xaa\x80\x40\x0c\x79\x83\x84\x2e\x3c\x88\xce\xa7\x31\x7e\x5a\x8e\x75\x21\x05\xe7\x3f\x54\x4a\xfc\x0f\x46\x1f\x00\x84\x6d\xcb\x96\xe0\xdc\x4d\x43\x4d\xf9\xd2\xfd\x8e\xa0\x4f\xca\x3f\xb8\x6d\xf3\xfd\xf3\xc3\xf7\xb2\x3a\xfe\x41\x41\x7f\x67\x34\x2a\x3f\x1c\x78\x74\x25\xdc\x69\xca\xa7\x2d\x52\x75\x7a\xbc\x14\x95\x7a\xcf\x7e\xa7\xd8\xbb\xfa\xda\x2a\x71\x57\xbe\x87\x04\xec\x5d\x85\x7f\xdd\x57\x6c\x49\x59\xb2\x6a\x85\x67\x43\xc9\x42\xc8\x92\x4a\x5b\xb0\xcf\xb7\xf2\xfc\x6a\x51\x6f\xe7\xd7\x0b\x79\x11\xdd\xb8\x77\x6f\x2f\xf2\x85\x28\x9f\x0e\xb4\x5d\x41\x44\xf1\xd7\xdf\x62\x8c\x55\xe8\xb0\xcc\x0d\x7e\xd4\x65\xac\xa6\x6a\x9c\x75\x0e\x8c\x02\xcd\xe6\x27\xc7\xe0\x67\x93\x12\xf7\x29\xa5\x4e\x56\x15\x56\x43\xc6\xd8\x28\x07\x93\xe1\xca\xf7\xab\xe7\x20\xd7\xb3\x49\x1c\x95\xef\x33\xdf\x1f\x67\x6b\x56\xfb\x6c\xcd\x7e\x31\x67\xd6\x3c\x8f\x11\xaa\x3a\x6f\x96\x32\xc0\x74\x8c\x96\x7d\xba\xc0\x27\xcd\x15\x49\xdf\x8f\x3e\x80\x8b\xac\x82\xc1\x8b\xc8\x31\xe2\x2e\x4f\x3b\x9b\x9b\x4a\xd8\xa5\x63\x8b\x92\xe2\x83\xaa\x19\x32\x95\xfb\x20\x73\x24\xbd\x7d\x9e\xdb\xe9\xed\x2d\x3d\x27\x32\x89\x95\xef\xab\x4f\x88\x4c\xf9\xbe\x3c\x94\x97\x3a\xc8\x6e\x2d\x38\x25\xb2\xbf\x65\x14\xc6\x70\x6d\x32\x81\xc0\xa3\xcb\x47\x86\xae\xec\x3d\x44\x77\x2d\x79\x7a\xdf\x68\xa0\xd1\x44\xad\x2b\xea\x21\x06\x8d\x72\x1c\x86\x0e\xff\x9e\xc9\xf6\x3f\xed\x39\x84\x78\xa4\xf0\x99\x5f\x70\x00\x0a\x8d\x2c\x52\xef\x61\xcd\xcc\x9e\x6b\x4b\x0a\x13\xc0\x98\x81\xb7\x92\x4e\xed\xe5\x0c\xc6\x58\x6a\x6d\xbf\xad\x70\xcc\x77\x9f\xcf\xf7\x8c\x93\x40\xfc\xb4\x26\x1c\xb3\x33\x95\xe2\xa1\xf6\x20\x22\x9f\x6b\x57\xb3\xdf\xa9\xf4\x20\x62\x47\xca\xd6\xb9\x7a\xe3\x8d\xe7\x44\x6b\x40\x9e\x85\x11\x9b\xda\x01\x11\x19\x79\x63\xef\xcc\x10\xa8\x3e\xb0\x73\x02\x4d\x17\x87\x1b\x25\xab\xb7\x9c\x3c\x61\x6f\xc1\x85\x31\x4b\x76\xc6\x1e\x4c\x10\x46\x0e\x82\x07\xaa\xb5\x61\x6f\xee\x0c\x97\x18\x5d\xfb\x4e\x34\x95\xc1\xa4\xd7\x9c\xd1\x4a\xfd\x93\x16\x0a\xc0\x70\x6d\xf1\x63\xce\xc3\xc2\x0c\x2c\x69\x95\xaa\xbc\x1b\xd8\x55\xe1\xca\xba\xed\xd6\x30\x34\x1e\xc0\xf3\x4e\x39\xac\x44\x45\xbd\xb6\x45\x15\x3e\x97\xea\xd1\xdf\xa6\x1f\x87\x56\xc9\x99\x58\x2b\xa6\x43\x2a\x81\x29\xd1\xf6\x50\x2b\x36\x7f\xda\xf9\xb3\x0a\x2d\xa9\x11\xe6\xc8\x0a\xe1\xee\x38\xce\xed\x44\x77\x94\x8f\x70\x12\xe4\x1a\x75\x0b\xbd\x80\x42\xa4\xe0\x97\x5b\xbc\x4d\xc2\xb1\x3f\xd3\xea\xf0\xf2\x19\xe3\x8f\xb2\xfa\x32\x5b\x8c\xb5\x20\x5b\x64\xf5\x65\xd4\x21\xe5\x33\x30\xe9\xfb\x40\xf6\x85\xfb\x04\x7e\xad\xbb\xc2\xfd\x58\xfb\x4a\x68\x93\xfe\xfb\xf2\x2f\xb0\x08\xb8\xfb\x04\x35\xdf\x3f\x57\x8c\xcf\x94\x0d\x21\xba\xb4\xf1\x3d\x44\x67\x19\x1f\x7f\x06\xb8\x8b\x4d\x87\x98\xd0\xa5\xdd\x5b\x53\xb6\x28\xdc\x07\x58\x15\x48\x26\x2f\x60\xb4\x32\xd6\xcb\x13\xde\xe1\x7e\x70\x15\x5f\x19\x4f\x44\x1f\xdc\xd6\x06\x90\xe0\x0a\xce\x19\x66\x5d\xe0\x7c\x6d\xec\xf0\xe6\x3a\xb2\x0f\xcf\x84\xef\x9f\x31\xd8\xfa\x4b\x62\xec\xfa\x59\xab\xb6\xe2\x44\xf5\xc8\xd3\x33\x54\x9d\x8b\xcc\x5c\x54\x0d\x6d\x8e\x8b\xb3\xa6\x42\x34\x66\xeb\xff\x4f\x5a\x53\xe5\xa8\x3a\xa2\x17\x5e\x00\xfa\xdd\x0a\x38\xeb\x6f\x3b\x5a\xe8\x6a\x06\xa7\x57\x10\xa2\xfd\xca\x8c\xb1\xa5\x86\x48\x81\x0a\xb6\x6d\x7b\x06\xf3\x9f\x07\xfc\xc9\x1e\xf1\xb5\xfe\x54\xd2\xc8\x64\x9c\x34\xa2\xf5\xe7\xae\xdc\x29\xdc\x0d\xa7\x9e\x18\x63\xda\xdd\xb9\xd3\x21\x73\xcb\xa6\x30\xf6\x4d\xa6\x76\x53\xd9\xfd\x86\xb2\x85\x96\xff\xff\x17\x00\x00\xff\xff\xc5\x73\xb3\xb5\x75\x3b\x00\x00")
-
-func resPrismJsBytes() ([]byte, error) {
-	return bindataRead(
-		_resPrismJs,
-		"res/prism.js",
-	)
-}
-
-func resPrismJs() (*asset, error) {
-	bytes, err := resPrismJsBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "res/prism.js", size: 15221, mode: os.FileMode(420), modTime: time.Unix(1510060966, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"res/bootstrap.min.css": resBootstrapMinCss,
-	"res/bootstrap.min.js": resBootstrapMinJs,
-	"res/index.html": resIndexHtml,
-	"res/jquery-3.2.1.slim.min.js": resJquery321SlimMinJs,
-	"res/popper.min.js": resPopperMinJs,
-	"res/prism.css": resPrismCss,
-	"res/prism.js": resPrismJs,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"res": &bintree{nil, map[string]*bintree{
-		"bootstrap.min.css": &bintree{resBootstrapMinCss, map[string]*bintree{}},
-		"bootstrap.min.js": &bintree{resBootstrapMinJs, map[string]*bintree{}},
-		"index.html": &bintree{resIndexHtml, map[string]*bintree{}},
-		"jquery-3.2.1.slim.min.js": &bintree{resJquery321SlimMinJs, map[string]*bintree{}},
-		"popper.min.js": &bintree{resPopperMinJs, map[string]*bintree{}},
-		"prism.css": &bintree{resPrismCss, map[string]*bintree{}},
-		"prism.js": &bintree{resPrismJs, map[string]*bintree{}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// configFiles are looked up in the working directory when -config is not
+// given, the first one found is loaded.
+var configFiles = []string{".gocover-html.yml", ".gocover-html.yaml", ".gocover-html.json"}
+
+// configAliases are longer names config files may use for short flags.
+var configAliases = map[string]string{
+	"profiles":   "p",
+	"output":     "o",
+	"output-dir": "o-dir",
+}
+
+// trustedOptions run commands or send credentials somewhere, a config file
+// found in the working directory may come with a cloned repository, so
+// they are only read from a file named by -config.
+var trustedOptions = map[string]bool{
+	"after-command": true,
+	"run":           true,
+	"upload":        true,
+	"token":         true,
+	"api-url":       true,
+}
+
+// configEntry is an option of a config file, with one value per use of
+// its flag.
+type configEntry struct {
+	key    string
+	values []string
+}
+
+// loadConfig sets the flags that were not given on the command line from
+// the config file name, or from the first of configFiles found if name is
+// empty. Options are named like the flags, lists set repeatable flags like
+// -p once per value.
+func loadConfig(name string) error {
+	explicit := name != ""
+	if !explicit {
+		for _, f := range configFiles {
+			if _, err := os.Stat(f); err == nil {
+				name = f
+				break
+			}
+		}
+
+		if name == "" {
+			return nil
+		}
+	}
+
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return err
+	}
+
+	var entries []configEntry
+	if strings.HasSuffix(name, ".json") {
+		entries, err = parseJSONConfig(b)
+	} else {
+		entries, err = parseYAMLConfig(b)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+
+	// Flags sharing a variable, like -threshold and -min-total, share
+	// their Value, a config option can't override either once one is
+	// given.
+	given := map[flag.Value]bool{}
+	flag.Visit(func(f *flag.Flag) { given[f.Value] = true })
+
+	for _, e := range entries {
+		key := e.key
+		if alias, ok := configAliases[key]; ok {
+			key = alias
+		}
+
+		if flag.Lookup(key) == nil || key == "config" {
+			return fmt.Errorf("%s: unknown option %q", name, e.key)
+		}
+
+		if trustedOptions[key] && !explicit {
+			return fmt.Errorf("%s: %s is only read from a config file given with -config", name, e.key)
+		}
+
+		if given[flag.Lookup(key).Value] {
+			continue
+		}
+
+		for _, v := range e.values {
+			if err := flag.Set(key, v); err != nil {
+				return fmt.Errorf("%s: %s: %v", name, e.key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseJSONConfig parses a config file holding a JSON object of strings,
+// numbers, booleans and arrays of them.
+func parseJSONConfig(b []byte) ([]configEntry, error) {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var entries []configEntry
+	for _, k := range keys {
+		items, ok := obj[k].([]interface{})
+		if !ok {
+			items = []interface{}{obj[k]}
+		}
+
+		e := configEntry{key: k}
+		for _, item := range items {
+			switch v := item.(type) {
+			case string:
+				e.values = append(e.values, v)
+			case float64:
+				e.values = append(e.values, strconv.FormatFloat(v, 'f', -1, 64))
+			case bool:
+				e.values = append(e.values, strconv.FormatBool(v))
+			default:
+				return nil, fmt.Errorf("%s: unsupported value %v", k, item)
+			}
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// parseYAMLConfig parses the subset of YAML config files need: a mapping
+// of options to scalars, to [a, b] flow lists or to block lists of
+// "- item" lines, and # comments.
+func parseYAMLConfig(b []byte) ([]configEntry, error) {
+	var entries []configEntry
+	list := -1
+
+	for i, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimRight(stripComment(line), " \t\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed == "---" {
+			continue
+		}
+
+		if trimmed == "-" || strings.HasPrefix(trimmed, "- ") {
+			if list < 0 {
+				return nil, fmt.Errorf("line %d: list item without an option", i+1)
+			}
+
+			v, err := yamlScalar(strings.TrimSpace(trimmed[1:]))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", i+1, err)
+			}
+
+			entries[list].values = append(entries[list].values, v)
+			continue
+		}
+
+		if line[0] == ' ' || line[0] == '\t' {
+			return nil, fmt.Errorf("line %d: nested options are not supported", i+1)
+		}
+
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected option: value", i+1)
+		}
+
+		e := configEntry{key: strings.TrimSpace(key)}
+		value = strings.TrimSpace(value)
+		list = -1
+
+		switch {
+		case value == "":
+			list = len(entries)
+		case strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]"):
+			inner := strings.TrimSpace(value[1 : len(value)-1])
+			if inner == "" {
+				break
+			}
+
+			for _, item := range strings.Split(inner, ",") {
+				v, err := yamlScalar(strings.TrimSpace(item))
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %v", i+1, err)
+				}
+
+				e.values = append(e.values, v)
+			}
+		default:
+			v, err := yamlScalar(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", i+1, err)
+			}
+
+			e.values = []string{v}
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// stripComment removes a # comment from a YAML line, leaving # signs in
+// quoted strings and inside values alone.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+// yamlScalar returns the value of a plain, single or double quoted YAML
+// scalar.
+func yamlScalar(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return strconv.Unquote(s)
+	}
+
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	}
+
+	return s, nil
+}
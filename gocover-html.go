@@ -2,21 +2,372 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aronluigi/gocover-html/pkg/report"
 )
 
 func main() {
-	profile := flag.String("p", "", "Path to profile file.")
-	out := flag.String("o", "", "HTML export file.")
+	// gocover-html publish posts the report to a pull or merge request
+	// instead of writing it, it takes the same flags.
+	publish := len(os.Args) > 1 && os.Args[1] == "publish"
+	if publish {
+		os.Args = append(os.Args[:1], os.Args[2:]...)
+	}
+
+	var profiles profileFlag
+	flag.Var(&profiles, "p", "Path to profile file (- for stdin), or a comma-separated list of profiles or globs to merge. May be repeated;\n"+
+		"prefix with label= to show the labelled profiles side by side.")
+	out := flag.String("o", "", "Export file, - for stdout.")
+	outDir := flag.String("o-dir", "", "Write the HTML report to this directory as an index page with a page per package and file.")
+	run := flag.String("run", "", "Run go test on these space-separated packages, e.g. ./..., and report their coverage. Arguments after -- are passed to go test.")
+	coverMode := flag.String("covermode", "", "Coverage mode of the tests run by -run: set, count or atomic.")
+	tags := flag.String("tags", "", "Build tags of the tests run by -run.")
+	race := flag.Bool("race", false, "Run the tests of -run with the race detector.")
+	serve := flag.String("serve", "", "Serve the HTML report on this address, e.g. :8080, reloading it when the profiles or sources change.")
+	format := flag.String("format", "html", "Output format: html, json, text, csv, lcov, cobertura, sarif, uncovered-funcs or annotated-diff.\n"+
+		"sarif lists uncovered code for code review tools, e.g. GitHub code scanning or reviewdog -f=sarif.")
+	funcs := flag.String("funcs", "all", "Functions listed by -format uncovered-funcs: all, exported or unexported.")
+	bars := flag.Bool("bars", false, "Draw coverage bars in text output.")
+	color := flag.String("color", "auto", "Color text output by -badge-low and -badge-high: "+strings.Join(report.ColorModes, ", ")+".")
+	afterCommand := flag.String("after-command", "", "Shell command to run after the report is written, the report path is in $"+report.OutputEnv+".")
+	var maxFileSize sizeFlag
+	flag.Var(&maxFileSize, "max-file-size", "Leave the source of files larger than this out of HTML reports, e.g. 1M; 0 disables.")
+	jobs := flag.Int("jobs", 0, "Number of files to load and render concurrently, 0 uses GOMAXPROCS.")
+	timeout := flag.Duration("timeout", 2*time.Minute, "Timeout for external commands, 0 disables.")
+	excludeFunc := flag.String("exclude-func", "", "Exclude functions whose name (Type.Method for methods) matches this regexp, e.g. ^init$.")
+	include := flag.String("include", "", "Only report files whose import path matches this regexp.")
+	exclude := flag.String("exclude", "", "Leave out files whose import path matches this regexp, e.g. /mocks/|\\.pb\\.go$.")
+	includeGenerated := flag.Bool("include-generated", false, "Report files marked with a \"Code generated ... DO NOT EDIT.\" comment.")
+	assetList := flag.String("assets", strings.Join(report.AssetGroups, ","), "Comma-separated asset groups to inline in HTML output.")
+	threshold := flag.Float64("threshold", 0, "Fail if total coverage is below this percentage (0 disables).")
+	flag.Float64Var(threshold, "min-total", 0, "Same as -threshold.")
+	minFile := flag.Float64("min-file", 0, "Fail if any file's coverage is below this percentage (0 disables).")
+	diff := flag.String("diff", "", "Also report the coverage of the lines changed since this git ref, e.g. origin/main.")
+	compare := flag.String("compare", "", "Compare with this baseline profile, or comma-separated profiles, showing the coverage lost and gained per file.\n"+
+		"With -compare old.out the profile to compare may be given as argument instead of with -p.")
+	minDiff := flag.Float64("min-diff", 0, "Fail if the coverage of the lines changed since -diff is below this percentage (0 disables).")
+	history := flag.String("history", "", "Append the total and package coverage of every run to this JSON file and chart it in the HTML report.")
+	archive := flag.String("archive", "", "Also bundle the HTML report into this zip file.")
+	upload := flag.String("upload", "", "Upload the HTML report, or the -archive, to s3://bucket/prefix with the AWS_* credentials of the environment,\n"+
+		"or PUT it to an HTTP URL with the Authorization header $"+report.UploadAuthEnv+".")
+	badge := flag.String("badge", "", "Also write an SVG coverage badge to this file.")
+	badgeLow := flag.Float64("badge-low", 50, "Coverage percentage at and below which the badge is red.")
+	badgeHigh := flag.Float64("badge-high", 80, "Coverage percentage from which the badge is green, it shades through yellow in between.")
+	precision := flag.Int("precision", 2, "Number of decimals of the coverage percentages of the report, the badge and gate messages.")
+	checkOnly := flag.Bool("check-only", false, "Only check the coverage gates, don't write a report.")
+	maxUncovered := flag.Int64("max-uncovered", -1, "Fail if more than N statements are uncovered (-1 disables).")
+	failZero := flag.Bool("fail-zero-packages", false, "Fail if any package has 0% coverage.")
+	overlap := flag.Bool("overlap", false, "Compare the statements covered by two labelled profile sets.")
+	fetchDeps := flag.Bool("fetch-deps", false, "Run go mod download when the source of a file can't be found.")
+	collapsed := flag.Bool("collapsed", false, "Collapse file sources in HTML output until expanded.")
+	patch := flag.String("patch", "", "Unified diff to annotate with -format annotated-diff.")
+	minPackage := flag.Float64("min-package", 0, "Minimum coverage of every package without its own "+report.FloorFile+".")
+	overview := flag.Bool("overview", false, "Write an HTML overview of the coverage numbers without any source.")
+	resDir := flag.String("res-dir", "", "Directory with assets replacing the embedded ones of the same name, e.g. index.html.")
+	customCSS := flag.String("custom-css", "", "Stylesheet to add to the HTML report, it can set the --gocover-* color variables.")
+	sortBy := flag.String("sort", "", "Order of the files: "+strings.Join(report.SortOrders, ", ")+"; profile order by default.")
+	theme := flag.String("theme", "light", "Default theme of the HTML report: "+strings.Join(report.Themes, ", ")+".")
+	var modDirs modDirFlag
+	flag.Var(&modDirs, "mod-dir", "Read the source of a module from a directory as module=dir, may be repeated.")
+	skipMissing := flag.Bool("skip-missing", false, "Report files whose source can't be found without source instead of failing.")
+	trimPrefix := flag.String("trim-prefix", "", "Remove this prefix from the file names of the profiles before looking up their source.")
+	var srcMap srcMapFlag
+	flag.Var(&srcMap, "src-map", "Rewrite profile file names starting with old to start with new as old=new, may be repeated.\n"+
+		"For profiles recorded on another machine or in a container.")
+	provider := flag.String("provider", "", "publish: Code host, "+strings.Join(report.Providers, " or ")+"; detected in GitHub Actions and GitLab CI.")
+	token := flag.String("token", "", "publish: API token, $GITHUB_TOKEN or $GITLAB_TOKEN by default.")
+	repo := flag.String("repo", "", "publish: Repository as owner/name, or GitLab project ID or path; taken from the CI environment by default.")
+	pr := flag.String("pr", "", "publish: Number of the pull or merge request to comment on; taken from the CI environment by default.")
+	commit := flag.String("commit", "", "publish: Commit to annotate, by default the pull request head or HEAD.")
+	apiURL := flag.String("api-url", "", "publish: Base URL of the GitHub or GitLab API for self-hosted instances.")
+	var meta metaFlag
+	flag.Var(&meta, "meta", "Report metadata as key=value, may be repeated.")
+	config := flag.String("config", "", "Config file setting the flags not given on the command line, by default the first of "+strings.Join(configFiles, ", ")+" found.")
 	flag.Parse()
 
-	if *profile == "" {
+	if err := loadConfig(*config); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// gocover-html -compare old.out new.out
+	if *compare != "" && len(profiles) == 0 && *run == "" && flag.NArg() == 1 {
+		if err := profiles.Set(flag.Arg(0)); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	} else if flag.NArg() > 0 && *run == "" {
+		fmt.Fprintln(os.Stderr, "arguments after the flags are only passed to go test with -run")
+		os.Exit(1)
+	}
+
+	if len(profiles) == 0 && *run == "" {
 		flag.PrintDefaults()
 		os.Exit(1)
 	}
 
-	err := htmlOutput(*profile, *out)
+	assets, err := report.ParseAssets(*assetList)
 	if err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
+
+	switch *funcs {
+	case "all", "exported", "unexported":
+	default:
+		fmt.Fprintf(os.Stderr, "invalid -funcs %q\n", *funcs)
+		os.Exit(1)
+	}
+
+	switch *color {
+	case "auto", "always", "never":
+	default:
+		fmt.Fprintf(os.Stderr, "invalid -color %q\n", *color)
+		os.Exit(1)
+	}
+
+	switch *sortBy {
+	case "", "name", "coverage", "uncovered", "size":
+	default:
+		fmt.Fprintf(os.Stderr, "invalid -sort %q\n", *sortBy)
+		os.Exit(1)
+	}
+
+	switch *theme {
+	case "light", "dark", "auto":
+	default:
+		fmt.Fprintf(os.Stderr, "invalid -theme %q\n", *theme)
+		os.Exit(1)
+	}
+
+	if *precision < 0 {
+		fmt.Fprintf(os.Stderr, "invalid -precision %d\n", *precision)
+		os.Exit(1)
+	}
+
+	switch *provider {
+	case "", "github", "gitlab":
+	default:
+		fmt.Fprintf(os.Stderr, "invalid -provider %q\n", *provider)
+		os.Exit(1)
+	}
+
+	if publish && *serve != "" {
+		fmt.Fprintln(os.Stderr, "publish can't be combined with -serve")
+		os.Exit(1)
+	}
+
+	if *outDir != "" && (*format != "html" || *out != "") {
+		fmt.Fprintln(os.Stderr, "-o-dir only writes HTML reports and can't be combined with -o")
+		os.Exit(1)
+	}
+
+	if (*archive != "" || *upload != "") && (*format != "html" || *out == "-" || *serve != "" || publish) {
+		fmt.Fprintln(os.Stderr, "-archive and -upload only ship HTML reports written to a file")
+		os.Exit(1)
+	}
+
+	if *resDir != "" {
+		fi, err := os.Stat(*resDir)
+		if err == nil && !fi.IsDir() {
+			err = fmt.Errorf("-res-dir %s is not a directory", *resDir)
+		}
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	var excludeRe, includeFileRe, excludeFileRe *regexp.Regexp
+	for _, re := range []struct {
+		dst  **regexp.Regexp
+		expr string
+	}{
+		{&excludeRe, *excludeFunc},
+		{&includeFileRe, *include},
+		{&excludeFileRe, *exclude},
+	} {
+		if re.expr == "" {
+			continue
+		}
+
+		*re.dst, err = regexp.Compile(re.expr)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	opts := report.Options{
+		Profiles:         profiles,
+		Outfile:          *out,
+		OutDir:           *outDir,
+		Meta:             meta,
+		Format:           *format,
+		Bars:             *bars,
+		Color:            *color,
+		Assets:           assets,
+		AfterCommand:     *afterCommand,
+		Timeout:          *timeout,
+		Jobs:             *jobs,
+		MaxFileSize:      int64(maxFileSize),
+		ExcludeFunc:      excludeRe,
+		Include:          includeFileRe,
+		Exclude:          excludeFileRe,
+		IncludeGenerated: *includeGenerated,
+		FailZeroPackages: *failZero,
+		Overlap:          *overlap,
+		Funcs:            *funcs,
+		FetchDeps:        *fetchDeps,
+		ModuleDirs:       modDirs,
+		SkipMissing:      *skipMissing,
+		Warnings:         os.Stderr,
+		TrimPrefix:       *trimPrefix,
+		SrcMap:           srcMap,
+		Collapsed:        *collapsed,
+		Patch:            *patch,
+		MinPackage:       *minPackage,
+		Overview:         *overview,
+		Threshold:        *threshold,
+		MinFile:          *minFile,
+		Diff:             *diff,
+		Compare:          splitList(*compare),
+		MinDiff:          *minDiff,
+		CheckOnly:        *checkOnly,
+		Archive:          *archive,
+		Upload:           *upload,
+		Badge:            *badge,
+		History:          *history,
+		BadgeLow:         *badgeLow,
+		BadgeHigh:        *badgeHigh,
+		Precision:        precision,
+		Theme:            *theme,
+		Sort:             *sortBy,
+		CustomCSS:        *customCSS,
+		ResDir:           *resDir,
+	}
+
+	if *maxUncovered >= 0 {
+		opts.MaxUncovered = maxUncovered
+	}
+
+	var profile string
+	var testErr error
+	if *run != "" {
+		var testArgs []string
+		if *coverMode != "" {
+			testArgs = append(testArgs, "-covermode="+*coverMode)
+		}
+
+		if *tags != "" {
+			testArgs = append(testArgs, "-tags="+*tags)
+		}
+
+		if *race {
+			testArgs = append(testArgs, "-race")
+		}
+
+		profile, testErr = report.RunTests(strings.Fields(*run), append(testArgs, flag.Args()...))
+		if profile == "" {
+			fmt.Fprintln(os.Stderr, "gocover-html:", testErr)
+			os.Exit(exitCode(testErr))
+		}
+
+		opts.Profiles = addProfile(opts.Profiles, profile)
+	}
+
+	var target *report.PublishTarget
+	if publish {
+		target = &report.PublishTarget{
+			Provider: *provider,
+			Token:    *token,
+			Repo:     *repo,
+			Request:  *pr,
+			Commit:   *commit,
+			APIURL:   *apiURL,
+		}
+	}
+
+	code := writeReport(opts, *serve, target)
+	if profile != "" {
+		os.Remove(profile)
+	}
+
+	// Failing tests still get their report, but fail the run.
+	if testErr != nil {
+		fmt.Fprintln(os.Stderr, "gocover-html: tests failed:", testErr)
+		if code == 0 {
+			code = exitCode(testErr)
+		}
+	}
+
+	os.Exit(code)
+}
+
+// writeReport writes the report, serves it if serve is set or publishes
+// it to target if that is set, and returns the exit status of the tool.
+func writeReport(opts report.Options, serve string, target *report.PublishTarget) int {
+	if serve != "" {
+		err := report.Serve(serve, opts)
+		fmt.Fprintln(os.Stderr, "gocover-html:", err)
+		return 1
+	}
+
+	report.CatchInterrupts()
+
+	var err error
+	if target != nil {
+		err = report.Publish(opts, *target)
+	} else {
+		err = report.Run(opts)
+	}
+
+	if err == report.ErrInterrupted {
+		fmt.Fprintln(os.Stderr, err)
+		return 130
+	}
+
+	if err != nil {
+		switch err.(type) {
+		case *report.CoverageError:
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		case *report.CommandError:
+			fmt.Fprintln(os.Stderr, "after-command", err)
+			return exitCode(err)
+		}
+
+		fmt.Fprintln(os.Stderr, "gocover-html:", err)
+		return 1
+	}
+
+	return 0
+}
+
+// exitCode returns the exit status for err, that of the failed command for
+// a *report.CommandError.
+func exitCode(err error) int {
+	if e, ok := err.(*report.CommandError); ok {
+		return e.ExitCode()
+	}
+
+	return 1
+}
+
+// addProfile adds the profile at path to the unlabelled profile set.
+func addProfile(sets []*report.ProfileSet, path string) []*report.ProfileSet {
+	for _, s := range sets {
+		if s.Label == "" {
+			s.Paths = append(s.Paths, path)
+			return sets
+		}
+	}
+
+	return append([]*report.ProfileSet{{Paths: []string{path}}}, sets...)
 }
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aronluigi/gocover-html/pkg/report"
+)
+
+// metaFlag collects repeated -meta key=value flags in the order given.
+type metaFlag []report.MetaEntry
+
+func (m *metaFlag) String() string {
+	s := make([]string, len(*m))
+	for k, v := range *m {
+		s[k] = v.Key + "=" + v.Value
+	}
+
+	return strings.Join(s, ",")
+}
+
+func (m *metaFlag) Set(v string) error {
+	i := strings.Index(v, "=")
+	if i <= 0 {
+		return fmt.Errorf("invalid metadata %q, expected key=value", v)
+	}
+
+	*m = append(*m, report.MetaEntry{Key: v[:i], Value: v[i+1:]})
+	return nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aronluigi/gocover-html/pkg/report"
+)
+
+// modDirFlag collects repeated -mod-dir module=dir flags.
+type modDirFlag []report.ModuleDir
+
+func (m *modDirFlag) String() string {
+	s := make([]string, len(*m))
+	for k, v := range *m {
+		s[k] = v.Path + "=" + v.Dir
+	}
+
+	return strings.Join(s, ",")
+}
+
+func (m *modDirFlag) Set(v string) error {
+	i := strings.Index(v, "=")
+	if i <= 0 || i == len(v)-1 {
+		return fmt.Errorf("invalid module directory %q, expected module=dir", v)
+	}
+
+	*m = append(*m, report.ModuleDir{Path: strings.TrimSuffix(v[:i], "/"), Dir: v[i+1:]})
+	return nil
+}
+
+// srcMapFlag collects repeated -src-map old=new flags.
+type srcMapFlag []report.PathMap
+
+func (m *srcMapFlag) String() string {
+	s := make([]string, len(*m))
+	for k, v := range *m {
+		s[k] = v.Old + "=" + v.New
+	}
+
+	return strings.Join(s, ",")
+}
+
+func (m *srcMapFlag) Set(v string) error {
+	i := strings.Index(v, "=")
+	if i <= 0 {
+		return fmt.Errorf("invalid source mapping %q, expected old=new", v)
+	}
+
+	*m = append(*m, report.PathMap{Old: v[:i], New: v[i+1:]})
+	return nil
+}
@@ -0,0 +1,92 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+// OutputEnv is the environment variable holding the path of the written
+// report when the -after-command runs.
+const OutputEnv = "GOCOVER_HTML_OUTPUT"
+
+// CommandError is returned when an external command run by the tool fails.
+type CommandError struct {
+	command string
+	err     error
+}
+
+func (e *CommandError) Error() string {
+	return fmt.Sprintf("%q: %v", e.command, e.err)
+}
+
+// ExitCode returns the exit status of the failed command, or 1 if it did
+// not exit normally.
+func (e *CommandError) ExitCode() int {
+	if ee, ok := e.err.(*exec.ExitError); ok && ee.ExitCode() > 0 {
+		return ee.ExitCode()
+	}
+
+	return 1
+}
+
+// runAfterCommand runs command through the system shell once the report
+// has been written to output, passing the absolute report path in the
+// GOCOVER_HTML_OUTPUT environment variable, empty for reports written to
+// stdout. Its output goes to stderr, stdout may carry the report. The
+// command is killed if it runs longer than timeout; a zero timeout means
+// no limit.
+//
+// The command is executed verbatim with the privileges of the tool, so it
+// must only ever come from trusted configuration.
+func runAfterCommand(command, output string, timeout time.Duration) error {
+	if command == "" {
+		return nil
+	}
+
+	if output == stdoutName {
+		output = ""
+	}
+
+	if output != "" {
+		abs, err := filepath.Abs(output)
+		if err != nil {
+			return err
+		}
+
+		output = abs
+	}
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/c", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	cmd.Env = append(os.Environ(), OutputEnv+"="+output)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %v", timeout)
+	}
+
+	if err != nil {
+		return &CommandError{command: command, err: err}
+	}
+
+	return nil
+}
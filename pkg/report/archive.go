@@ -0,0 +1,145 @@
+package report
+
+import (
+	"archive/zip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// shipReport archives the written HTML report to opts.Archive and uploads
+// it to opts.Upload, the archive if there is one and the report files
+// otherwise. The report is the directory of pages dir, or the single page
+// file if dir is empty.
+func shipReport(dir, file string, opts Options) error {
+	if opts.Archive != "" {
+		err := writeArchive(opts.Archive, dir, file)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.Upload == "" {
+		return nil
+	}
+
+	files := map[string]string{filepath.Base(opts.Archive): opts.Archive}
+	if opts.Archive == "" {
+		var err error
+		files, err = reportFiles(dir, file)
+		if err != nil {
+			return err
+		}
+	}
+
+	return uploadFiles(opts.Upload, files, opts.Timeout)
+}
+
+// reportFiles returns the files of the report in dir by slash separated
+// path relative to it, or the single page file as index.html.
+func reportFiles(dir, file string) (map[string]string, error) {
+	if dir == "" {
+		return map[string]string{"index.html": file}, nil
+	}
+
+	files := map[string]string{}
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(rel)] = path
+		return nil
+	})
+
+	return files, err
+}
+
+// writeArchive writes the report to the zip file name, pages keep their
+// paths so the extracted archive is browsable from index.html.
+func writeArchive(name, dir, file string) error {
+	files, err := reportFiles(dir, file)
+	if err != nil {
+		return err
+	}
+
+	// An archive inside the report directory, left by an earlier run,
+	// would be read while it is written.
+	if abs, err := filepath.Abs(name); err == nil {
+		for rel, path := range files {
+			if p, err := filepath.Abs(path); err == nil && p == abs {
+				delete(files, rel)
+			}
+		}
+	}
+
+	out, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(out)
+	for _, rel := range sortedKeys(files) {
+		err = addToArchive(zw, rel, files[rel])
+		if err != nil {
+			break
+		}
+	}
+
+	if cerr := zw.Close(); err == nil {
+		err = cerr
+	}
+
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+// addToArchive compresses the file at path into zw as name.
+func addToArchive(zw *zip.Writer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	h, err := zip.FileInfoHeader(fi)
+	if err != nil {
+		return err
+	}
+
+	h.Name = name
+	h.Method = zip.Deflate
+
+	w, err := zw.CreateHeader(h)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
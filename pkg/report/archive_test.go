@@ -0,0 +1,38 @@
+package report
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestWriteArchiveInReportDir(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "report.zip")
+	for _, f := range []string{"index.html", "report.zip"} {
+		if err := os.WriteFile(filepath.Join(dir, f), []byte(f), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := writeArchive(name, dir, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.OpenReader(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer zr.Close()
+
+	var got []string
+	for _, f := range zr.File {
+		got = append(got, f.Name)
+	}
+
+	if want := []string{"index.html"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("archive holds %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,49 @@
+package report
+
+import (
+	"embed"
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// resources holds the report template and the stylesheets and scripts
+// inlined into it, so the binary works from any working directory.
+//
+//go:embed res/*
+var resources embed.FS
+
+// assetFiles are the files under res/ of every asset group, which
+// multi-page reports write next to their pages instead of inlining them.
+var assetFiles = map[string][]string{
+	"bootstrap": {"bootstrap.min.css", "jquery-3.2.1.slim.min.js", "popper.min.js", "bootstrap.min.js"},
+	"prism":     {"prism.css", "prism.js"},
+}
+
+// resourceFS returns the report resources. Files found in dir, if it is
+// set, replace the embedded file of the same name under res/, so single
+// assets can be customized without copying all of them.
+func resourceFS(dir string) fs.FS {
+	if dir == "" {
+		return resources
+	}
+
+	return overlayFS{dir: os.DirFS(dir)}
+}
+
+// overlayFS serves res/ files from dir before the embedded resources.
+type overlayFS struct {
+	dir fs.FS
+}
+
+func (o overlayFS) Open(name string) (fs.File, error) {
+	if rel := strings.TrimPrefix(name, "res/"); rel != name {
+		f, err := o.dir.Open(rel)
+		if err == nil || !errors.Is(err, fs.ErrNotExist) {
+			return f, err
+		}
+	}
+
+	return resources.Open(name)
+}
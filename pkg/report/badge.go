@@ -0,0 +1,101 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+)
+
+// badgeLabel is the text on the left side of the coverage badge.
+const badgeLabel = "coverage"
+
+// badgeTemplate is a flat shields.io style badge. It is filled in with the
+// total width, the label width, the value width, the badge color, the
+// label and value centers and the label and value themselves.
+const badgeTemplate = `<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="20" role="img" aria-label="%[7]s: %[8]s">
+<title>%[7]s: %[8]s</title>
+<linearGradient id="s" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>
+<clipPath id="r"><rect width="%[1]d" height="20" rx="3" fill="#fff"/></clipPath>
+<g clip-path="url(#r)"><rect width="%[2]d" height="20" fill="#555"/><rect x="%[2]d" width="%[3]d" height="20" fill="%[4]s"/><rect width="%[1]d" height="20" fill="url(#s)"/></g>
+<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">
+<text x="%[5]d" y="15" fill="#010101" fill-opacity=".3">%[7]s</text><text x="%[5]d" y="14">%[7]s</text>
+<text x="%[6]d" y="15" fill="#010101" fill-opacity=".3">%[8]s</text><text x="%[6]d" y="14">%[8]s</text>
+</g>
+</svg>
+`
+
+// badgeTextWidth estimates the width in pixels of s in 11px Verdana.
+func badgeTextWidth(s string) int {
+	return int(math.Ceil(float64(len(s))*7)) + 10
+}
+
+// badgeColor returns the badge color for a coverage percentage: red up to
+// low, green from high, and shading from red over yellow to green in
+// between.
+func badgeColor(cov, low, high float64) string {
+	t := 1.0
+	if high > low {
+		t = math.Max(0, math.Min(1, (cov-low)/(high-low)))
+	} else if cov < high {
+		t = 0
+	}
+
+	// Interpolate the hue from red (0) to green (120) at constant
+	// saturation and lightness.
+	r, g, b := hslToRGB(t*120, 0.75, 0.42)
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// hslToRGB converts a color given by hue in degrees, saturation and
+// lightness in [0, 1] to RGB.
+func hslToRGB(h, s, l float64) (r, g, b uint8) {
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var rf, gf, bf float64
+	switch {
+	case h < 60:
+		rf, gf = c, x
+	case h < 120:
+		rf, gf = x, c
+	default:
+		gf, bf = c, x
+	}
+
+	to := func(v float64) uint8 { return uint8(math.Round((v + m) * 255)) }
+	return to(rf), to(gf), to(bf)
+}
+
+// writeBadge writes an SVG badge showing the coverage percentage to w,
+// with opts.Precision decimals and colored by opts.BadgeLow and
+// opts.BadgeHigh.
+func writeBadge(w io.Writer, cov float64, opts Options) error {
+	value := formatCoverage(cov, *opts.Precision) + "%"
+	lw, vw := badgeTextWidth(badgeLabel), badgeTextWidth(value)
+
+	color := badgeColor(cov, opts.BadgeLow, opts.BadgeHigh)
+	_, err := fmt.Fprintf(w, badgeTemplate, lw+vw, lw, vw, color, lw/2, lw+vw/2, badgeLabel, value)
+	return err
+}
+
+// writeBadgeFile writes the coverage badge of the report to opts.Badge,
+// if it is set.
+func writeBadgeFile(d *templateData, opts Options) error {
+	if opts.Badge == "" {
+		return nil
+	}
+
+	out, err := os.Create(opts.Badge)
+	if err != nil {
+		return err
+	}
+
+	err = writeBadge(out, totalCoverage(d), opts)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
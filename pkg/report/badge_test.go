@@ -0,0 +1,40 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestWriteBadgeWidth(t *testing.T) {
+	precision := 1
+	opts := withDefaults(Options{Precision: &precision})
+	lw := badgeTextWidth(badgeLabel)
+
+	for _, tt := range []struct {
+		cov   float64
+		value string
+	}{
+		{7.2, "7.2%"},
+		{100, "100.0%"},
+	} {
+		var b bytes.Buffer
+		if err := writeBadge(&b, tt.cov, opts); err != nil {
+			t.Fatal(err)
+		}
+
+		// The value is 7 pixels per character wide plus 10 of padding.
+		vw := 7*len(tt.value) + 10
+		svg := b.String()
+		for _, want := range []string{
+			fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d"`, lw+vw),
+			fmt.Sprintf(`<rect x="%d" width="%d"`, lw, vw),
+			fmt.Sprintf(`<text x="%d" y="14">%s</text>`, lw+vw/2, tt.value),
+		} {
+			if !strings.Contains(svg, want) {
+				t.Errorf("badge of %v%% does not contain %q:\n%s", tt.cov, want, svg)
+			}
+		}
+	}
+}
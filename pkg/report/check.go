@@ -0,0 +1,166 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CoverageError is returned when a report was generated successfully but
+// fails one of the configured coverage gates.
+type CoverageError struct {
+	msg string
+}
+
+func (e *CoverageError) Error() string {
+	return e.msg
+}
+
+// checkGates runs every coverage gate enabled in opts against the report
+// and returns the first failure. Partial reports never pass.
+func checkGates(d *templateData, opts Options) error {
+	if d.Partial {
+		return ErrInterrupted
+	}
+
+	err := checkThreshold(d, opts.Threshold)
+	if err != nil {
+		return err
+	}
+
+	err = checkMinDiff(d, opts.MinDiff)
+	if err != nil {
+		return err
+	}
+
+	err = checkMinFile(d, opts.MinFile)
+	if err != nil {
+		return err
+	}
+
+	err = checkMaxUncovered(d, opts.MaxUncovered)
+	if err != nil {
+		return err
+	}
+
+	if opts.FailZeroPackages {
+		err = checkZeroPackages(d)
+		if err != nil {
+			return err
+		}
+	}
+
+	return checkPackageFloors(d)
+}
+
+// checkThreshold fails when the total coverage of the report is below
+// threshold percent. A zero threshold disables the check.
+func checkThreshold(d *templateData, threshold float64) error {
+	if threshold <= 0 {
+		return nil
+	}
+
+	cov := totalCoverage(d)
+	if cov < threshold {
+		return &CoverageError{
+			msg: fmt.Sprintf("coverage %s%% is below threshold %s%%", formatCoverage(cov, d.precision), formatCoverage(threshold, d.precision)),
+		}
+	}
+
+	return nil
+}
+
+// checkMinFile fails when the coverage of any file with statements is
+// below min percent, listing all of them. A zero min disables the check.
+func checkMinFile(d *templateData, min float64) error {
+	if min <= 0 {
+		return nil
+	}
+
+	var low []string
+	for _, f := range d.Files {
+		if f.Statements > 0 && f.Coverage < min {
+			low = append(low, fmt.Sprintf("%s: %s%%", f.Name, formatCoverage(f.Coverage, d.precision)))
+		}
+	}
+
+	if len(low) > 0 {
+		return &CoverageError{
+			msg: fmt.Sprintf("files below %s%% coverage:\n\t", formatCoverage(min, d.precision)) + strings.Join(low, "\n\t"),
+		}
+	}
+
+	return nil
+}
+
+// checkOutput builds the report of the profile sets in opts.Profiles and
+// only checks the coverage gates, without writing a report. The badge is
+// still written if requested.
+func checkOutput(opts Options) error {
+	d, _, err := loadReports(opts)
+	if err != nil {
+		return err
+	}
+
+	releaseInterrupts()
+
+	err = recordHistory(d, opts)
+	if err != nil {
+		return err
+	}
+
+	err = writeBadgeFile(d, opts)
+	if err != nil {
+		return err
+	}
+
+	return checkGates(d, opts)
+}
+
+// uncoveredStatements returns the number of statements not covered by the
+// test run across all files of the report.
+func uncoveredStatements(d *templateData) int64 {
+	var n int64
+
+	for _, f := range d.Files {
+		n += f.Statements - f.Covered
+	}
+
+	return n
+}
+
+// checkMaxUncovered fails when the report contains more than max uncovered
+// statements. A nil max disables the check.
+func checkMaxUncovered(d *templateData, max *int64) error {
+	if max == nil {
+		return nil
+	}
+
+	n := uncoveredStatements(d)
+	if n > *max {
+		return &CoverageError{
+			msg: fmt.Sprintf("%d uncovered statements exceed maximum of %d", n, *max),
+		}
+	}
+
+	return nil
+}
+
+// checkZeroPackages fails when any package with statements has none of
+// them covered, which usually means its tests did not run at all.
+func checkZeroPackages(d *templateData) error {
+	var zero []string
+
+	for _, p := range groupPackages(d.Files) {
+		if p.Statements > 0 && p.Covered == 0 {
+			zero = append(zero, p.Path)
+		}
+	}
+
+	if len(zero) > 0 {
+		return &CoverageError{
+			msg: "packages with 0% coverage:\n\t" + strings.Join(zero, "\n\t"),
+		}
+	}
+
+	return nil
+}
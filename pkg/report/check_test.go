@@ -0,0 +1,39 @@
+package report
+
+import "testing"
+
+func TestCheckThreshold(t *testing.T) {
+	d := &templateData{Files: []*templateFile{
+		{Name: "example.com/p/a.go", Covered: 3, Statements: 4},
+		{Name: "example.com/p/b.go", Covered: 0, Statements: 4},
+	}, precision: 2}
+
+	tests := []struct {
+		threshold float64
+		fail      bool
+	}{
+		{0, false},
+		{30, false},
+		{37.5, false},
+		{37.51, true},
+		{90, true},
+	}
+
+	for _, tt := range tests {
+		err := checkThreshold(d, tt.threshold)
+		if _, ok := err.(*CoverageError); ok != tt.fail || (err != nil && !ok) {
+			t.Errorf("checkThreshold() with threshold %v = %v, want failure %v", tt.threshold, err, tt.fail)
+		}
+	}
+
+	want := "coverage 37.50% is below threshold 90.00%"
+	if err := checkThreshold(d, 90); err == nil || err.Error() != want {
+		t.Errorf("checkThreshold() = %v, want %q", err, want)
+	}
+
+	d.precision = 1
+	want = "coverage 37.5% is below threshold 90.0%"
+	if err := checkThreshold(d, 90); err == nil || err.Error() != want {
+		t.Errorf("checkThreshold() with precision 1 = %v, want %q", err, want)
+	}
+}
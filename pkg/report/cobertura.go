@@ -0,0 +1,158 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// coberturaDTD is the document type of Cobertura coverage reports.
+const coberturaDTD = `<!DOCTYPE coverage SYSTEM "http://cobertura.sourceforge.net/xml/coverage-04.dtd">`
+
+type coberturaCoverage struct {
+	XMLName         xml.Name           `xml:"coverage"`
+	LineRate        float64            `xml:"line-rate,attr"`
+	BranchRate      float64            `xml:"branch-rate,attr"`
+	LinesCovered    int                `xml:"lines-covered,attr"`
+	LinesValid      int                `xml:"lines-valid,attr"`
+	BranchesCovered int                `xml:"branches-covered,attr"`
+	BranchesValid   int                `xml:"branches-valid,attr"`
+	Complexity      int                `xml:"complexity,attr"`
+	Version         string             `xml:"version,attr"`
+	Timestamp       int64              `xml:"timestamp,attr"`
+	Sources         []string           `xml:"sources>source"`
+	Packages        []coberturaPackage `xml:"packages>package"`
+}
+
+type coberturaPackage struct {
+	Name       string           `xml:"name,attr"`
+	LineRate   float64          `xml:"line-rate,attr"`
+	BranchRate float64          `xml:"branch-rate,attr"`
+	Complexity int              `xml:"complexity,attr"`
+	Classes    []coberturaClass `xml:"classes>class"`
+}
+
+type coberturaClass struct {
+	Name       string          `xml:"name,attr"`
+	Filename   string          `xml:"filename,attr"`
+	LineRate   float64         `xml:"line-rate,attr"`
+	BranchRate float64         `xml:"branch-rate,attr"`
+	Complexity int             `xml:"complexity,attr"`
+	Methods    struct{}        `xml:"methods"`
+	Lines      []coberturaLine `xml:"lines>line"`
+}
+
+type coberturaLine struct {
+	Number            int    `xml:"number,attr"`
+	Hits              int    `xml:"hits,attr"`
+	Branch            bool   `xml:"branch,attr"`
+	ConditionCoverage string `xml:"condition-coverage,attr,omitempty"`
+}
+
+// rateCounter counts the valid and covered lines or branches of a part of
+// a Cobertura report.
+type rateCounter struct {
+	valid, covered int
+}
+
+func (r *rateCounter) add(covered bool) {
+	r.valid++
+	if covered {
+		r.covered++
+	}
+}
+
+func (r rateCounter) rate() float64 {
+	if r.valid == 0 {
+		return 1
+	}
+
+	return float64(r.covered) / float64(r.valid)
+}
+
+// coberturaOutput writes a Cobertura XML report.
+func coberturaOutput(opts Options) error {
+	return writeFormat(opts, merged(writeCobertura))
+}
+
+// writeCobertura writes the line coverage of the report as Cobertura XML,
+// with a package per Go package and a class per file. Lines shared by
+// several blocks are branch lines whose condition coverage is the share
+// of their blocks that ran.
+func writeCobertura(w io.Writer, d *templateData) error {
+	var total, totalBranches rateCounter
+
+	c := coberturaCoverage{Timestamp: time.Now().Unix()}
+	if wd, err := os.Getwd(); err == nil {
+		c.Sources = []string{wd}
+	}
+
+	for _, p := range groupPackages(d.Files) {
+		var pkgLines, pkgBranches rateCounter
+		cp := coberturaPackage{Name: p.Path}
+
+		for _, f := range p.Files {
+			var lines, branches rateCounter
+			class := coberturaClass{Name: f.Name, Filename: sourcePath(f)}
+
+			fl, err := fileLines(f)
+			if err != nil {
+				return err
+			}
+
+			for _, l := range fl {
+				cl := coberturaLine{Number: l.line, Hits: l.hits}
+				if len(l.counts) > 1 {
+					var b rateCounter
+					for _, n := range l.counts {
+						b.add(n > 0)
+						branches.add(n > 0)
+					}
+
+					cl.Branch = true
+					cl.ConditionCoverage = fmt.Sprintf("%.0f%% (%d/%d)", b.rate()*100, b.covered, b.valid)
+				}
+
+				lines.add(l.hits > 0)
+				class.Lines = append(class.Lines, cl)
+			}
+
+			class.LineRate, class.BranchRate = lines.rate(), branches.rate()
+			cp.Classes = append(cp.Classes, class)
+
+			pkgLines.valid += lines.valid
+			pkgLines.covered += lines.covered
+			pkgBranches.valid += branches.valid
+			pkgBranches.covered += branches.covered
+		}
+
+		cp.LineRate, cp.BranchRate = pkgLines.rate(), pkgBranches.rate()
+		c.Packages = append(c.Packages, cp)
+
+		total.valid += pkgLines.valid
+		total.covered += pkgLines.covered
+		totalBranches.valid += pkgBranches.valid
+		totalBranches.covered += pkgBranches.covered
+	}
+
+	c.LineRate, c.BranchRate = total.rate(), totalBranches.rate()
+	c.LinesValid, c.LinesCovered = total.valid, total.covered
+	c.BranchesValid, c.BranchesCovered = totalBranches.valid, totalBranches.covered
+
+	_, err := io.WriteString(w, xml.Header+coberturaDTD+"\n")
+	if err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	err = enc.Encode(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return err
+}
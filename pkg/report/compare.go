@@ -0,0 +1,155 @@
+package report
+
+import (
+	"os"
+	"sort"
+
+	"golang.org/x/tools/cover"
+)
+
+// baseline holds the profiles a report is compared with by file name.
+type baseline map[string]*cover.Profile
+
+// blockPos identifies a profile block by its position in the source.
+type blockPos struct {
+	startLine, startCol, endLine, endCol int
+}
+
+// loadBaseline reads the baseline profiles in opts.Compare, it returns nil
+// if there are none. They are filtered like the profiles of the report
+// with the current sources, files whose source is gone since are kept
+// as they are.
+func loadBaseline(opts Options) (baseline, error) {
+	if len(opts.Compare) == 0 {
+		return nil, nil
+	}
+
+	profiles, err := readProfiles(opts.Compare, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	b := baseline{}
+	for _, p := range profiles {
+		if excludedName(p.FileName, opts) {
+			continue
+		}
+
+		if file, err := resolveFile(p.FileName, opts); err == nil {
+			_, keep, err := filterProfile(p, file, opts)
+			if os.IsNotExist(err) {
+				keep, err = true, nil
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !keep {
+				continue
+			}
+		}
+
+		b[p.FileName] = p
+	}
+
+	return b, nil
+}
+
+// setBaseline records the coverage of the file in the baseline b and the
+// blocks that stopped or started running since, uncovered blocks new
+// since the baseline count as lost. Blocks are matched by position, so
+// edits of the file show up as coverage lost and gained.
+func (f *templateFile) setBaseline(b baseline) {
+	if b == nil {
+		return
+	}
+
+	f.Compared = true
+
+	old, ok := b[f.Name]
+	if !ok {
+		f.Added = true
+		return
+	}
+
+	f.OldCovered, f.OldStatements = statementCounts(old)
+	f.OldCoverage = percentCovered(old)
+
+	counts := map[blockPos]int{}
+	for _, ob := range old.Blocks {
+		counts[blockPos{ob.StartLine, ob.StartCol, ob.EndLine, ob.EndCol}] += ob.Count
+	}
+
+	lost := map[int]bool{}
+	for _, nb := range f.profile.Blocks {
+		oc, ok := counts[blockPos{nb.StartLine, nb.StartCol, nb.EndLine, nb.EndCol}]
+		switch {
+		case nb.Count == 0 && (oc > 0 || !ok):
+			f.Lost += int64(nb.NumStmt)
+			for l := nb.StartLine; l <= nb.EndLine; l++ {
+				lost[l] = true
+			}
+		case nb.Count > 0 && ok && oc == 0:
+			f.Gained += int64(nb.NumStmt)
+		}
+	}
+
+	for l := range lost {
+		f.lostLines = append(f.lostLines, l)
+	}
+	sort.Ints(f.lostLines)
+}
+
+// Delta returns the change of the coverage of the file since the
+// baseline in percentage points.
+func (f *templateFile) Delta() float64 {
+	return f.Coverage - f.OldCoverage
+}
+
+// setBaseline records the totals of the baseline b over the files of the
+// report and the files of b no longer in profiles, which are listed as
+// removed.
+func (d *templateData) setBaseline(b baseline, profiles []*cover.Profile) {
+	if b == nil {
+		return
+	}
+
+	d.Compared = true
+	for _, f := range d.Files {
+		d.OldCovered += f.OldCovered
+		d.OldStatements += f.OldStatements
+	}
+
+	current := map[string]bool{}
+	for _, p := range profiles {
+		current[p.FileName] = true
+	}
+
+	for name, p := range b {
+		if current[name] {
+			continue
+		}
+
+		covered, total := statementCounts(p)
+		d.OldCovered += covered
+		d.OldStatements += total
+		d.Removed = append(d.Removed, name)
+	}
+	sort.Strings(d.Removed)
+}
+
+// OldCoverage returns the total coverage of the baseline as a percentage.
+func (d *templateData) OldCoverage() float64 {
+	if d.OldStatements == 0 {
+		return 0
+	}
+
+	return float64(d.OldCovered) / float64(d.OldStatements) * 100
+}
+
+// CoverageDelta returns the change of the total coverage since the
+// baseline in percentage points.
+func (d *templateData) CoverageDelta() float64 {
+	return totalCoverage(d) - d.OldCoverage()
+}
@@ -0,0 +1,37 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// csvOutput writes one CSV row per file, followed by a total row.
+func csvOutput(opts Options) error {
+	return writeFormat(opts, merged(writeCSV))
+}
+
+// writeCSV writes the per-file statement counts and coverage of the report
+// as CSV with a header row.
+func writeCSV(w io.Writer, d *templateData) error {
+	cw := csv.NewWriter(w)
+	row := func(name string, covered, total int64, cov float64) {
+		cw.Write([]string{
+			name,
+			strconv.FormatInt(covered, 10),
+			strconv.FormatInt(total, 10),
+			formatCoverage(cov, d.precision),
+		})
+	}
+
+	cw.Write([]string{"filename", "covered", "statements", "coverage"})
+	for _, f := range d.Files {
+		row(f.Name, f.Covered, f.Statements, f.Coverage)
+	}
+
+	covered, total := fileCounts(d.Files)
+	row("total", covered, total, totalCoverage(d))
+
+	cw.Flush()
+	return cw.Error()
+}
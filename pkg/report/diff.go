@@ -0,0 +1,151 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// hunkHeader matches the header of a unified diff hunk and captures the
+// first line and the line count of the hunk in the new file.
+var hunkHeader = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,(\d+))? @@`)
+
+// lineCoverage maps source files to the execution counts of their lines.
+type lineCoverage map[string]map[int]int
+
+// getLineCoverage returns the line counts of the files of the report
+// keyed by slash separated absolute path. Files without source are left
+// out, diffs can't name them.
+func getLineCoverage(d *templateData) (lineCoverage, error) {
+	lc := lineCoverage{}
+	for _, f := range d.Files {
+		if f.Missing {
+			continue
+		}
+
+		abs, err := filepath.Abs(f.file)
+		if err != nil {
+			return nil, err
+		}
+
+		src, err := f.source()
+		if err != nil {
+			return nil, err
+		}
+
+		lc[filepath.ToSlash(abs)] = lineCounts(f.profile, src)
+	}
+
+	return lc, nil
+}
+
+// lookup returns the line counts of the file named by a diff, which is
+// relative to the working directory or the repository root.
+func (lc lineCoverage) lookup(name string) map[int]int {
+	if abs, err := filepath.Abs(name); err == nil {
+		if lines, ok := lc[filepath.ToSlash(abs)]; ok {
+			return lines
+		}
+	}
+
+	suffix := "/" + filepath.ToSlash(name)
+	for file, lines := range lc {
+		if strings.HasSuffix(file, suffix) {
+			return lines
+		}
+	}
+
+	return nil
+}
+
+// diffPath returns the file name of a "+++ " diff header line without
+// its b/ prefix, or "" for /dev/null. Names git quotes, because they hold
+// special characters, are unquoted.
+func diffPath(header string) string {
+	name := strings.TrimSpace(header[4:])
+	if i := strings.Index(name, "\t"); i >= 0 {
+		name = name[:i]
+	}
+
+	if name == "/dev/null" {
+		return ""
+	}
+
+	if strings.HasPrefix(name, `"`) {
+		if s, err := strconv.Unquote(name); err == nil {
+			name = s
+		}
+	}
+
+	return strings.TrimPrefix(name, "b/")
+}
+
+// annotateDiff copies the unified diff read from r to w, appending the
+// coverage status to every added line that holds statements.
+func annotateDiff(w io.Writer, r io.Reader, lc lineCoverage) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	bw := bufio.NewWriter(w)
+
+	var lines map[int]int
+	line := 0
+	for sc.Scan() {
+		text := sc.Text()
+
+		switch {
+		case strings.HasPrefix(text, "+++ "):
+			lines = lc.lookup(diffPath(text))
+		case strings.HasPrefix(text, "@@"):
+			if m := hunkHeader.FindStringSubmatch(text); m != nil {
+				line, _ = strconv.Atoi(m[1])
+			}
+		case strings.HasPrefix(text, "+"):
+			if c, ok := lines[line]; ok {
+				if c > 0 {
+					text += " // covered"
+				} else {
+					text += " // NOT COVERED"
+				}
+			}
+			line++
+		case strings.HasPrefix(text, " "), text == "":
+			line++
+		}
+
+		fmt.Fprintln(bw, text)
+	}
+
+	if err := sc.Err(); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// annotatedDiffOutput reads the unified diff in opts.Patch and writes it
+// annotated with coverage.
+func annotatedDiffOutput(opts Options) error {
+	if opts.Patch == "" {
+		return fmt.Errorf("annotated-diff needs a diff given with -patch")
+	}
+
+	return writeFormat(opts, merged(func(w io.Writer, d *templateData) error {
+		lc, err := getLineCoverage(d)
+		if err != nil {
+			return err
+		}
+
+		in, err := os.Open(opts.Patch)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		return annotateDiff(w, in, lc)
+	}))
+}
@@ -0,0 +1,19 @@
+package report
+
+import "testing"
+
+func TestDiffPath(t *testing.T) {
+	for header, want := range map[string]string{
+		"+++ b/pkg/a.go":               "pkg/a.go",
+		"+++ b/b/a.go":                 "b/a.go",
+		"+++ a/a.go":                   "a/a.go",
+		"+++ b/my file.go\t":           "my file.go",
+		"+++ /dev/null":                "",
+		`+++ "b/tab\there.go"`:         "tab\there.go",
+		`+++ "b/caf\303\251 \"1\".go"`: "café \"1\".go",
+	} {
+		if got := diffPath(header); got != want {
+			t.Errorf("diffPath(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package report
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// generatedHeader matches the comment marking generated Go files, as
+// described in https://golang.org/s/generatedcode.
+var generatedHeader = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// excludedName reports whether the profile file named by import path is
+// left out of reports by opts.Include and opts.Exclude.
+func excludedName(name string, opts Options) bool {
+	if opts.Include != nil && !opts.Include.MatchString(name) {
+		return true
+	}
+
+	return opts.Exclude != nil && opts.Exclude.MatchString(name)
+}
+
+// excludedFile reports whether the profile file named by import path, with
+// its source at file, is left out of reports. Besides the name filters of
+// excludedName this skips generated files unless opts.IncludeGenerated is
+// set.
+func excludedFile(name, file string, opts Options) (bool, error) {
+	if excludedName(name, opts) {
+		return true, nil
+	}
+
+	if opts.IncludeGenerated {
+		return false, nil
+	}
+
+	return isGenerated(file)
+}
+
+// isGenerated reports whether the Go source file carries the generated
+// code comment before its package clause.
+func isGenerated(file string) (bool, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if generatedHeader.MatchString(line) {
+			return true, nil
+		}
+
+		if strings.HasPrefix(line, "package ") {
+			return false, nil
+		}
+	}
+
+	return false, sc.Err()
+}
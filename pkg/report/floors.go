@@ -0,0 +1,114 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// FloorFile is the name of the per-package file declaring the minimum
+// coverage of the package in its directory, e.g.
+//
+//	# coverage floor of this package
+//	min: 80
+const FloorFile = "coverage.yaml"
+
+// packageFloor is the minimum coverage applying to a package and where it
+// was declared.
+type packageFloor struct {
+	min    float64
+	source string
+}
+
+// readFloor reads the floor declared in the coverage.yaml of dir. It
+// returns nil if the directory has no such file or it declares no floor.
+// Only the flat "key: value" subset of YAML is understood.
+func readFloor(dir string) (*packageFloor, error) {
+	name := filepath.Join(dir, FloorFile)
+
+	f, err := os.Open(name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+
+		k, v, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(k) != "min" {
+			continue
+		}
+
+		min, err := strconv.ParseFloat(strings.Trim(strings.TrimSpace(v), `"'%`), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid min: %v", name, err)
+		}
+
+		return &packageFloor{min: min, source: name}, nil
+	}
+
+	return nil, sc.Err()
+}
+
+// setFloors looks up the floor of every package. A coverage.yaml in the
+// package directory takes precedence over the -min-package flag, which
+// applies to every other package when greater than zero.
+func setFloors(pkgs []*packageStats, minPackage float64) error {
+	for _, p := range pkgs {
+		// Without a directory the coverage.yaml of the working directory
+		// would be read for the package.
+		var floor *packageFloor
+		if dir := p.dir(); dir != "" {
+			var err error
+			floor, err = readFloor(dir)
+			if err != nil {
+				return err
+			}
+		}
+
+		if floor == nil && minPackage > 0 {
+			floor = &packageFloor{min: minPackage, source: "-min-package"}
+		}
+
+		if floor != nil {
+			p.Floor, p.FloorSource = floor.min, floor.source
+		}
+	}
+
+	return nil
+}
+
+// checkPackageFloors fails when the statement weighted coverage of a
+// package is below the floor found by setFloors.
+func checkPackageFloors(d *templateData) error {
+	var failed []string
+
+	for _, p := range d.Packages {
+		if p.FloorSource == "" {
+			continue
+		}
+
+		if cov := p.Coverage(); cov < p.Floor {
+			failed = append(failed, fmt.Sprintf("%s: %s%% is below %s%% (%s)", p.Path, formatCoverage(cov, d.precision), formatCoverage(p.Floor, d.precision), p.FloorSource))
+		}
+	}
+
+	if len(failed) > 0 {
+		return &CoverageError{
+			msg: "packages below their coverage floor:\n\t" + strings.Join(failed, "\n\t"),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetFloors(t *testing.T) {
+	dir := t.TempDir()
+	yaml := filepath.Join(dir, FloorFile)
+	if err := os.WriteFile(yaml, []byte("# floor\nmin: 80%\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := &templateData{Files: []*templateFile{
+		{Name: "example.com/p/a.go", Covered: 3, Statements: 4, file: filepath.Join(dir, "a.go")},
+		{Name: "example.com/q/b.go", Covered: 1, Statements: 4},
+	}, precision: 2}
+	d.Packages = groupPackages(d.Files)
+
+	if err := setFloors(d.Packages, 50); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range []packageStats{{Floor: 80, FloorSource: yaml}, {Floor: 50, FloorSource: "-min-package"}} {
+		if p := d.Packages[i]; p.Floor != want.Floor || p.FloorSource != want.FloorSource {
+			t.Errorf("%s floor = %v (%s), want %v (%s)", p.Path, p.Floor, p.FloorSource, want.Floor, want.FloorSource)
+		}
+	}
+
+	err := checkPackageFloors(d)
+	if err == nil || !strings.Contains(err.Error(), "example.com/p: 75.00% is below 80.00%") || !strings.Contains(err.Error(), "example.com/q: 25.00% is below 50.00% (-min-package)") {
+		t.Errorf("checkPackageFloors() = %v, want both packages below their floor", err)
+	}
+}
@@ -0,0 +1,206 @@
+package report
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"regexp"
+	"sort"
+
+	"golang.org/x/tools/cover"
+)
+
+// funcExtent describes the position of a function declaration in a file.
+type funcExtent struct {
+	pkg       string
+	name      string
+	startLine int
+	startCol  int
+	endLine   int
+	endCol    int
+}
+
+// findFuncs parses the Go source of the named file and returns the extents
+// of the functions declared in it. Methods are named Type.Method.
+func findFuncs(name string, src []byte) ([]*funcExtent, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var funcs []*funcExtent
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		start := fset.Position(fn.Pos())
+		end := fset.Position(fn.End())
+		funcs = append(funcs, &funcExtent{
+			pkg:       f.Name.Name,
+			name:      funcName(fn),
+			startLine: start.Line,
+			startCol:  start.Column,
+			endLine:   end.Line,
+			endCol:    end.Column,
+		})
+	}
+
+	return funcs, nil
+}
+
+// funcName returns the name of fn, qualified with its receiver type for
+// methods.
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	t := fn.Recv.List[0].Type
+	if s, ok := t.(*ast.StarExpr); ok {
+		t = s.X
+	}
+
+	switch x := t.(type) {
+	case *ast.IndexExpr:
+		t = x.X
+	case *ast.IndexListExpr:
+		t = x.X
+	}
+
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name + "." + fn.Name.Name
+	}
+
+	return fn.Name.Name
+}
+
+// contains reports whether the block lies within the function.
+func (f *funcExtent) contains(b cover.ProfileBlock) bool {
+	if b.StartLine < f.startLine || (b.StartLine == f.startLine && b.StartCol < f.startCol) {
+		return false
+	}
+
+	return b.EndLine < f.endLine || (b.EndLine == f.endLine && b.EndCol <= f.endCol)
+}
+
+// coverage returns the number of covered and total statements of the
+// profile blocks lying inside the function. Profile blocks are sorted by
+// position, so only those starting within the function are looked at.
+func (f *funcExtent) coverage(p *cover.Profile) (covered, total int64) {
+	first := sort.Search(len(p.Blocks), func(i int) bool {
+		b := p.Blocks[i]
+		return b.StartLine > f.startLine || (b.StartLine == f.startLine && b.StartCol >= f.startCol)
+	})
+
+	for _, b := range p.Blocks[first:] {
+		if b.StartLine > f.endLine {
+			break
+		}
+
+		if !f.contains(b) {
+			continue
+		}
+
+		total += int64(b.NumStmt)
+		if b.Count > 0 {
+			covered += int64(b.NumStmt)
+		}
+	}
+
+	return covered, total
+}
+
+// excludeFuncs removes the blocks of all functions whose name matches re
+// from the profile, so they no longer count towards its coverage.
+func excludeFuncs(p *cover.Profile, src []byte, re *regexp.Regexp) error {
+	funcs, err := findFuncs(p.FileName, src)
+	if err != nil {
+		return err
+	}
+
+	var excluded []*funcExtent
+	for _, f := range funcs {
+		if re.MatchString(f.name) {
+			excluded = append(excluded, f)
+		}
+	}
+
+	if len(excluded) == 0 {
+		return nil
+	}
+
+	blocks := p.Blocks[:0:0]
+	for _, b := range p.Blocks {
+		keep := true
+		for _, f := range excluded {
+			keep = keep && !f.contains(b)
+		}
+
+		if keep {
+			blocks = append(blocks, b)
+		}
+	}
+
+	p.Blocks = blocks
+	return nil
+}
+
+// funcCoverage is the coverage of a single function of a report file.
+type funcCoverage struct {
+	Name       string
+	StartLine  int
+	EndLine    int
+	Covered    int64
+	Statements int64
+}
+
+// Coverage returns the statement weighted coverage of the function as a
+// percentage.
+func (f *funcCoverage) Coverage() float64 {
+	if f.Statements == 0 {
+		return 0
+	}
+
+	return float64(f.Covered) / float64(f.Statements) * 100
+}
+
+// Funcs returns the coverage of the functions of the file that contain
+// statements, in source order. Like Body it reads the source when the
+// template asks for it.
+func (f *templateFile) Funcs() ([]*funcCoverage, error) {
+	if f.Missing || f.Oversized {
+		return nil, nil
+	}
+
+	src, err := ioutil.ReadFile(f.file)
+	if err != nil {
+		return nil, err
+	}
+
+	extents, err := findFuncs(f.file, src)
+	if err != nil {
+		return nil, err
+	}
+
+	var funcs []*funcCoverage
+	for _, e := range extents {
+		covered, total := e.coverage(f.profile)
+		if total == 0 {
+			continue
+		}
+
+		funcs = append(funcs, &funcCoverage{
+			Name:       e.name,
+			StartLine:  e.startLine,
+			EndLine:    e.endLine,
+			Covered:    covered,
+			Statements: total,
+		})
+	}
+
+	return funcs, nil
+}
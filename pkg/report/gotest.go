@@ -0,0 +1,44 @@
+package report
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// RunTests runs go test on pkgs, writing a coverage profile to a temporary
+// file, and returns the path of the profile. args are passed to go test
+// in front of the packages, like -covermode, -tags or -race. The output of
+// the tests goes to stderr, keeping stdout for the report.
+//
+// When tests fail after writing the profile, its path is returned along
+// with the *CommandError, so the report of the failing run can still be
+// written. The caller removes the profile once it is done with it.
+func RunTests(pkgs []string, args []string) (string, error) {
+	f, err := ioutil.TempFile("", "gocover-*.out")
+	if err != nil {
+		return "", err
+	}
+
+	name := f.Name()
+	f.Close()
+
+	goArgs := append([]string{"test", "-coverprofile=" + name}, args...)
+	goArgs = append(goArgs, pkgs...)
+
+	cmd := exec.Command("go", goArgs...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Run()
+	if err != nil {
+		err = &CommandError{command: "go " + strings.Join(goArgs, " "), err: err}
+		if fi, serr := os.Stat(name); serr != nil || fi.Size() == 0 {
+			os.Remove(name)
+			return "", err
+		}
+	}
+
+	return name, err
+}
@@ -0,0 +1,57 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/tools/cover"
+)
+
+// lineHits returns the hit counts of the executed lines of a count or
+// atomic profile as "start-end:count" ranges of lines with the same count,
+// in ascending order. A line spanned by several blocks gets the highest
+// count among them, lines shared with a block that was not executed are
+// left out so that they are only highlighted as uncovered.
+func lineHits(profile *cover.Profile) []string {
+	hits := map[int]int{}
+	for _, block := range profile.Blocks {
+		if block.Count == 0 {
+			continue
+		}
+
+		for l := block.StartLine; l <= block.EndLine; l++ {
+			if block.Count > hits[l] {
+				hits[l] = block.Count
+			}
+		}
+	}
+
+	for _, block := range profile.Blocks {
+		if block.Count != 0 {
+			continue
+		}
+
+		for l := block.StartLine; l <= block.EndLine; l++ {
+			delete(hits, l)
+		}
+	}
+
+	lines := make([]int, 0, len(hits))
+	for l := range hits {
+		lines = append(lines, l)
+	}
+	sort.Ints(lines)
+
+	var ranges []string
+	for i := 0; i < len(lines); {
+		j := i
+		for j+1 < len(lines) && lines[j+1] == lines[j]+1 && hits[lines[j+1]] == hits[lines[i]] {
+			j++
+		}
+
+		ranges = append(ranges, fmt.Sprintf("%d-%d:%d", lines[i], lines[j], hits[lines[i]]))
+		i = j + 1
+	}
+
+	return ranges
+}
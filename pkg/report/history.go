@@ -0,0 +1,230 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// historyEntry is the coverage of a single run recorded in the history
+// file.
+type historyEntry struct {
+	Time     time.Time          `json:"time"`
+	Commit   string             `json:"commit,omitempty"`
+	Coverage float64            `json:"coverage"`
+	Packages map[string]float64 `json:"packages"`
+}
+
+// Chart sizes of the trend of the report total and of every package.
+const (
+	chartWidth, chartHeight     = 600, 60
+	packageWidth, packageHeight = 200, 20
+)
+
+// recordHistory appends the coverage of the report to the history file
+// opts.History, a JSON array of runs, and keeps the history in d so the
+// HTML report can chart it. Partial reports are not recorded.
+func recordHistory(d *templateData, opts Options) error {
+	if opts.History == "" {
+		return nil
+	}
+
+	h, err := readHistory(opts.History)
+	if err != nil {
+		return err
+	}
+
+	if !d.Partial {
+		h = append(h, newHistoryEntry(d, opts.Timeout))
+
+		b, err := json.MarshalIndent(h, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		err = ioutil.WriteFile(opts.History, append(b, '\n'), 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.History = h
+	return nil
+}
+
+// readHistory reads the runs recorded in the history file name, which
+// doesn't need to exist yet.
+func readHistory(name string) ([]historyEntry, error) {
+	b, err := ioutil.ReadFile(name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var h []historyEntry
+	if err := json.Unmarshal(b, &h); err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+
+	return h, nil
+}
+
+// newHistoryEntry returns the history entry of the report, with the
+// commit checked out in the working directory if there is one.
+func newHistoryEntry(d *templateData, timeout time.Duration) historyEntry {
+	e := historyEntry{
+		Time:     time.Now().UTC().Truncate(time.Second),
+		Coverage: round2(totalCoverage(d)),
+		Packages: map[string]float64{},
+	}
+
+	if sha, err := gitOutput(timeout, "rev-parse", "HEAD"); err == nil {
+		e.Commit = strings.TrimSpace(sha)
+	}
+
+	for _, p := range d.Packages {
+		e.Packages[p.Path] = round2(p.Coverage())
+	}
+
+	return e
+}
+
+// round2 rounds a percentage to two decimals, as shown in the reports.
+func round2(v float64) float64 {
+	return float64(int64(v*100+0.5)) / 100
+}
+
+// HistoryChart returns an SVG line chart of the total coverage of the
+// recorded runs.
+func (d *templateData) HistoryChart() template.HTML {
+	points := make([]chartPoint, len(d.History))
+	for i, e := range d.History {
+		points[i] = chartPoint{i, e.Coverage, e.title(e.Coverage, d.precision)}
+	}
+
+	return sparkline(points, len(d.History), chartWidth, chartHeight)
+}
+
+// HistoryChange returns the change of the total coverage since the
+// previous recorded run.
+func (d *templateData) HistoryChange() float64 {
+	n := len(d.History)
+	if n < 2 {
+		return 0
+	}
+
+	return d.History[n-1].Coverage - d.History[n-2].Coverage
+}
+
+// packageTrend is the coverage history of a single package.
+type packageTrend struct {
+	Path   string
+	Chart  template.HTML
+	Change float64
+}
+
+// PackageTrends returns the coverage history of every package of the last
+// recorded run.
+func (d *templateData) PackageTrends() []packageTrend {
+	n := len(d.History)
+	if n == 0 {
+		return nil
+	}
+
+	var paths []string
+	for p := range d.History[n-1].Packages {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var trends []packageTrend
+	for _, p := range paths {
+		t := packageTrend{Path: p}
+
+		var points []chartPoint
+		for i, e := range d.History {
+			if cov, ok := e.Packages[p]; ok {
+				points = append(points, chartPoint{i, cov, e.title(cov, d.precision)})
+			}
+		}
+
+		if len(points) > 1 {
+			t.Change = points[len(points)-1].value - points[len(points)-2].value
+		}
+
+		t.Chart = sparkline(points, n, packageWidth, packageHeight)
+		trends = append(trends, t)
+	}
+
+	return trends
+}
+
+// title describes the run with the given coverage in chart tooltips.
+func (e historyEntry) title(cov float64, precision int) string {
+	t := fmt.Sprintf("%s: %s%%", e.Time.Format("2006-01-02 15:04"), formatCoverage(cov, precision))
+	if e.Commit != "" {
+		t += " at " + shortCommit(e.Commit)
+	}
+
+	return t
+}
+
+// shortCommit abbreviates a commit hash like git does.
+func shortCommit(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+
+	return sha
+}
+
+// chartPoint is the coverage of the run with index i.
+type chartPoint struct {
+	i     int
+	value float64
+	title string
+}
+
+// sparkline draws points, percentages of n runs, as an inline SVG line
+// chart of the given size with a dot per run showing its title on hover.
+func sparkline(points []chartPoint, n, width, height int) template.HTML {
+	const pad = 3
+
+	x := func(i int) float64 {
+		if n < 2 {
+			return float64(width) / 2
+		}
+
+		return pad + float64(i)*float64(width-2*pad)/float64(n-1)
+	}
+	y := func(v float64) float64 {
+		return pad + (100-v)/100*float64(height-2*pad)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg class="coverage-trend" width="%d" height="%d" viewBox="0 0 %[1]d %[2]d" role="img">`, width, height)
+	fmt.Fprintf(&b, `<line x1="0" y1="%.1f" x2="%d" y2="%.1f" stroke="currentColor" stroke-opacity=".2"/>`, y(50), width, y(50))
+
+	coords := make([]string, len(points))
+	for k, p := range points {
+		coords[k] = fmt.Sprintf("%.1f,%.1f", x(p.i), y(p.value))
+	}
+	fmt.Fprintf(&b, `<polyline points="%s" fill="none" stroke="#17a2b8" stroke-width="1.5"/>`, strings.Join(coords, " "))
+
+	for _, p := range points {
+		fmt.Fprintf(&b, `<circle cx="%.1f" cy="%.1f" r="2.5" fill="#17a2b8"><title>%s</title></circle>`,
+			x(p.i), y(p.value), html.EscapeString(p.title))
+	}
+
+	b.WriteString(`</svg>`)
+	return template.HTML(b.String())
+}
@@ -0,0 +1,1164 @@
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/build"
+	"html"
+	"html/template"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/tools/cover"
+)
+
+type templateData struct {
+	Files     []*templateFile
+	Packages  []*packageStats
+	Meta      []MetaEntry
+	Mode      string
+	Set       bool
+	Filtered  bool
+	Partial   bool
+	Collapsed bool
+	Overview  bool
+
+	// Label names the profile set of the report when several are rendered
+	// as tabs, Prefix keeps their element IDs apart.
+	Label  string
+	Prefix string
+
+	// Overlap compares the coverage of two labelled profile sets.
+	Overlap *overlapReport
+
+	// Diff is the git ref the changed lines are taken from.
+	Diff string
+
+	// Pages is set when the report is written as separate pages, which
+	// link to each other instead of to sections of the same page.
+	Pages bool
+
+	// History holds the runs recorded in the -history file, oldest first.
+	History []historyEntry
+
+	// Sort is the order of Files, one of SortOrders or "" for profile
+	// order.
+	Sort string
+
+	// Compared is set when the report is compared with the baseline of
+	// -compare. OldCovered and OldStatements are its totals over the
+	// files of the report and Removed, the files no longer covered.
+	Compared      bool
+	OldCovered    int64
+	OldStatements int64
+	Removed       []string
+
+	// precision is the number of decimals coverage is shown with, see
+	// Options.Precision.
+	precision int
+}
+
+// FileHref returns the link to the source of f, or "" if the report
+// doesn't show it: in overview mode, or for a page of a partial
+// multi-page report that was never written.
+func (d *templateData) FileHref(f *templateFile) string {
+	if d.Overview {
+		return ""
+	}
+
+	if d.Pages {
+		if !f.paged {
+			return ""
+		}
+
+		return fmt.Sprintf("files/%d.html", f.ID)
+	}
+
+	return fmt.Sprintf("#%ssec-%d", d.Prefix, f.ID)
+}
+
+// PackageHref returns the link to the i-th package of d.Packages.
+func (d *templateData) PackageHref(i int) string {
+	if d.Pages {
+		return fmt.Sprintf("packages/%d.html", i)
+	}
+
+	return fmt.Sprintf("#%spkg-%d", d.Prefix, i)
+}
+
+type templateFile struct {
+	Name       string
+	Coverage   float64
+	Covered    int64
+	Statements int64
+	ID         int
+	Dependency bool
+	// Missing is set for files reported without source because it
+	// couldn't be found, see Options.SkipMissing.
+	Missing bool
+	// Oversized is set for files whose source of Size bytes is larger
+	// than Options.MaxFileSize and left out of the report.
+	Oversized bool
+	Size      int64
+
+	// PatchLines and PatchCovered count the lines changed since -diff
+	// that hold statements, and those of them that ran.
+	PatchLines   int64
+	PatchCovered int64
+
+	// Compared is set when the report is compared with a baseline, Added
+	// if the file isn't in it. Lost and Gained count the statements that
+	// stopped and started running since.
+	Compared      bool
+	Added         bool
+	OldCoverage   float64
+	OldCovered    int64
+	OldStatements int64
+	Lost          int64
+	Gained        int64
+
+	// file is the path of the source file on disk, empty if Missing.
+	file    string
+	profile *cover.Profile
+	// paged is set once the own page of the file of a multi-page report
+	// is written.
+	paged bool
+	// uncoveredChanges are the changed lines that did not run.
+	uncoveredChanges []int
+	// lostLines are the lines of the blocks that ran in the baseline but
+	// no longer do.
+	lostLines []int
+
+	// state and pending hand the body rendered by prefetchBodies to Body.
+	state   int32
+	pending chan renderedBody
+}
+
+// Body returns the highlighted source of the file. It is generated when
+// the template asks for it, or just before by prefetchBodies, so only a
+// few files are held in memory at a time while the report is written.
+func (f *templateFile) Body() (template.HTML, error) {
+	if !atomic.CompareAndSwapInt32(&f.state, bodyIdle, bodyInline) {
+		r := <-f.pending
+		atomic.StoreInt32(&f.state, bodyIdle)
+		<-r.slot
+
+		return r.html, r.err
+	}
+
+	defer atomic.StoreInt32(&f.state, bodyIdle)
+	return f.render()
+}
+
+// missingSource replaces the source of files reported without it.
+const missingSource template.HTML = `<div class="alert alert-warning" role="alert">Source not found, only the coverage of this file is reported.</div>`
+
+// render generates the highlighted source of the file.
+func (f *templateFile) render() (template.HTML, error) {
+	if f.Missing {
+		return missingSource, nil
+	}
+
+	if f.Oversized {
+		return template.HTML(fmt.Sprintf(`<div class="alert alert-warning" role="alert">The source of %d bytes exceeds the maximum file size, only the coverage of this file is reported.</div>`, f.Size)), nil
+	}
+
+	src, err := ioutil.ReadFile(f.file)
+	if err != nil {
+		return "", err
+	}
+
+	// The body is built in place, the largest files of a report would
+	// otherwise be held twice.
+	var b strings.Builder
+	b.Grow(2 * len(src))
+
+	err = htmlGen(&b, src, f.profile, f.uncoveredChanges, f.lostLines)
+	if err != nil {
+		return "", err
+	}
+
+	return template.HTML(b.String()), nil
+}
+
+// source returns the source of the file, nil if it is Missing or too
+// large to be read.
+func (f *templateFile) source() ([]byte, error) {
+	if f.Missing || f.Oversized {
+		return nil, nil
+	}
+
+	return ioutil.ReadFile(f.file)
+}
+
+// BaseName returns the name of the file without its package path.
+func (f *templateFile) BaseName() string {
+	return path.Base(f.Name)
+}
+
+// UncoveredBlocks returns the first line of every run of uncovered lines
+// of the file, blocks that overlap or follow each other are joined. Files
+// shown without source have none.
+func (f *templateFile) UncoveredBlocks() []int {
+	if f.Missing || f.Oversized {
+		return nil
+	}
+
+	var blocks []cover.ProfileBlock
+	for _, b := range f.profile.Blocks {
+		if b.Count == 0 {
+			blocks = append(blocks, b)
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].StartLine < blocks[j].StartLine })
+
+	var starts []int
+	end := -1
+	for _, b := range blocks {
+		if b.StartLine > end+1 {
+			starts = append(starts, b.StartLine)
+		}
+
+		if b.EndLine > end {
+			end = b.EndLine
+		}
+	}
+
+	return starts
+}
+
+// removeArrayDuplicates removes the duplicates from a list of "start-end"
+// line ranges and returns them ordered by start line, then end line, so
+// that the generated report is the same on every run.
+func removeArrayDuplicates(e []string) []string {
+	seen := map[string]bool{}
+	res := []string{}
+
+	for _, v := range e {
+		if seen[v] {
+			continue
+		}
+
+		seen[v] = true
+		res = append(res, v)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		si, ei := lineRange(res[i])
+		sj, ej := lineRange(res[j])
+		if si != sj {
+			return si < sj
+		}
+
+		return ei < ej
+	})
+
+	return res
+}
+
+// lineRange parses a "start-end" line range.
+func lineRange(r string) (int, int) {
+	var start, end int
+	fmt.Sscanf(r, "%d-%d", &start, &end)
+
+	return start, end
+}
+
+// AssetGroups are the bundled asset groups that can be selected with
+// -assets. prism provides syntax and line highlighting, bootstrap provides
+// the page styling together with its jQuery and Popper dependencies.
+var AssetGroups = []string{"prism", "bootstrap"}
+
+// Themes are the values accepted by -theme, auto follows the
+// prefers-color-scheme setting of the browser.
+var Themes = []string{"light", "dark", "auto"}
+
+// ParseAssets parses a comma-separated list of asset groups into a set.
+func ParseAssets(list string) (map[string]bool, error) {
+	assets := map[string]bool{}
+
+	for _, g := range strings.Split(list, ",") {
+		g = strings.TrimSpace(g)
+		if g == "" {
+			continue
+		}
+
+		known := false
+		for _, v := range AssetGroups {
+			known = known || v == g
+		}
+
+		if !known {
+			return nil, fmt.Errorf("unknown asset group %q, expected one of %s", g, strings.Join(AssetGroups, ","))
+		}
+
+		assets[g] = true
+	}
+
+	return assets, nil
+}
+
+// getTemplate renders the report for data into buf, inlining only the
+// asset groups selected in opts.Assets, read from opts.ResDir where
+// present. If tabs is not empty each of its
+// reports is rendered in a tab of its own.
+func getTemplate(buf io.Writer, data *templateData, tabs []*templateData, opts Options) error {
+	it, tplVals, err := loadTemplate(opts, true)
+	if err != nil {
+		return err
+	}
+
+	tplVals["data"] = data
+	tplVals["totalCov"] = totalCoverage(data)
+	tplVals["tabs"] = tabs
+
+	if !data.Overview {
+		files := data.Files
+		if len(tabs) > 0 {
+			files = nil
+			for _, t := range tabs {
+				files = append(files, t.Files...)
+			}
+		}
+
+		defer prefetchBodies(files, opts.Jobs)()
+	}
+
+	return executeTemplate(it, buf, tplVals)
+}
+
+// outputBufferSize is the size of the buffer between the report template
+// and its output, which gets many small writes.
+const outputBufferSize = 64 << 10
+
+// executeTemplate streams the report rendered by it to w through a
+// buffer.
+func executeTemplate(it *template.Template, w io.Writer, vals map[string]interface{}) error {
+	bw := bufio.NewWriterSize(w, outputBufferSize)
+	if err := it.Execute(bw, vals); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// loadTemplate parses the report template and returns it with the values
+// shared by every page rendered from it. The selected assets are read to
+// be inlined if inline is set, otherwise pages link to them under the
+// "assets" value.
+func loadTemplate(opts Options, inline bool) (*template.Template, map[string]interface{}, error) {
+	res := resourceFS(opts.ResDir)
+
+	it, err := template.New("index.html").Funcs(template.FuncMap{
+		"coverage": func(cov float64) string { return formatCoverage(cov, *opts.Precision) },
+		"change":   func(delta float64) string { return formatChange(delta, *opts.Precision) },
+	}).ParseFS(res, "res/index.html")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	darkCSS, err := fs.ReadFile(res, "res/dark.css")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var customCSS []byte
+	if opts.CustomCSS != "" {
+		customCSS, err = ioutil.ReadFile(opts.CustomCSS)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var prismCSS, prismJS []byte
+	if opts.Assets["prism"] && inline {
+		prismCSS, err = fs.ReadFile(res, "res/prism.css")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		prismJS, err = fs.ReadFile(res, "res/prism.js")
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var bsCSS, jq, bsJS, popper []byte
+	if opts.Assets["bootstrap"] && inline {
+		bsCSS, err = fs.ReadFile(res, "res/bootstrap.min.css")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		jq, err = fs.ReadFile(res, "res/jquery-3.2.1.slim.min.js")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		bsJS, err = fs.ReadFile(res, "res/bootstrap.min.js")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		popper, err = fs.ReadFile(res, "res/popper.min.js")
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	tplVals := map[string]interface{}{
+		"prismCSS":     template.CSS(prismCSS),
+		"bootstrapCSS": template.CSS(bsCSS),
+		"prismJS":      template.JS(prismJS),
+		"popper":       template.JS(popper),
+		"jq":           template.JS(jq),
+		"bootstrapJS":  template.JS(bsJS),
+		"darkCSS":      template.CSS(darkCSS),
+		"customCSS":    template.CSS(customCSS),
+		"prism":        opts.Assets["prism"],
+		"bootstrap":    opts.Assets["bootstrap"],
+		"assets":       "",
+		"page":         "",
+		"theme":        opts.Theme,
+		"reload":       template.HTML(""),
+	}
+
+	if opts.liveReload {
+		tplVals["reload"] = template.HTML(reloadScript)
+	}
+
+	return it, tplVals, nil
+}
+
+// findFile finds the location of the named file in the module cache,
+// GOROOT, GOPATH etc. Packages are looked up with go list, which knows
+// about modules, falling back to go/build for legacy GOPATH setups.
+// The profile records files by import path, which always uses forward
+// slashes, so it is split with path rather than filepath.
+func findFile(file string, timeout time.Duration) (string, error) {
+	if filepath.IsAbs(file) {
+		if _, err := os.Stat(file); err == nil {
+			return file, nil
+		}
+	}
+
+	dir, name := path.Split(file)
+	if pkgDir := packageDir(strings.TrimSuffix(dir, "/"), timeout); pkgDir != "" {
+		return filepath.Join(pkgDir, name), nil
+	}
+
+	pkg, err := build.Import(dir, ".", build.FindOnly)
+
+	if err != nil {
+		return "", fmt.Errorf("can't find %q: %v", file, err)
+	}
+
+	return filepath.Join(pkg.Dir, name), nil
+}
+
+// htmlGen generates an HTML coverage report with the provided filename,
+// source code, and tokens, and writes it to the given Writer.
+func htmlGen(w io.Writer, src []byte, profile *cover.Profile, uncoveredChanges, lost []int) error {
+	dst := bufio.NewWriter(w)
+	uncoverdLines := []string{}
+
+	for _, block := range profile.Blocks {
+		if block.Count != 0 {
+			continue
+		}
+
+		l := fmt.Sprintf("%d-%d", block.StartLine, block.EndLine)
+		uncoverdLines = append(uncoverdLines, l)
+	}
+
+	html := `<pre class=" line-numbers" data-line="%s" data-covered="%s"%s%s%s><code class="language-go">%s</code></pre>`
+	uncoverdLines = removeArrayDuplicates(uncoverdLines)
+
+	// Count and atomic profiles also record how often each line ran.
+	var counts string
+	if profile.Mode != "set" {
+		counts = fmt.Sprintf(` data-counts="%s"`, strings.Join(lineHits(profile), ","))
+	}
+
+	// Changed lines that did not run are marked when diffing against a ref.
+	var changes string
+	if len(uncoveredChanges) > 0 {
+		changes = fmt.Sprintf(` data-changed="%s"`, strings.Join(lineRanges(uncoveredChanges), ","))
+	}
+
+	// So are the lines that lost coverage since the -compare baseline.
+	var regressed string
+	if len(lost) > 0 {
+		regressed = fmt.Sprintf(` data-lost="%s"`, strings.Join(lineRanges(lost), ","))
+	}
+
+	fmt.Fprintf(dst, html, strings.Join(uncoverdLines, ","), strings.Join(coveredLines(profile), ","), counts, changes, regressed, displaySource(src, profile))
+	return dst.Flush()
+}
+
+// coveredLines returns the ranges of lines executed by the test run, in
+// ascending order. A line shared with a block that was not executed is
+// left out so that it is only highlighted as uncovered.
+func coveredLines(profile *cover.Profile) []string {
+	covered := map[int]bool{}
+	for _, block := range profile.Blocks {
+		if block.Count == 0 {
+			continue
+		}
+
+		for l := block.StartLine; l <= block.EndLine; l++ {
+			covered[l] = true
+		}
+	}
+
+	for _, block := range profile.Blocks {
+		if block.Count != 0 {
+			continue
+		}
+
+		for l := block.StartLine; l <= block.EndLine; l++ {
+			delete(covered, l)
+		}
+	}
+
+	lines := make([]int, 0, len(covered))
+	for l := range covered {
+		lines = append(lines, l)
+	}
+	sort.Ints(lines)
+
+	return lineRanges(lines)
+}
+
+// lineRanges joins sorted line numbers into "start-end" ranges.
+func lineRanges(lines []int) []string {
+	var ranges []string
+	for i := 0; i < len(lines); {
+		j := i
+		for j+1 < len(lines) && lines[j+1] == lines[j]+1 {
+			j++
+		}
+
+		ranges = append(ranges, fmt.Sprintf("%d-%d", lines[i], lines[j]))
+		i = j + 1
+	}
+
+	return ranges
+}
+
+// displaySource prepares src for a <pre> block. The source is HTML escaped
+// so that characters like <, > and & are shown as written, and the browser
+// shows exactly the lines counted by the profile, which go/token delimits
+// by '\n' only: a leading byte order mark is dropped, and lone carriage
+// returns, which HTML parsing would turn into extra line breaks and so
+// shift every highlighted range below them, are written as character
+// references that render as spaces. The exact columns of the blocks of
+// profile that did not run are wrapped in uncovered-span elements, so the
+// uncovered part of a partially covered line stands out.
+func displaySource(src []byte, profile *cover.Profile) string {
+	src = bytes.TrimPrefix(src, []byte("\xef\xbb\xbf"))
+	spans := uncoveredBoundaries(src, profile)
+
+	var b strings.Builder
+	open := false
+	for i := 0; i <= len(src); i++ {
+		for ; len(spans) > 0 && spans[0].Offset == i; spans = spans[1:] {
+			if spans[0].Start && !open {
+				b.WriteString(`<span class="uncovered-span">`)
+				open = true
+			} else if !spans[0].Start && open {
+				b.WriteString("</span>")
+				open = false
+			}
+		}
+
+		if i == len(src) {
+			break
+		}
+
+		switch c := src[i]; c {
+		case '\r':
+			if i+1 == len(src) || src[i+1] != '\n' {
+				b.WriteString("&#13;")
+			} else {
+				b.WriteByte(c)
+			}
+		case '<', '>', '&', '\'', '"':
+			b.WriteString(html.EscapeString(string(c)))
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	if open {
+		b.WriteString("</span>")
+	}
+
+	return b.String()
+}
+
+// uncoveredBoundaries returns the byte offsets in src where the blocks of
+// profile that did not run start and end.
+func uncoveredBoundaries(src []byte, profile *cover.Profile) []cover.Boundary {
+	uncovered := &cover.Profile{FileName: profile.FileName, Mode: profile.Mode}
+	for _, b := range profile.Blocks {
+		if b.Count == 0 {
+			uncovered.Blocks = append(uncovered.Blocks, b)
+		}
+	}
+
+	if len(uncovered.Blocks) == 0 {
+		return nil
+	}
+
+	return uncovered.Boundaries(src)
+}
+
+// statementCounts returns the number of covered statements and the total
+// number of statements in the profile.
+func statementCounts(p *cover.Profile) (covered, total int64) {
+	for _, b := range p.Blocks {
+		total += int64(b.NumStmt)
+		if b.Count > 0 {
+			covered += int64(b.NumStmt)
+		}
+	}
+
+	return covered, total
+}
+
+// percentCovered returns, as a percentage, the fraction of the statements in
+// the profile covered by the test run.
+// In effect, it reports the coverage of a given source file.
+func percentCovered(p *cover.Profile) float64 {
+	covered, total := statementCounts(p)
+
+	if total == 0 {
+		return 0
+	}
+
+	return float64(covered) / float64(total) * 100
+}
+
+// formatCoverage formats a coverage percentage without the percent sign,
+// with the precision all outputs and gate messages share.
+func formatCoverage(cov float64, precision int) string {
+	return strconv.FormatFloat(cov, 'f', precision, 64)
+}
+
+// formatChange formats a change of coverage like formatCoverage, always
+// signed.
+func formatChange(delta float64, precision int) string {
+	return fmt.Sprintf("%+.*f", precision, delta)
+}
+
+// totalCoverage returns the statement weighted coverage of all files in
+// the report.
+func totalCoverage(p *templateData) float64 {
+	return filesCoverage(p.Files)
+}
+
+// TotalCoverage returns the total coverage of the report.
+func (d *templateData) TotalCoverage() float64 {
+	return totalCoverage(d)
+}
+
+// CoveredStatements returns the number of statements of the report that
+// were executed.
+func (d *templateData) CoveredStatements() int64 {
+	covered, _ := fileCounts(d.Files)
+	return covered
+}
+
+// Statements returns the number of statements of the report.
+func (d *templateData) Statements() int64 {
+	_, total := fileCounts(d.Files)
+	return total
+}
+
+// HasDependencies reports whether the report covers files outside the main
+// module.
+func (d *templateData) HasDependencies() bool {
+	_, deps := splitDependencies(d.Files)
+	return len(deps) > 0
+}
+
+// HasFloors reports whether any package of the report has a coverage
+// floor.
+func (d *templateData) HasFloors() bool {
+	for _, p := range d.Packages {
+		if p.FloorSource != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ModuleCoverage returns the coverage of the main module files.
+func (d *templateData) ModuleCoverage() float64 {
+	own, _ := splitDependencies(d.Files)
+	return filesCoverage(own)
+}
+
+// DependencyCoverage returns the coverage of the dependency files.
+func (d *templateData) DependencyCoverage() float64 {
+	_, deps := splitDependencies(d.Files)
+	return filesCoverage(deps)
+}
+
+// MissingFiles returns the files of the report whose source wasn't found.
+func (d *templateData) MissingFiles() []*templateFile {
+	var missing []*templateFile
+	for _, f := range d.Files {
+		if f.Missing {
+			missing = append(missing, f)
+		}
+	}
+
+	return missing
+}
+
+// fileCounts returns the number of covered and total statements of files.
+func fileCounts(files []*templateFile) (covered, total int64) {
+	for _, f := range files {
+		covered += f.Covered
+		total += f.Statements
+	}
+
+	return covered, total
+}
+
+// filesCoverage returns the statement weighted coverage of the given files
+// as a percentage, which matches what go tool cover reports for them.
+func filesCoverage(files []*templateFile) float64 {
+	covered, total := fileCounts(files)
+	if total == 0 {
+		return 0
+	}
+
+	return float64(covered) / float64(total) * 100
+}
+
+// splitDependencies splits files into those of the main module and those
+// of its dependencies.
+func splitDependencies(files []*templateFile) (own, deps []*templateFile) {
+	for _, f := range files {
+		if f.Dependency {
+			deps = append(deps, f)
+		} else {
+			own = append(own, f)
+		}
+	}
+
+	return own, deps
+}
+
+// getTemplateData reads and merges the named profiles and collects the
+// coverage of every file they cover.
+func getTemplateData(names []string, opts Options) (templateData, error) {
+	profiles, err := readProfiles(names, opts)
+	if err != nil {
+		return templateData{}, err
+	}
+
+	return buildTemplateData(profiles, opts)
+}
+
+// buildTemplateData collects the coverage of every file of the parsed
+// profiles.
+func buildTemplateData(profiles []*cover.Profile, opts Options) (templateData, error) {
+	var d templateData
+	d.precision = *opts.Precision
+
+	listPackageDirs(profiles, opts.Timeout)
+
+	d.Filtered = opts.ExcludeFunc != nil
+	d.Overview = opts.Overview
+	d.Diff = opts.Diff
+	modules := mainModules(opts.Timeout)
+
+	var changes changedFiles
+	if opts.Diff != "" {
+		c, err := changedLines(opts.Diff, opts.Timeout)
+		if err != nil {
+			return d, err
+		}
+
+		changes = c
+	}
+
+	base, err := loadBaseline(opts)
+	if err != nil {
+		return d, err
+	}
+
+	// The files are loaded concurrently, then collected in profile order
+	// so the report doesn't depend on which finished first.
+	files := make([]*templateFile, len(profiles))
+	errs := make([]error, len(profiles))
+	done := make([]bool, len(profiles))
+
+	parallel(len(profiles), opts.Jobs, func(k int) {
+		if interrupted() {
+			return
+		}
+
+		files[k], errs[k] = loadFile(k, profiles[k], modules, changes, base, opts)
+		done[k] = true
+	})
+
+	for k, profile := range profiles {
+		if !done[k] {
+			d.Partial = true
+			continue
+		}
+
+		d.Mode = profile.Mode
+		if profile.Mode == "set" {
+			d.Set = true
+		}
+
+		if errs[k] != nil {
+			return d, errs[k]
+		}
+
+		if files[k] != nil {
+			d.Files = append(d.Files, files[k])
+		}
+	}
+
+	d.setBaseline(base, profiles)
+	d.Packages = groupPackages(d.Files)
+	err = setFloors(d.Packages, opts.MinPackage)
+	if err != nil {
+		return d, err
+	}
+
+	d.Sort = opts.Sort
+	sortFiles(d.Files, opts.Sort)
+	for _, p := range d.Packages {
+		sortFiles(p.Files, opts.Sort)
+	}
+
+	return d, nil
+}
+
+// loadFile locates the source of the k-th profile and collects its
+// coverage, it returns nil for files left out of the report.
+func loadFile(k int, profile *cover.Profile, modules []string, changes changedFiles, base baseline, opts Options) (*templateFile, error) {
+	fn := profile.FileName
+	if excludedName(fn, opts) {
+		return nil, nil
+	}
+
+	file, err := resolveSource(fn, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if file == "" {
+		f := newTemplateFile(k, profile, modules)
+		f.Missing = true
+		f.setBaseline(base)
+		return f, nil
+	}
+
+	var size int64
+	if opts.MaxFileSize > 0 {
+		fi, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+
+		size = fi.Size()
+	}
+
+	// Oversized files aren't read at all, only the name and generated
+	// file filters apply to them.
+	oversized := opts.MaxFileSize > 0 && size > opts.MaxFileSize
+
+	var src []byte
+	keep := true
+	if oversized {
+		var skip bool
+		skip, err = excludedFile(fn, file, opts)
+		keep = !skip
+	} else {
+		src, keep, err = filterProfile(profile, file, opts)
+	}
+
+	if err != nil || !keep {
+		return nil, err
+	}
+
+	f := newTemplateFile(k, profile, modules)
+	f.file = file
+	f.Oversized, f.Size = oversized, size
+	f.setBaseline(base)
+
+	return f, f.setPatchCoverage(changes, src)
+}
+
+// filterProfile applies the file filters, ignore directives and
+// opts.ExcludeFunc to the profile with its source at file. It returns the
+// source, and whether the file is kept in the report.
+func filterProfile(profile *cover.Profile, file string, opts Options) ([]byte, bool, error) {
+	skip, err := excludedFile(profile.FileName, file, opts)
+	if err != nil || skip {
+		return nil, false, err
+	}
+
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ignored, err := applyIgnores(profile, src)
+	if err != nil || ignored {
+		return nil, false, err
+	}
+
+	if opts.ExcludeFunc != nil {
+		err = excludeFuncs(profile, src, opts.ExcludeFunc)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	return src, true, nil
+}
+
+// newTemplateFile returns the report file of the k-th profile.
+func newTemplateFile(k int, profile *cover.Profile, modules []string) *templateFile {
+	covered, total := statementCounts(profile)
+	return &templateFile{
+		Name:       profile.FileName,
+		Coverage:   percentCovered(profile),
+		Covered:    covered,
+		Statements: total,
+		ID:         k,
+		Dependency: isDependency(profile.FileName, modules),
+		profile:    profile,
+		pending:    make(chan renderedBody, 1),
+	}
+}
+
+// htmlOutput reads the profile sets in opts.Profiles and generates an HTML
+// coverage report, writing it to opts.Outfile, or stdout if it is "-". If
+// outfile is empty, it writes the report to a temporary file and opens it
+// in a web browser.
+// Once the report is written the configured coverage gates are checked.
+func htmlOutput(opts Options) error {
+	outfile := opts.Outfile
+
+	d, tabs, err := loadReports(opts)
+	if err != nil {
+		return err
+	}
+
+	releaseInterrupts()
+
+	err = recordHistory(d, opts)
+	if err != nil {
+		return err
+	}
+
+	var out *os.File
+	if outfile == "" {
+		var dir string
+
+		dir, err = ioutil.TempDir("", "cover")
+		if err != nil {
+			return err
+		}
+
+		out, err = os.Create(filepath.Join(dir, "coverage.html"))
+		if err != nil {
+			return err
+		}
+	} else {
+		out, err = createOutput(outfile)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = getTemplate(out, d, tabs, opts)
+	if err == nil {
+		err = closeOutput(out)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = shipReport("", out.Name(), opts)
+	if err != nil {
+		return err
+	}
+
+	// A report only written to be shipped isn't worth a browser tab.
+	if outfile == "" && opts.Archive == "" && opts.Upload == "" {
+		if !startBrowser(fileURL(out.Name())) {
+			fmt.Fprintf(os.Stderr, "HTML output written to %s\n", out.Name())
+		}
+	}
+
+	err = writeBadgeFile(d, opts)
+	if err != nil {
+		return err
+	}
+
+	if outfile != stdoutName {
+		outfile = out.Name()
+	}
+
+	err = runAfterCommand(opts.AfterCommand, outfile, opts.Timeout)
+	if err != nil {
+		return err
+	}
+
+	return checkGates(d, opts)
+}
+
+// fileURL returns the file:// URL of the named local file. The path is
+// escaped so that names containing spaces or other special characters
+// still open correctly in a browser.
+func fileURL(name string) string {
+	p := filepath.ToSlash(name)
+	if !strings.HasPrefix(p, "/") {
+		// Windows paths such as C:/Users/... need a leading slash.
+		p = "/" + p
+	}
+
+	u := url.URL{Scheme: "file", Path: p}
+	return u.String()
+}
+
+// startCommand starts the named program without waiting for it, and
+// commandOutput runs it and returns its standard output. The browser is
+// opened through them so tests can record the commands instead.
+var (
+	startCommand = func(name string, args ...string) error {
+		return exec.Command(name, args...).Start()
+	}
+	commandOutput = func(name string, args ...string) ([]byte, error) {
+		return exec.Command(name, args...).Output()
+	}
+)
+
+// windowsURLArg stands for the URL converted to a Windows path in the
+// arguments of browserCommands. It is only converted, which runs wslpath,
+// once the command is tried.
+const windowsURLArg = "\x00windows-url"
+
+// startBrowser tries to open the URL in a browser
+// and reports whether it succeeds.
+func startBrowser(url string) bool {
+	return startCommands(url, browserCommands(url, runtime.GOOS, isWSL()))
+}
+
+// startCommands starts the first of cmds that can be run and reports
+// whether there was one.
+func startCommands(url string, cmds [][]string) bool {
+	for _, args := range cmds {
+		for i, arg := range args {
+			if arg == windowsURLArg {
+				args[i] = windowsPath(url)
+			}
+		}
+
+		if startCommand(args[0], args[1:]...) == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// browserCommands returns the commands that may open url on goos, under
+// WSL if wsl is set, in the order they should be tried. Browsers listed
+// in $BROWSER come first, a %s in an entry is replaced by the URL.
+func browserCommands(url, goos string, wsl bool) [][]string {
+	var cmds [][]string
+
+	for _, b := range filepath.SplitList(os.Getenv("BROWSER")) {
+		args := strings.Fields(b)
+		if len(args) == 0 {
+			continue
+		}
+
+		if strings.Contains(b, "%s") {
+			for i := range args {
+				args[i] = strings.Replace(args[i], "%s", url, -1)
+			}
+		} else {
+			args = append(args, url)
+		}
+
+		cmds = append(cmds, args)
+	}
+
+	switch {
+	case goos == "darwin":
+		cmds = append(cmds, []string{"open", url})
+	case goos == "windows":
+		cmds = append(cmds, []string{"cmd", "/c", "start", url})
+	case wsl:
+		cmds = append(cmds,
+			[]string{"wslview", url},
+			[]string{"cmd.exe", "/c", "start", "", windowsURLArg},
+			[]string{"xdg-open", url})
+	default:
+		cmds = append(cmds, []string{"xdg-open", url})
+	}
+
+	return cmds
+}
+
+// isWSL reports whether we run on Linux under the Windows Subsystem for
+// Linux, where runtime.GOOS is linux but the browser lives on Windows.
+func isWSL() bool {
+	if runtime.GOOS != "linux" {
+		return false
+	}
+
+	if os.Getenv("WSL_DISTRO_NAME") != "" {
+		return true
+	}
+
+	version, err := ioutil.ReadFile("/proc/version")
+
+	return err == nil && strings.Contains(strings.ToLower(string(version)), "microsoft")
+}
+
+// windowsPath translates a file URL into a path Windows programs can
+// reach, using wslpath. Other URLs are returned unchanged.
+func windowsPath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "file" {
+		return rawURL
+	}
+
+	out, err := commandOutput("wslpath", "-w", u.Path)
+	if err != nil {
+		return rawURL
+	}
+
+	return strings.TrimSpace(string(out))
+}
@@ -0,0 +1,309 @@
+package report
+
+import (
+	"bytes"
+	"errors"
+	"html"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func TestRemoveArrayDuplicates(t *testing.T) {
+	in := []string{"10-12", "9-9", "2-5", "10-11", "9-9", "2-5", "100-101"}
+	want := []string{"2-5", "9-9", "10-11", "10-12", "100-101"}
+
+	for i := 0; i < 10; i++ {
+		e := append([]string(nil), in...)
+		if got := removeArrayDuplicates(e); !reflect.DeepEqual(got, want) {
+			t.Fatalf("removeArrayDuplicates(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// renderSource returns the output of htmlGen for src covered by p.
+func renderSource(t *testing.T, src []byte, p *cover.Profile) string {
+	t.Helper()
+
+	var b bytes.Buffer
+	if err := htmlGen(&b, src, p, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	return b.String()
+}
+
+func TestHTMLGenEscapes(t *testing.T) {
+	src := []byte(`package p
+
+func F(a, b int) bool {
+	if a < b && b > 0 {
+		return "<b>" != ""
+	}
+	return false
+}
+`)
+
+	p := &cover.Profile{FileName: "example.com/p/p.go", Mode: "set", Blocks: []cover.ProfileBlock{
+		{StartLine: 3, StartCol: 23, EndLine: 4, EndCol: 20, NumStmt: 1, Count: 1},
+		{StartLine: 4, StartCol: 20, EndLine: 6, EndCol: 3, NumStmt: 1, Count: 0},
+		{StartLine: 7, StartCol: 2, EndLine: 7, EndCol: 14, NumStmt: 1, Count: 1},
+	}}
+
+	out := renderSource(t, src, p)
+	for _, want := range []string{
+		`data-line="4-6"`,
+		`data-covered="3-3,7-7"`,
+		"if a &lt; b &amp;&amp; b &gt; 0 <span class=\"uncovered-span\">{",
+		"return &#34;&lt;b&gt;&#34; != &#34;&#34;\n\t}</span>\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("htmlGen() output does not contain %q:\n%s", want, out)
+		}
+	}
+
+	if strings.Contains(out, "<b>") {
+		t.Errorf("htmlGen() output contains unescaped source:\n%s", out)
+	}
+}
+
+func TestDisplaySourceLines(t *testing.T) {
+	// A byte order mark, a comment block, a lone carriage return and
+	// trailing blank lines must not shift the lines go/token counts.
+	src := "\xef\xbb\xbf// Copyright\r\n// notice.\r\n\npackage p\n\n// s has a \r in it.\nvar s = 1\n\nfunc F() {\n\ts = 2\n}\n\n\n"
+	p := &cover.Profile{FileName: "example.com/p/p.go", Mode: "set", Blocks: []cover.ProfileBlock{
+		{StartLine: 9, StartCol: 10, EndLine: 11, EndCol: 2, NumStmt: 1, Count: 0},
+	}}
+
+	out := displaySource([]byte(src), p)
+	if strings.ContainsAny(strings.ReplaceAll(out, "\r\n", "\n"), "\r") {
+		t.Errorf("displaySource() output contains a lone carriage return: %q", out)
+	}
+
+	lines := strings.Split(out, "\n")
+	if got, want := len(lines), strings.Count(src, "\n")+1; got != want {
+		t.Fatalf("displaySource() output has %d lines, want %d", got, want)
+	}
+
+	if want := `func F() <span class="uncovered-span">{`; lines[8] != want {
+		t.Errorf("line 9 = %q, want %q", lines[8], want)
+	}
+
+	tags := regexp.MustCompile(`<[^>]*>`)
+	if got, want := html.UnescapeString(tags.ReplaceAllString(out, "")), strings.TrimPrefix(src, "\xef\xbb\xbf"); got != want {
+		t.Errorf("displaySource() shows %q, want %q", got, want)
+	}
+}
+
+func TestCoveredLines(t *testing.T) {
+	p := &cover.Profile{FileName: "example.com/p/p.go", Mode: "set", Blocks: []cover.ProfileBlock{
+		{StartLine: 3, StartCol: 20, EndLine: 5, EndCol: 12, NumStmt: 2, Count: 1},
+		{StartLine: 5, StartCol: 12, EndLine: 7, EndCol: 3, NumStmt: 1, Count: 0},
+		{StartLine: 8, StartCol: 2, EndLine: 9, EndCol: 10, NumStmt: 1, Count: 1},
+	}}
+
+	// Line 5 is shared by a block that ran and one that didn't, it is
+	// only highlighted as uncovered.
+	want := []string{"3-4", "8-9"}
+	if got := coveredLines(p); !reflect.DeepEqual(got, want) {
+		t.Errorf("coveredLines() = %q, want %q", got, want)
+	}
+
+	out := renderSource(t, nil, p)
+	if want := `data-line="5-7" data-covered="3-4,8-9"`; !strings.Contains(out, want) {
+		t.Errorf("htmlGen() output does not contain %q:\n%s", want, out)
+	}
+}
+
+func TestBrowserCommands(t *testing.T) {
+	const url = "file:///tmp/report.html"
+
+	output := commandOutput
+	defer func() { commandOutput = output }()
+
+	commandOutput = func(name string, args ...string) ([]byte, error) {
+		t.Errorf("ran %s %q while listing the commands", name, args)
+		return nil, errors.New("not run")
+	}
+
+	tests := []struct {
+		browser string
+		goos    string
+		wsl     bool
+		want    [][]string
+	}{
+		{"", "darwin", false, [][]string{{"open", url}}},
+		{"", "windows", false, [][]string{{"cmd", "/c", "start", url}}},
+		{"", "linux", false, [][]string{{"xdg-open", url}}},
+		{"", "linux", true, [][]string{
+			{"wslview", url},
+			{"cmd.exe", "/c", "start", "", windowsURLArg},
+			{"xdg-open", url},
+		}},
+		{"firefox" + string(os.PathListSeparator) + "chromium --new-window %s", "linux", false, [][]string{
+			{"firefox", url},
+			{"chromium", "--new-window", url},
+			{"xdg-open", url},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("BROWSER", tt.browser)
+		if got := browserCommands(url, tt.goos, tt.wsl); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("browserCommands() with BROWSER=%q on %s (WSL %v) = %q, want %q", tt.browser, tt.goos, tt.wsl, got, tt.want)
+		}
+	}
+}
+
+func TestStartBrowser(t *testing.T) {
+	start := startCommand
+	defer func() { startCommand = start }()
+
+	var started []string
+	startCommand = func(name string, args ...string) error {
+		started = append(started, name)
+		if len(started) < 2 {
+			return errors.New("not installed")
+		}
+
+		return nil
+	}
+
+	t.Setenv("BROWSER", "missing"+string(os.PathListSeparator)+"present")
+	if !startBrowser("file:///tmp/report.html") {
+		t.Fatal("startBrowser() = false, want true")
+	}
+
+	if want := []string{"missing", "present"}; !reflect.DeepEqual(started, want) {
+		t.Errorf("startBrowser() started %q, want %q", started, want)
+	}
+}
+
+func TestStartCommandsWSL(t *testing.T) {
+	const url = "file:///tmp/report.html"
+
+	start, output := startCommand, commandOutput
+	defer func() { startCommand, commandOutput = start, output }()
+
+	var converted int
+	commandOutput = func(name string, args ...string) ([]byte, error) {
+		converted++
+		if name != "wslpath" || !reflect.DeepEqual(args, []string{"-w", "/tmp/report.html"}) {
+			t.Errorf("ran %s %q, want wslpath -w /tmp/report.html", name, args)
+		}
+
+		return []byte(`\\wsl$\Ubuntu\tmp\report.html` + "\n"), nil
+	}
+
+	for _, missing := range []string{"", "wslview"} {
+		converted = 0
+		var started [][]string
+		startCommand = func(name string, args ...string) error {
+			started = append(started, append([]string{name}, args...))
+			if name == missing {
+				return errors.New("not installed")
+			}
+
+			return nil
+		}
+
+		t.Setenv("BROWSER", "")
+		if !startCommands(url, browserCommands(url, "linux", true)) {
+			t.Fatal("startCommands() = false, want true")
+		}
+
+		// wslpath only runs when the cmd.exe fallback is tried.
+		want := [][]string{{"wslview", url}}
+		if missing != "" {
+			want = append(want, []string{"cmd.exe", "/c", "start", "", `\\wsl$\Ubuntu\tmp\report.html`})
+		}
+
+		if !reflect.DeepEqual(started, want) || converted != len(want)-1 {
+			t.Errorf("startCommands() without %q started %q running wslpath %d times, want %q", missing, started, converted, want)
+		}
+	}
+}
+
+func TestFileURL(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the paths are Unix paths")
+	}
+
+	for name, want := range map[string]string{
+		"/tmp/report.html":          "file:///tmp/report.html",
+		"/tmp/my reports/r 1.html":  "file:///tmp/my%20reports/r%201.html",
+		"/tmp/100%/#1?.html":        "file:///tmp/100%25/%231%3F.html",
+		"/home/ünïcode/report.html": "file:///home/%C3%BCn%C3%AFcode/report.html",
+	} {
+		if got := fileURL(name); got != want {
+			t.Errorf("fileURL(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestFilesCoverage(t *testing.T) {
+	// A large file at 10% and a small one at 100% average to 55% per file
+	// but cover 11% of the statements.
+	files := []*templateFile{
+		{Name: "example.com/p/big.go", Covered: 100, Statements: 1000, Coverage: 10},
+		{Name: "example.com/p/small.go", Covered: 10, Statements: 10, Coverage: 100},
+		{Name: "example.com/p/empty.go"},
+	}
+
+	if got, want := filesCoverage(files), 110.0/1010*100; got != want {
+		t.Errorf("filesCoverage() = %v, want %v", got, want)
+	}
+
+	if got := filesCoverage(files[2:]); got != 0 {
+		t.Errorf("filesCoverage() without statements = %v, want 0", got)
+	}
+}
+
+func TestFileHref(t *testing.T) {
+	written := &templateFile{ID: 1, paged: true}
+	skipped := &templateFile{ID: 2}
+
+	tests := []struct {
+		d             templateData
+		written, skip string
+	}{
+		{templateData{Prefix: "t0-"}, "#t0-sec-1", "#t0-sec-2"},
+		{templateData{Overview: true}, "", ""},
+		{templateData{Pages: true}, "files/1.html", ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.d.FileHref(written); got != tt.written {
+			t.Errorf("FileHref() of a written file = %q, want %q", got, tt.written)
+		}
+
+		// A partial multi-page report never wrote the page of the file.
+		if got := tt.d.FileHref(skipped); got != tt.skip {
+			t.Errorf("FileHref() of a skipped file = %q, want %q", got, tt.skip)
+		}
+	}
+}
+
+func TestSourceOversized(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "big.go")
+	if err := os.WriteFile(name, []byte("package big\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The source of a file over -max-file-size is never read.
+	f := &templateFile{Name: "example.com/big/big.go", Oversized: true, file: name}
+	if src, err := f.source(); src != nil || err != nil {
+		t.Errorf("source() of an oversized file = %q, %v, want nil", src, err)
+	}
+
+	f.Oversized = false
+	if src, err := f.source(); string(src) != "package big\n" || err != nil {
+		t.Errorf("source() = %q, %v, want the file", src, err)
+	}
+}
@@ -0,0 +1,126 @@
+package report
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"golang.org/x/tools/cover"
+)
+
+// Comment directives excluding code from reports. Like other Go
+// directives they are written without a space after the slashes.
+const (
+	ignorePrefix = "//gocover:ignore"
+	ignoreLine   = "//gocover:ignore-line"
+	ignoreBlock  = "//gocover:ignore-block"
+	ignoreFile   = "//gocover:ignore-file"
+)
+
+// span is a range of source positions, the end is exclusive.
+type span struct {
+	startLine, startCol int
+	endLine, endCol     int
+}
+
+// contains reports whether the profile block lies within the span.
+func (s span) contains(b cover.ProfileBlock) bool {
+	if b.StartLine < s.startLine || (b.StartLine == s.startLine && b.StartCol < s.startCol) {
+		return false
+	}
+
+	return b.EndLine < s.endLine || (b.EndLine == s.endLine && b.EndCol <= s.endCol)
+}
+
+// applyIgnores removes the blocks excluded by the ignore directives of
+// src from the profile, so they are neither highlighted nor counted. It
+// reports whether the whole file is ignored.
+//
+// A directive applies to the line it ends, or to the next line if it is
+// on a line of its own. //gocover:ignore-line drops the blocks covering
+// that line, //gocover:ignore-block the blocks of the block statement or
+// case clause starting on it and //gocover:ignore-file the file.
+func applyIgnores(p *cover.Profile, src []byte) (bool, error) {
+	if !bytes.Contains(src, []byte(ignorePrefix)) {
+		return false, nil
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, p.FileName, src, parser.ParseComments)
+	if err != nil {
+		return false, err
+	}
+
+	lines := map[int]bool{}
+	blockLines := map[int]bool{}
+	for _, cg := range f.Comments {
+		for _, c := range cg.List {
+			directive := strings.Fields(c.Text)[0]
+			if directive == ignoreFile {
+				return true, nil
+			}
+
+			if directive != ignoreLine && directive != ignoreBlock {
+				continue
+			}
+
+			pos := fset.Position(c.Pos())
+			line := pos.Line
+			if strings.TrimSpace(string(src[pos.Offset-pos.Column+1:pos.Offset])) == "" {
+				line++
+			}
+
+			if directive == ignoreLine {
+				lines[line] = true
+			} else {
+				blockLines[line] = true
+			}
+		}
+	}
+
+	var spans []span
+	ast.Inspect(f, func(n ast.Node) bool {
+		var start token.Pos
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			start = n.Lbrace
+		case *ast.CaseClause:
+			start = n.Case
+		case *ast.CommClause:
+			start = n.Case
+		default:
+			return true
+		}
+
+		s, e := fset.Position(start), fset.Position(n.End())
+		if !blockLines[s.Line] {
+			return true
+		}
+
+		// Only the outermost block starting on the line is needed.
+		delete(blockLines, s.Line)
+		spans = append(spans, span{s.Line, s.Column, e.Line, e.Column})
+		return false
+	})
+
+	blocks := p.Blocks[:0:0]
+	for _, b := range p.Blocks {
+		keep := true
+		for l := b.StartLine; l <= b.EndLine && keep; l++ {
+			keep = !lines[l]
+		}
+
+		for _, s := range spans {
+			keep = keep && !s.contains(b)
+		}
+
+		if keep {
+			blocks = append(blocks, b)
+		}
+	}
+
+	p.Blocks = blocks
+	return false, nil
+}
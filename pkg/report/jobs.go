@@ -0,0 +1,89 @@
+package report
+
+import (
+	"html/template"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// States of the body of a templateFile.
+const (
+	bodyIdle int32 = iota
+	bodyInline
+	bodyPrefetched
+)
+
+// renderedBody is a file body rendered ahead of the template, slot is the
+// prefetch slot it holds until the template takes it.
+type renderedBody struct {
+	html template.HTML
+	err  error
+	slot chan struct{}
+}
+
+// jobCount returns the number of goroutines to use, GOMAXPROCS unless
+// jobs is positive.
+func jobCount(jobs int) int {
+	if jobs > 0 {
+		return jobs
+	}
+
+	return runtime.GOMAXPROCS(0)
+}
+
+// parallel calls fn for every index below n from up to jobs goroutines
+// and returns once all calls returned.
+func parallel(n, jobs int, fn func(i int)) {
+	next := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < jobCount(jobs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		next <- i
+	}
+	close(next)
+
+	wg.Wait()
+}
+
+// prefetchBodies renders the bodies of files, in the order the template
+// shows them, from up to jobs goroutines ahead of it. At most jobs bodies
+// are held waiting for the template at a time. Files the template gets to
+// first are rendered by Body itself. The returned function stops the
+// prefetching once the template is done.
+func prefetchBodies(files []*templateFile, jobs int) func() {
+	slots := make(chan struct{}, jobCount(jobs))
+	done := make(chan struct{})
+
+	go func() {
+		for _, f := range files {
+			select {
+			case slots <- struct{}{}:
+			case <-done:
+				return
+			}
+
+			if f.pending == nil || !atomic.CompareAndSwapInt32(&f.state, bodyIdle, bodyPrefetched) {
+				<-slots
+				continue
+			}
+
+			go func(f *templateFile) {
+				html, err := f.render()
+				f.pending <- renderedBody{html, err, slots}
+			}(f)
+		}
+	}()
+
+	return func() { close(done) }
+}
@@ -0,0 +1,107 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// SchemaVersion is the version of the JSON report format. It is bumped on
+// every change that may break consumers of the format.
+//
+// Changelog:
+//
+//	1: total and per-file coverage, statement counts, mode and metadata.
+//	2: per-file blocks with their positions, statements and counts.
+const SchemaVersion = 2
+
+// JSONReport is the machine readable coverage summary written by -format
+// json.
+type JSONReport struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	Mode          string            `json:"mode"`
+	Coverage      float64           `json:"coverage"`
+	Covered       int64             `json:"covered"`
+	Statements    int64             `json:"statements"`
+	Partial       bool              `json:"partial,omitempty"`
+	Meta          map[string]string `json:"meta,omitempty"`
+	Files         []FileReport      `json:"files"`
+}
+
+// FileReport is the coverage of a single file of a JSONReport.
+type FileReport struct {
+	Name       string        `json:"name"`
+	Coverage   float64       `json:"coverage"`
+	Covered    int64         `json:"covered"`
+	Statements int64         `json:"statements"`
+	Missing    bool          `json:"missing,omitempty"`
+	Blocks     []BlockReport `json:"blocks"`
+}
+
+// BlockReport is a single profile block of a FileReport. Lines and
+// columns start at 1, the end column is exclusive.
+type BlockReport struct {
+	StartLine  int `json:"startLine"`
+	StartCol   int `json:"startCol"`
+	EndLine    int `json:"endLine"`
+	EndCol     int `json:"endCol"`
+	Statements int `json:"statements"`
+	Count      int `json:"count"`
+}
+
+// newJSONReport builds the JSON report of d.
+func newJSONReport(d *templateData) JSONReport {
+	r := JSONReport{
+		SchemaVersion: SchemaVersion,
+		Mode:          d.Mode,
+		Coverage:      totalCoverage(d),
+		Partial:       d.Partial,
+		Files:         []FileReport{},
+	}
+
+	r.Covered, r.Statements = fileCounts(d.Files)
+
+	if len(d.Meta) > 0 {
+		r.Meta = map[string]string{}
+		for _, m := range d.Meta {
+			r.Meta[m.Key] = m.Value
+		}
+	}
+
+	for _, f := range d.Files {
+		fr := FileReport{
+			Name:       f.Name,
+			Coverage:   f.Coverage,
+			Covered:    f.Covered,
+			Statements: f.Statements,
+			Missing:    f.Missing,
+			Blocks:     []BlockReport{},
+		}
+
+		for _, b := range f.profile.Blocks {
+			fr.Blocks = append(fr.Blocks, BlockReport{
+				StartLine:  b.StartLine,
+				StartCol:   b.StartCol,
+				EndLine:    b.EndLine,
+				EndCol:     b.EndCol,
+				Statements: b.NumStmt,
+				Count:      b.Count,
+			})
+		}
+
+		r.Files = append(r.Files, fr)
+	}
+
+	return r
+}
+
+// writeJSON writes the JSON report of d, indented for readability.
+func writeJSON(w io.Writer, d *templateData) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(newJSONReport(d))
+}
+
+// jsonOutput writes the JSON report.
+func jsonOutput(opts Options) error {
+	return writeFormat(opts, merged(writeJSON))
+}
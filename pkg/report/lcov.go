@@ -0,0 +1,124 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// lineStat is the coverage of a single source line, as used by the line
+// based lcov and Cobertura formats.
+type lineStat struct {
+	line int
+	// hits is the lowest count of the blocks on the line, so a partially
+	// executed line is not reported as covered.
+	hits int
+	// counts are the counts of every block on the line. Lines with more
+	// than one block are reported as branches.
+	counts []int
+}
+
+// fileLines returns the coverage of every line of the file holding
+// statements in ascending order.
+func fileLines(f *templateFile) ([]*lineStat, error) {
+	src, err := f.source()
+	if err != nil {
+		return nil, err
+	}
+
+	p := f.profile
+	hits := lineCounts(p, src)
+	counts := map[int][]int{}
+
+	srcLines := splitLines(src)
+	for _, b := range p.Blocks {
+		for l := firstLine(b, srcLines); l <= lastLine(b, srcLines); l++ {
+			counts[l] = append(counts[l], b.Count)
+		}
+	}
+
+	lines := make([]*lineStat, 0, len(hits))
+	for l, h := range hits {
+		lines = append(lines, &lineStat{line: l, hits: h, counts: counts[l]})
+	}
+
+	sort.Slice(lines, func(i, j int) bool { return lines[i].line < lines[j].line })
+	return lines, nil
+}
+
+// sourcePath returns the path of the file's source, relative to the
+// working directory if it lies below it, or its import path if the source
+// was not located.
+func sourcePath(f *templateFile) string {
+	if f.file == "" {
+		return f.Name
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return f.file
+	}
+
+	rel, err := filepath.Rel(wd, f.file)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return f.file
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// lcovOutput writes an lcov tracefile.
+func lcovOutput(opts Options) error {
+	return writeFormat(opts, merged(writeLCOV))
+}
+
+// writeLCOV writes the line coverage of the report in the lcov tracefile
+// format read by genhtml and Coveralls. Lines shared by several blocks
+// also get a branch record per block.
+func writeLCOV(w io.Writer, d *templateData) error {
+	bw := bufio.NewWriter(w)
+
+	for _, f := range d.Files {
+		fmt.Fprintf(bw, "TN:\nSF:%s\n", sourcePath(f))
+
+		var found, hit, branches, taken int
+		lines, err := fileLines(f)
+		if err != nil {
+			return err
+		}
+
+		for _, l := range lines {
+			if len(l.counts) < 2 {
+				continue
+			}
+
+			for i, c := range l.counts {
+				fmt.Fprintf(bw, "BRDA:%d,0,%d,%d\n", l.line, i, c)
+				branches++
+				if c > 0 {
+					taken++
+				}
+			}
+		}
+
+		if branches > 0 {
+			fmt.Fprintf(bw, "BRF:%d\nBRH:%d\n", branches, taken)
+		}
+
+		for _, l := range lines {
+			fmt.Fprintf(bw, "DA:%d,%d\n", l.line, l.hits)
+			found++
+			if l.hits > 0 {
+				hit++
+			}
+		}
+
+		fmt.Fprintf(bw, "LF:%d\nLH:%d\nend_of_record\n", found, hit)
+	}
+
+	return bw.Flush()
+}
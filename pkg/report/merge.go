@@ -0,0 +1,283 @@
+package report
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/cover"
+)
+
+// lineEnd is used as the end column of line based blocks, which always
+// extend to the end of their line.
+const lineEnd = math.MaxInt32
+
+// stdinName is the profile name that reads the profile from stdin.
+const stdinName = "-"
+
+// stdinProfile holds the profile read from stdin, which can only be read
+// once but may be parsed for several reports.
+var stdinProfile []byte
+
+// parseProfile parses the named profile file, or stdin if name is "-".
+func parseProfile(name string) ([]*cover.Profile, error) {
+	if name != stdinName {
+		return cover.ParseProfiles(name)
+	}
+
+	if stdinProfile == nil {
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+
+		stdinProfile = profileLines(b)
+	}
+
+	if len(bytes.TrimSpace(stdinProfile)) == 0 {
+		return nil, errors.New("no coverage profile on stdin")
+	}
+
+	return cover.ParseProfilesFromReader(bytes.NewReader(stdinProfile))
+}
+
+// profileLine matches a block line of a coverage profile.
+var profileLine = regexp.MustCompile(`^.+:[0-9]+\.[0-9]+,[0-9]+\.[0-9]+ [0-9]+ [0-9]+$`)
+
+// profileLines returns the coverage profile contained in b, dropping any
+// other output mixed into it, such as the results printed by
+// go test -coverprofile=/dev/stdout.
+func profileLines(b []byte) []byte {
+	var out bytes.Buffer
+	mode := false
+
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case strings.HasPrefix(line, "mode: "):
+			if mode {
+				continue
+			}
+			mode = true
+		case !mode || !profileLine.MatchString(line):
+			continue
+		}
+
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+
+	return out.Bytes()
+}
+
+// parseProfiles parses every named profile and merges them into a single
+// set of per-file profiles, in the order files are first seen. Warnings
+// of mergeProfiles go to warn.
+func parseProfiles(names []string, warn io.Writer) ([]*cover.Profile, error) {
+	var merged []*cover.Profile
+
+	for _, name := range names {
+		profiles, err := parseProfile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		merged, err = mergeProfiles(merged, profiles, warn)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	return merged, nil
+}
+
+// readProfiles parses and merges the profile files names like
+// parseProfiles, renaming their files with remapProfiles.
+func readProfiles(names []string, opts Options) ([]*cover.Profile, error) {
+	profiles, err := parseProfiles(names, opts.Warnings)
+	if err != nil {
+		return nil, err
+	}
+
+	return remapProfiles(profiles, opts)
+}
+
+// mergeProfiles merges the profiles of src into dst. All of them must be
+// of the same mode. Files present in both with the same block layout have
+// their block counts combined; files whose blocks differ, e.g. because
+// they were compiled with different build tags, are merged by line
+// instead (see mergeLines), which is warned about on warn.
+func mergeProfiles(dst, src []*cover.Profile, warn io.Writer) ([]*cover.Profile, error) {
+	files := map[string]int{}
+	for k, p := range dst {
+		files[p.FileName] = k
+	}
+
+	for _, p := range src {
+		if len(dst) > 0 && p.Mode != dst[0].Mode {
+			return nil, fmt.Errorf("can't merge %s: mode %q does not match %q", p.FileName, p.Mode, dst[0].Mode)
+		}
+
+		k, ok := files[p.FileName]
+		if !ok {
+			files[p.FileName] = len(dst)
+			dst = append(dst, p)
+			continue
+		}
+
+		q := dst[k]
+
+		if sameLayout(q, p) {
+			for i := range q.Blocks {
+				q.Blocks[i].Count = mergeCount(q.Mode, q.Blocks[i].Count, p.Blocks[i].Count)
+			}
+
+			continue
+		}
+
+		warnf(warn, "%s: block layouts differ, merging by line", p.FileName)
+		dst[k] = mergeLines(q, p)
+	}
+
+	return dst, nil
+}
+
+// sameLayout reports whether both profiles consist of identical blocks.
+func sameLayout(a, b *cover.Profile) bool {
+	if len(a.Blocks) != len(b.Blocks) {
+		return false
+	}
+
+	for i, x := range a.Blocks {
+		y := b.Blocks[i]
+		if x.StartLine != y.StartLine || x.StartCol != y.StartCol ||
+			x.EndLine != y.EndLine || x.EndCol != y.EndCol || x.NumStmt != y.NumStmt {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeCount combines two execution counts of the same block. Counts are
+// summed in count and atomic mode and OR'd in set mode.
+func mergeCount(mode string, a, b int) int {
+	if mode == "set" {
+		if a > 0 || b > 0 {
+			return 1
+		}
+
+		return 0
+	}
+
+	return a + b
+}
+
+// lineCounts returns the execution count of every source line holding
+// statements of the profile. A line shared by several blocks takes the
+// lowest count so a partially executed line is not reported as covered.
+// src is the source of the file, or nil if it isn't known, see firstLine
+// and lastLine.
+func lineCounts(p *cover.Profile, src []byte) map[int]int {
+	lines := map[int]int{}
+	srcLines := splitLines(src)
+
+	for _, b := range p.Blocks {
+		for l := firstLine(b, srcLines); l <= lastLine(b, srcLines); l++ {
+			if c, ok := lines[l]; !ok || b.Count < c {
+				lines[l] = b.Count
+			}
+		}
+	}
+
+	return lines
+}
+
+// splitLines returns the lines of src, nil if src is.
+func splitLines(src []byte) [][]byte {
+	if src == nil {
+		return nil
+	}
+
+	return bytes.Split(src, []byte("\n"))
+}
+
+// firstLine returns the first line of the block that holds more than the
+// brace opening it. Blocks start right after the brace, at the end of a
+// line like func f() {, which only the source lines tell.
+func firstLine(b cover.ProfileBlock, lines [][]byte) int {
+	start := b.StartLine
+	if start <= len(lines) && b.StartCol-1 <= len(lines[start-1]) && b.StartLine < b.EndLine {
+		rest := string(bytes.TrimSpace(lines[start-1][b.StartCol-1:]))
+		if rest == "{" || rest == "" {
+			start++
+		}
+	}
+
+	return start
+}
+
+// lastLine returns the last line of the block that holds more than its
+// closing brace. End columns are exclusive, so a block ending in the first
+// column doesn't reach its end line, and most blocks end right after the
+// brace closing them, which is only found in the source lines.
+func lastLine(b cover.ProfileBlock, lines [][]byte) int {
+	end := b.EndLine
+	if b.EndCol <= 1 {
+		end--
+	} else if end <= len(lines) && b.EndCol-1 <= len(lines[end-1]) {
+		rest := string(bytes.TrimSpace(lines[end-1][:b.EndCol-1]))
+		if rest == "}" || rest == "" {
+			end--
+		}
+	}
+
+	if end < b.StartLine {
+		return b.StartLine
+	}
+
+	return end
+}
+
+// mergeLines merges two profiles of the same file whose blocks don't line
+// up by taking the union of their covered lines. The result has one block
+// per line counting as a single statement, so coverage of such a file is
+// reported in lines rather than statements and is less precise than a
+// positional merge.
+func mergeLines(a, b *cover.Profile) *cover.Profile {
+	lines := lineCounts(a, nil)
+	for l, c := range lineCounts(b, nil) {
+		if x, ok := lines[l]; ok {
+			c = mergeCount(a.Mode, x, c)
+		}
+
+		lines[l] = c
+	}
+
+	nums := make([]int, 0, len(lines))
+	for l := range lines {
+		nums = append(nums, l)
+	}
+	sort.Ints(nums)
+
+	p := &cover.Profile{FileName: a.FileName, Mode: a.Mode}
+	for _, l := range nums {
+		p.Blocks = append(p.Blocks, cover.ProfileBlock{
+			StartLine: l,
+			StartCol:  1,
+			EndLine:   l,
+			EndCol:    lineEnd,
+			NumStmt:   1,
+			Count:     lines[l],
+		})
+	}
+
+	return p
+}
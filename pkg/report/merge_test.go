@@ -0,0 +1,162 @@
+package report
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func TestLineCountsBraces(t *testing.T) {
+	src := []byte(`package p
+
+func F(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+`)
+
+	p := &cover.Profile{FileName: "example.com/p/p.go", Mode: "set", Blocks: []cover.ProfileBlock{
+		{StartLine: 3, StartCol: 19, EndLine: 4, EndCol: 11, NumStmt: 1, Count: 1},
+		{StartLine: 4, StartCol: 11, EndLine: 6, EndCol: 3, NumStmt: 1, Count: 0},
+		{StartLine: 7, StartCol: 2, EndLine: 7, EndCol: 10, NumStmt: 1, Count: 1},
+	}}
+
+	want := map[int]int{4: 1, 5: 0, 7: 1}
+	if got := lineCounts(p, src); !reflect.DeepEqual(got, want) {
+		t.Errorf("lineCounts() = %v, want %v", got, want)
+	}
+
+	// Without source only a block ending in the first column is trimmed.
+	p.Blocks[1].EndLine, p.Blocks[1].EndCol = 6, 1
+	want = map[int]int{3: 1, 4: 0, 5: 0, 7: 1}
+	if got := lineCounts(p, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("lineCounts() without source = %v, want %v", got, want)
+	}
+}
+
+func TestMergeProfiles(t *testing.T) {
+	block := func(line, count int) cover.ProfileBlock {
+		return cover.ProfileBlock{StartLine: line, StartCol: 2, EndLine: line, EndCol: 12, NumStmt: 1, Count: count}
+	}
+
+	profile := func(name, mode string, blocks ...cover.ProfileBlock) *cover.Profile {
+		return &cover.Profile{FileName: name, Mode: mode, Blocks: blocks}
+	}
+
+	tests := []struct {
+		mode     string
+		dst, src []int
+		want     []int
+	}{
+		{"count", []int{2, 0}, []int{3, 1}, []int{5, 1}},
+		{"atomic", []int{2, 0}, []int{3, 0}, []int{5, 0}},
+		{"set", []int{1, 0}, []int{0, 0}, []int{1, 0}},
+	}
+
+	for _, tt := range tests {
+		dst := []*cover.Profile{profile("example.com/p/a.go", tt.mode, block(3, tt.dst[0]), block(4, tt.dst[1]))}
+		src := []*cover.Profile{
+			profile("example.com/p/a.go", tt.mode, block(3, tt.src[0]), block(4, tt.src[1])),
+			profile("example.com/p/b.go", tt.mode, block(7, 1)),
+		}
+
+		got, err := mergeProfiles(dst, src, nil)
+		if err != nil {
+			t.Fatalf("mergeProfiles() in %s mode = %v", tt.mode, err)
+		}
+
+		if len(got) != 2 || got[1].FileName != "example.com/p/b.go" || got[1].Blocks[0].Count != 1 {
+			t.Fatalf("mergeProfiles() in %s mode = %d files, want a.go and b.go", tt.mode, len(got))
+		}
+
+		var counts []int
+		for _, b := range got[0].Blocks {
+			counts = append(counts, b.Count)
+		}
+
+		if !reflect.DeepEqual(counts, tt.want) {
+			t.Errorf("mergeProfiles() in %s mode of %v and %v = %v, want %v", tt.mode, tt.dst, tt.src, counts, tt.want)
+		}
+	}
+
+	// A block only one of the profiles has is kept when they are merged
+	// by line.
+	dst := []*cover.Profile{profile("example.com/p/a.go", "set", block(3, 1))}
+	src := []*cover.Profile{profile("example.com/p/a.go", "set", block(3, 0), block(5, 1))}
+
+	got, err := mergeProfiles(dst, src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := map[int]int{}
+	for _, b := range got[0].Blocks {
+		counts[b.StartLine] = b.Count
+	}
+
+	if want := map[int]int{3: 1, 5: 1}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("mergeProfiles() of differing layouts = %v by line, want %v", counts, want)
+	}
+}
+
+func TestMergeProfilesModes(t *testing.T) {
+	count := []*cover.Profile{{FileName: "example.com/p/a.go", Mode: "count"}}
+	set := []*cover.Profile{{FileName: "example.com/p/b.go", Mode: "set"}}
+
+	if _, err := mergeProfiles(count, set, nil); err == nil {
+		t.Error("mergeProfiles() = nil error for profiles of different modes")
+	}
+}
+
+// withStdin runs f with os.Stdin reading input through a pipe.
+func withStdin(t *testing.T, input string, f func()) {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	go func() {
+		w.WriteString(input)
+		w.Close()
+	}()
+
+	stdin := os.Stdin
+	os.Stdin, stdinProfile = r, nil
+	defer func() { os.Stdin, stdinProfile = stdin, nil }()
+
+	f()
+}
+
+func TestParseProfileStdin(t *testing.T) {
+	// go test -coverprofile=/dev/stdout mixes its results into the profile.
+	input := "=== RUN   TestF\n--- PASS: TestF (0.00s)\nmode: set\nexample.com/p/p.go:3.20,5.2 1 1\nPASS\r\ncoverage: 100.0% of statements\nok  \texample.com/p\t0.01s\n"
+
+	withStdin(t, input, func() {
+		for i := 0; i < 2; i++ {
+			profiles, err := parseProfile(stdinName)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(profiles) != 1 || profiles[0].FileName != "example.com/p/p.go" || len(profiles[0].Blocks) != 1 {
+				t.Fatalf("parseProfile(%q) = %+v, want the single block of example.com/p/p.go", stdinName, profiles)
+			}
+		}
+	})
+}
+
+func TestParseProfileEmptyStdin(t *testing.T) {
+	withStdin(t, "", func() {
+		_, err := parseProfile(stdinName)
+		if err == nil || err.Error() != "no coverage profile on stdin" {
+			t.Errorf("parseProfile(%q) with empty stdin = %v, want no coverage profile on stdin", stdinName, err)
+		}
+	})
+}
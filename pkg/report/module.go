@@ -0,0 +1,300 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/tools/cover"
+)
+
+// packageDirs caches the source directories of packages by import path,
+// files are resolved concurrently so it is guarded by packageDirsMu.
+var (
+	packageDirs   = map[string]string{}
+	packageDirsMu sync.Mutex
+)
+
+// fetchOnce makes resolveFile populate the module cache at most once per
+// run, fetched records whether that worked.
+var (
+	fetchOnce sync.Once
+	fetched   bool
+)
+
+// mainModules returns the paths of the main modules of the working
+// directory as reported by go list. It returns nil outside of module mode,
+// in which case every file is treated as belonging to the main module.
+func mainModules(timeout time.Duration) []string {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	out, err := exec.CommandContext(ctx, "go", "list", "-m").Output()
+	if err != nil {
+		return nil
+	}
+
+	return strings.Fields(string(out))
+}
+
+// isDependency reports whether the file, named by import path, lies
+// outside all of the given main modules.
+func isDependency(file string, modules []string) bool {
+	if len(modules) == 0 {
+		return false
+	}
+
+	for _, m := range modules {
+		if strings.HasPrefix(file, m+"/") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ModuleDir maps the files of a module, or of any import path prefix, to
+// the directory holding its source.
+type ModuleDir struct {
+	Path string
+	Dir  string
+}
+
+// mappedFile returns the file named by import path in the directory of
+// the longest matching entry of dirs, or "" if none matches.
+func mappedFile(name string, dirs []ModuleDir) string {
+	var best ModuleDir
+
+	for _, d := range dirs {
+		if strings.HasPrefix(name, d.Path+"/") && len(d.Path) > len(best.Path) {
+			best = d
+		}
+	}
+
+	if best.Path == "" {
+		return ""
+	}
+
+	return filepath.Join(best.Dir, filepath.FromSlash(strings.TrimPrefix(name, best.Path+"/")))
+}
+
+// resolveFile locates the source of the named profile file with
+// opts.Resolver if set, otherwise like findFile, looking in the
+// directories given in opts.ModuleDirs first.
+// With opts.FetchDeps set, a file that can't be found triggers a single
+// go mod download to populate the module cache before trying again, which
+// makes reports including dependencies work on a fresh machine.
+func resolveFile(name string, opts Options) (string, error) {
+	if opts.Resolver != nil {
+		return opts.Resolver(name)
+	}
+
+	if file := mappedFile(name, opts.ModuleDirs); file != "" {
+		if _, err := os.Stat(file); err != nil {
+			return "", fmt.Errorf("can't find %q: %v", name, err)
+		}
+
+		return file, nil
+	}
+
+	file, err := findFile(name, opts.Timeout)
+	if err == nil || !opts.FetchDeps {
+		return file, err
+	}
+
+	fetchOnce.Do(func() {
+		if derr := downloadModules(opts.Timeout); derr != nil {
+			warnf(opts.Warnings, "go mod download: %v", derr)
+			return
+		}
+
+		fetched = true
+		forgetMissingPackages()
+	})
+
+	if !fetched {
+		return "", err
+	}
+
+	return findFile(name, opts.Timeout)
+}
+
+// remapProfiles renames the files of profiles with opts.TrimPrefix and
+// the longest matching prefix of opts.SrcMap. Files renamed to the same
+// name are merged.
+func remapProfiles(profiles []*cover.Profile, opts Options) ([]*cover.Profile, error) {
+	if opts.TrimPrefix == "" && len(opts.SrcMap) == 0 {
+		return profiles, nil
+	}
+
+	for _, p := range profiles {
+		p.FileName = remapName(p.FileName, opts)
+	}
+
+	return mergeProfiles(nil, profiles, opts.Warnings)
+}
+
+// remapName returns the profile file name name renamed like
+// remapProfiles does.
+func remapName(name string, opts Options) string {
+	if opts.TrimPrefix != "" && strings.HasPrefix(name, opts.TrimPrefix) {
+		name = strings.TrimPrefix(name[len(opts.TrimPrefix):], "/")
+	}
+
+	best := -1
+	for i, m := range opts.SrcMap {
+		if strings.HasPrefix(name, m.Old) && (best < 0 || len(m.Old) > len(opts.SrcMap[best].Old)) {
+			best = i
+		}
+	}
+
+	if best >= 0 {
+		m := opts.SrcMap[best]
+		name = m.New + name[len(m.Old):]
+	}
+
+	return name
+}
+
+// resolveSource is resolveFile for the files of reports. With
+// opts.SkipMissing set, a file whose source can't be found or read is
+// only warned about on opts.Warnings and its path returned empty.
+func resolveSource(name string, opts Options) (string, error) {
+	file, err := resolveFile(name, opts)
+	if !opts.SkipMissing {
+		return file, err
+	}
+
+	if err == nil {
+		if _, err = os.Stat(file); err == nil {
+			return file, nil
+		}
+	}
+
+	warnf(opts.Warnings, "%v, reporting %s without source", err, name)
+	return "", nil
+}
+
+// downloadModules runs go mod download for the module in the working
+// directory. The go command inherits the environment, so GOFLAGS, GOPROXY
+// and the like apply as usual.
+func downloadModules(timeout time.Duration) error {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, "go", "mod", "download")
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// goList runs go list -e with the given arguments and returns the source
+// directory of every package it could find. Network access is disabled,
+// like go/build does, so missing modules are only downloaded through
+// -fetch-deps. A positive timeout kills go list when it runs longer.
+func goList(timeout time.Duration, pkgs ...string) map[string]string {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	args := append([]string{"list", "-e", "-f", "{{.ImportPath}}\t{{.Dir}}"}, pkgs...)
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Env = append(os.Environ(), "GOPROXY=off")
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+
+	dirs := map[string]string{}
+	for _, line := range strings.Split(string(out), "\n") {
+		pkg, dir, ok := strings.Cut(line, "\t")
+		if ok && dir != "" {
+			dirs[pkg] = dir
+		}
+	}
+
+	return dirs
+}
+
+// listPackageDirs looks up the directories of all packages in profiles
+// with a single go list call, so findFile needn't run one per package.
+// Packages go list can't find are cached as "" too, and looked up again
+// by the next report.
+func listPackageDirs(profiles []*cover.Profile, timeout time.Duration) {
+	var pkgs []string
+	seen := map[string]bool{}
+
+	packageDirsMu.Lock()
+	for _, p := range profiles {
+		pkg := path.Dir(p.FileName)
+		if packageDirs[pkg] == "" && !seen[pkg] {
+			seen[pkg] = true
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	packageDirsMu.Unlock()
+
+	if len(pkgs) == 0 {
+		return
+	}
+
+	dirs := goList(timeout, pkgs...)
+
+	packageDirsMu.Lock()
+	defer packageDirsMu.Unlock()
+
+	for _, pkg := range pkgs {
+		packageDirs[pkg] = dirs[pkg]
+	}
+}
+
+// forgetMissingPackages drops the packages go list couldn't find from
+// packageDirs, so they are looked up again.
+func forgetMissingPackages() {
+	packageDirsMu.Lock()
+	defer packageDirsMu.Unlock()
+
+	for pkg, dir := range packageDirs {
+		if dir == "" {
+			delete(packageDirs, pkg)
+		}
+	}
+}
+
+// packageDir returns the source directory of the package, or "" if go list
+// can't find it. The result is cached either way, go list runs without
+// holding packageDirsMu so lookups of other packages don't wait for it.
+func packageDir(pkg string, timeout time.Duration) string {
+	packageDirsMu.Lock()
+	dir, ok := packageDirs[pkg]
+	packageDirsMu.Unlock()
+
+	if ok {
+		return dir
+	}
+
+	dir = goList(timeout, pkg)[pkg]
+
+	packageDirsMu.Lock()
+	defer packageDirsMu.Unlock()
+
+	packageDirs[pkg] = dir
+	return dir
+}
@@ -0,0 +1,83 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindFileModule(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":            "module example.com/layout\n\ngo 1.21\n",
+		"root.go":           "package layout\n",
+		"internal/a/a.go":   "package a\n",
+		"internal/a/b c.go": "package a\n",
+		"cmd/tool/main.go":  "package main\n",
+		"nested/go.mod":     "module example.com/layout/nested\n\ngo 1.21\n",
+		"nested/n/n.go":     "package n\n",
+		"nested/n/n_gen.go": "package n\n",
+	}
+	for name, src := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The nested module is part of a workspace with the outer one, as in
+	// a monorepo.
+	err := os.WriteFile(filepath.Join(dir, "go.work"), []byte("go 1.21\n\nuse (\n\t.\n\t./nested\n)\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Chdir(dir)
+	t.Setenv("GOFLAGS", "")
+	packageDirs = map[string]string{}
+	t.Cleanup(func() { packageDirs = map[string]string{} })
+
+	for name, want := range map[string]string{
+		"example.com/layout/root.go":           "root.go",
+		"example.com/layout/internal/a/a.go":   "internal/a/a.go",
+		"example.com/layout/internal/a/b c.go": "internal/a/b c.go",
+		"example.com/layout/cmd/tool/main.go":  "cmd/tool/main.go",
+		"example.com/layout/nested/n/n.go":     "nested/n/n.go",
+		"example.com/layout/nested/n/n_gen.go": "nested/n/n_gen.go",
+	} {
+		got, err := findFile(name, 0)
+		if err != nil {
+			t.Errorf("findFile(%q) = %v", name, err)
+			continue
+		}
+
+		if want = filepath.Join(dir, filepath.FromSlash(want)); got != want {
+			t.Errorf("findFile(%q) = %q, want %q", name, got, want)
+		}
+	}
+
+	if packageDirs["example.com/layout/nested/n"] == "" {
+		t.Error("the nested module package wasn't found with go list")
+	}
+}
+
+func TestMappedFile(t *testing.T) {
+	dirs := []ModuleDir{
+		{Path: "example.com/mod", Dir: "/src/mod"},
+		{Path: "example.com/mod/sub", Dir: "/src/sub"},
+	}
+
+	for name, want := range map[string]string{
+		"example.com/mod/a/a.go":   filepath.FromSlash("/src/mod/a/a.go"),
+		"example.com/mod/sub/s.go": filepath.FromSlash("/src/sub/s.go"),
+		"example.com/module/m.go":  "",
+		"example.com/other/o.go":   "",
+	} {
+		if got := mappedFile(name, dirs); got != want {
+			t.Errorf("mappedFile(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
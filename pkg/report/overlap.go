@@ -0,0 +1,133 @@
+package report
+
+import (
+	"fmt"
+
+	"golang.org/x/tools/cover"
+)
+
+// overlapCounts holds the number of statements of a file, or of a whole
+// report, split by which of two profile sets cover them.
+type overlapCounts struct {
+	Name    string
+	Both    int64
+	OnlyA   int64
+	OnlyB   int64
+	Neither int64
+}
+
+// overlapReport compares the coverage of two labelled profile sets.
+type overlapReport struct {
+	A     string
+	B     string
+	Files []*overlapCounts
+	Total overlapCounts
+}
+
+// add counts n statements with the given coverage in a and b.
+func (c *overlapCounts) add(a, b bool, n int64) {
+	switch {
+	case a && b:
+		c.Both += n
+	case a:
+		c.OnlyA += n
+	case b:
+		c.OnlyB += n
+	default:
+		c.Neither += n
+	}
+}
+
+// getOverlap compares which statements are covered by each of the two
+// profile sets in opts.Profiles. Files whose blocks line up in both sets
+// are compared by statement, others by line like mergeLines does. Files
+// are selected by opts.Include and opts.Exclude.
+func getOverlap(opts Options) (*overlapReport, error) {
+	if len(opts.Profiles) != 2 {
+		return nil, fmt.Errorf("overlap needs exactly two profile sets, got %d", len(opts.Profiles))
+	}
+
+	a, err := readProfiles(opts.Profiles[0].Paths, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := readProfiles(opts.Profiles[1].Paths, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &overlapReport{A: opts.Profiles[0].Label, B: opts.Profiles[1].Label, Total: overlapCounts{Name: "total"}}
+
+	byName := map[string]*cover.Profile{}
+	for _, p := range b {
+		byName[p.FileName] = p
+	}
+
+	for _, p := range a {
+		if excludedName(p.FileName, opts) {
+			delete(byName, p.FileName)
+			continue
+		}
+
+		c := compareProfiles(p, byName[p.FileName])
+		delete(byName, p.FileName)
+		r.Files = append(r.Files, c)
+	}
+
+	for _, p := range b {
+		if _, ok := byName[p.FileName]; ok && !excludedName(p.FileName, opts) {
+			r.Files = append(r.Files, compareProfiles(nil, p))
+		}
+	}
+
+	for _, c := range r.Files {
+		r.Total.Both += c.Both
+		r.Total.OnlyA += c.OnlyA
+		r.Total.OnlyB += c.OnlyB
+		r.Total.Neither += c.Neither
+	}
+
+	return r, nil
+}
+
+// compareProfiles compares two profiles of the same file, either of which
+// may be nil if the file is missing from its set.
+func compareProfiles(a, b *cover.Profile) *overlapCounts {
+	switch {
+	case a == nil:
+		c := &overlapCounts{Name: b.FileName}
+		for _, x := range b.Blocks {
+			c.add(false, x.Count > 0, int64(x.NumStmt))
+		}
+		return c
+	case b == nil:
+		c := &overlapCounts{Name: a.FileName}
+		for _, x := range a.Blocks {
+			c.add(x.Count > 0, false, int64(x.NumStmt))
+		}
+		return c
+	}
+
+	c := &overlapCounts{Name: a.FileName}
+	if sameLayout(a, b) {
+		for i, x := range a.Blocks {
+			c.add(x.Count > 0, b.Blocks[i].Count > 0, int64(x.NumStmt))
+		}
+
+		return c
+	}
+
+	la, lb := lineCounts(a, nil), lineCounts(b, nil)
+	for l, n := range la {
+		m, ok := lb[l]
+		c.add(n > 0, ok && m > 0, 1)
+		delete(lb, l)
+	}
+
+	for _, m := range lb {
+		c.add(false, m > 0, 1)
+	}
+
+	return c
+}
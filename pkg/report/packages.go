@@ -0,0 +1,73 @@
+package report
+
+import (
+	"path"
+	"path/filepath"
+)
+
+// packageStats holds the aggregated statement counts of one package.
+type packageStats struct {
+	Path       string
+	Covered    int64
+	Statements int64
+	Files      []*templateFile
+
+	// Floor is the minimum coverage the package is gated on, declared in
+	// FloorSource: its coverage.yaml or -min-package. FloorSource is ""
+	// for packages without a floor.
+	Floor       float64
+	FloorSource string
+}
+
+// Coverage returns the statement weighted coverage of the package as a
+// percentage.
+func (p *packageStats) Coverage() float64 {
+	if p.Statements == 0 {
+		return 0
+	}
+
+	return float64(p.Covered) / float64(p.Statements) * 100
+}
+
+// Uncovered returns the number of statements of the package that were not
+// executed.
+func (p *packageStats) Uncovered() int64 {
+	return p.Statements - p.Covered
+}
+
+// dir returns the directory holding the package source, taken from the
+// first of its files whose source was found, or "" if none was.
+func (p *packageStats) dir() string {
+	for _, f := range p.Files {
+		if f.file != "" {
+			return filepath.Dir(f.file)
+		}
+	}
+
+	return ""
+}
+
+// groupPackages aggregates files by their package import path, which is
+// the file name without its final element. Packages are returned in the
+// order they are first seen.
+func groupPackages(files []*templateFile) []*packageStats {
+	var pkgs []*packageStats
+	index := map[string]*packageStats{}
+
+	for _, f := range files {
+		dir := path.Dir(f.Name)
+
+		p, ok := index[dir]
+		if !ok {
+			p = &packageStats{Path: dir}
+			index[dir] = p
+			pkgs = append(pkgs, p)
+		}
+
+		p.Covered += f.Covered
+		p.Statements += f.Statements
+		p.Files = append(p.Files, f)
+	}
+
+	return pkgs
+}
@@ -0,0 +1,52 @@
+package report
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestGroupPackages(t *testing.T) {
+	files := []*templateFile{
+		{Name: "example.com/p/a.go", Covered: 1, Statements: 4, file: "/src/p/a.go"},
+		{Name: "example.com/q/c.go", Covered: 0, Statements: 10},
+		{Name: "example.com/p/b.go", Covered: 3, Statements: 4},
+		{Name: "example.com/q/d.go", Covered: 10, Statements: 10, file: "/src/q/d.go"},
+	}
+
+	pkgs := groupPackages(files)
+	if len(pkgs) != 2 {
+		t.Fatalf("groupPackages() returned %d packages, want 2", len(pkgs))
+	}
+
+	tests := []struct {
+		path                string
+		covered, statements int64
+		coverage            float64
+		files               int
+		dir                 string
+	}{
+		{"example.com/p", 4, 8, 50, 2, "/src/p"},
+		{"example.com/q", 10, 20, 50, 2, "/src/q"},
+	}
+
+	for i, tt := range tests {
+		p := pkgs[i]
+		if p.Path != tt.path || p.Covered != tt.covered || p.Statements != tt.statements || len(p.Files) != tt.files {
+			t.Errorf("package %d = %s %d/%d with %d files, want %s %d/%d with %d files",
+				i, p.Path, p.Covered, p.Statements, len(p.Files), tt.path, tt.covered, tt.statements, tt.files)
+		}
+
+		if got := p.Coverage(); got != tt.coverage {
+			t.Errorf("%s coverage = %v, want %v", p.Path, got, tt.coverage)
+		}
+
+		if got := p.dir(); got != filepath.FromSlash(tt.dir) {
+			t.Errorf("%s dir() = %q, want %q", p.Path, got, tt.dir)
+		}
+	}
+
+	// The package totals add up to the statement weighted total.
+	if got, want := filesCoverage(files), 14.0/28*100; got != want {
+		t.Errorf("filesCoverage() = %v, want %v", got, want)
+	}
+}
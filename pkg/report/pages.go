@@ -0,0 +1,194 @@
+package report
+
+import (
+	"html/template"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// pagesOutput writes the HTML report to the directory opts.OutDir as an
+// index.html with the overview, a page per package under packages/ and a
+// page per file under files/, all linking to the assets under assets/.
+// Every page only holds a single source, so large reports stay usable.
+// Labelled profile sets are written merged.
+func pagesOutput(opts Options) error {
+	dir := opts.OutDir
+
+	d, _, err := loadReports(opts)
+	if err != nil {
+		return err
+	}
+
+	d.Pages = true
+	d.Prefix = ""
+	d.Collapsed = false
+
+	for _, sub := range []string{"assets", "files", "packages"} {
+		err = os.MkdirAll(filepath.Join(dir, sub), 0755)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = writeAssets(filepath.Join(dir, "assets"), opts)
+	if err != nil {
+		return err
+	}
+
+	it, vals, err := loadTemplate(opts, false)
+	if err != nil {
+		return err
+	}
+
+	vals["data"] = d
+	vals["totalCov"] = totalCoverage(d)
+	vals["assets"] = "../assets/"
+
+	pkgIndex := map[*templateFile]int{}
+	for i, p := range d.Packages {
+		for _, f := range p.Files {
+			pkgIndex[f] = i
+		}
+	}
+
+	// The file pages are written first, so an interrupt leaves a partial
+	// report whose index and package pages only link the pages written.
+	if !d.Overview {
+		errs := make([]error, len(d.Files))
+		written := make([]bool, len(d.Files))
+		parallel(len(d.Files), opts.Jobs, func(i int) {
+			if interrupted() {
+				return
+			}
+
+			f := d.Files[i]
+			page := map[string]interface{}{}
+			for k, v := range vals {
+				page[k] = v
+			}
+
+			page["page"] = "file"
+			page["file"] = f
+			page["pkg"] = d.Packages[pkgIndex[f]]
+			page["pkgIndex"] = pkgIndex[f]
+			page["prev"], page["next"] = (*templateFile)(nil), (*templateFile)(nil)
+			if i > 0 {
+				page["prev"] = d.Files[i-1]
+			}
+			if i < len(d.Files)-1 {
+				page["next"] = d.Files[i+1]
+			}
+
+			errs[i] = writePage(it, filepath.Join(dir, "files", pageName(f.ID)), page)
+			written[i] = errs[i] == nil
+		})
+
+		for _, err := range errs {
+			if err != nil {
+				return err
+			}
+		}
+
+		for i, f := range d.Files {
+			if !written[i] {
+				d.Partial = true
+				continue
+			}
+
+			f.paged = true
+		}
+	}
+
+	releaseInterrupts()
+
+	err = recordHistory(d, opts)
+	if err != nil {
+		return err
+	}
+
+	for i, p := range d.Packages {
+		vals["page"] = "package"
+		vals["pkg"] = p
+
+		err = writePage(it, filepath.Join(dir, "packages", pageName(i)), vals)
+		if err != nil {
+			return err
+		}
+	}
+
+	vals["page"] = ""
+	vals["assets"] = "assets/"
+	delete(vals, "pkg")
+
+	index := filepath.Join(dir, "index.html")
+	err = writePage(it, index, vals)
+	if err != nil {
+		return err
+	}
+
+	err = shipReport(dir, "", opts)
+	if err != nil {
+		return err
+	}
+
+	err = writeBadgeFile(d, opts)
+	if err != nil {
+		return err
+	}
+
+	err = runAfterCommand(opts.AfterCommand, index, opts.Timeout)
+	if err != nil {
+		return err
+	}
+
+	return checkGates(d, opts)
+}
+
+// pageName returns the file name of the page with the given index.
+func pageName(i int) string {
+	return strconv.Itoa(i) + ".html"
+}
+
+// writePage renders the report template with vals into the file name.
+func writePage(it *template.Template, name string, vals map[string]interface{}) error {
+	out, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	err = executeTemplate(it, out, vals)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
+
+// writeAssets copies the files of the asset groups selected in
+// opts.Assets to dir.
+func writeAssets(dir string, opts Options) error {
+	res := resourceFS(opts.ResDir)
+
+	for _, g := range AssetGroups {
+		if !opts.Assets[g] {
+			continue
+		}
+
+		for _, name := range assetFiles[g] {
+			b, err := fs.ReadFile(res, "res/"+name)
+			if err != nil {
+				return err
+			}
+
+			err = ioutil.WriteFile(filepath.Join(dir, name), b, 0644)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// changedFiles maps source files, by slash separated absolute path, to
+// their lines added or modified since a git ref.
+type changedFiles map[string]map[int]bool
+
+// changedLines returns the lines added or modified in the working tree
+// since ref, as reported by git diff. It isn't cached, the working tree
+// changes while Serve runs.
+func changedLines(ref string, timeout time.Duration) (changedFiles, error) {
+	root, err := gitOutput(timeout, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+
+	// The prefixes are set explicitly as diff.noprefix and
+	// diff.mnemonicPrefix would change them, and non-ASCII names are left
+	// unquoted so only names with special characters need unquoting.
+	diff, err := gitOutput(timeout, "-c", "core.quotePath=false", "diff", "--no-color", "--no-ext-diff",
+		"--src-prefix=a/", "--dst-prefix=b/", "-U0", ref, "--")
+	if err != nil {
+		return nil, err
+	}
+
+	c := changedFiles{}
+	var lines map[int]bool
+
+	sc := bufio.NewScanner(strings.NewReader(diff))
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		text := sc.Text()
+
+		switch {
+		case strings.HasPrefix(text, "+++ "):
+			lines = nil
+			if name := diffPath(text); name != "" {
+				lines = map[int]bool{}
+				c[filepath.ToSlash(filepath.Join(strings.TrimSpace(root), name))] = lines
+			}
+		case strings.HasPrefix(text, "@@") && lines != nil:
+			m := hunkHeader.FindStringSubmatch(text)
+			if m == nil {
+				continue
+			}
+
+			start, _ := strconv.Atoi(m[1])
+			n := 1
+			if m[2] != "" {
+				n, _ = strconv.Atoi(m[2])
+			}
+
+			for l := start; l < start+n; l++ {
+				lines[l] = true
+			}
+		}
+	}
+
+	return c, sc.Err()
+}
+
+// gitOutput runs git with args and returns its output.
+func gitOutput(timeout time.Duration, args ...string) (string, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return string(out), nil
+}
+
+// setPatchCoverage records which of the changed lines of the file hold
+// statements, and how many of those ran. src is the source of the file.
+func (f *templateFile) setPatchCoverage(changes changedFiles, src []byte) error {
+	abs, err := filepath.Abs(f.file)
+	if err != nil {
+		return err
+	}
+
+	changed := changes[filepath.ToSlash(abs)]
+	if len(changed) == 0 {
+		return nil
+	}
+
+	for l, c := range lineCounts(f.profile, src) {
+		if !changed[l] {
+			continue
+		}
+
+		f.PatchLines++
+		if c > 0 {
+			f.PatchCovered++
+		} else {
+			f.uncoveredChanges = append(f.uncoveredChanges, l)
+		}
+	}
+
+	sort.Ints(f.uncoveredChanges)
+	return nil
+}
+
+// PatchCoverage returns the share of the changed lines of the file holding
+// statements that ran, as a percentage.
+func (f *templateFile) PatchCoverage() float64 {
+	return patchCoverage(f.PatchCovered, f.PatchLines)
+}
+
+// patchCounts returns the number of covered and total changed lines
+// holding statements across the report.
+func (d *templateData) patchCounts() (covered, total int64) {
+	for _, f := range d.Files {
+		covered += f.PatchCovered
+		total += f.PatchLines
+	}
+
+	return covered, total
+}
+
+// PatchCoverage returns the coverage of the lines changed since
+// -diff across the report as a percentage.
+func (d *templateData) PatchCoverage() float64 {
+	return patchCoverage(d.patchCounts())
+}
+
+func patchCoverage(covered, total int64) float64 {
+	if total == 0 {
+		return 100
+	}
+
+	return float64(covered) / float64(total) * 100
+}
+
+// checkMinDiff fails when the coverage of the changed lines of the report
+// is below min percent. A zero min disables the check.
+func checkMinDiff(d *templateData, min float64) error {
+	if min <= 0 {
+		return nil
+	}
+
+	cov := d.PatchCoverage()
+	if cov < min {
+		return &CoverageError{
+			msg: fmt.Sprintf("patch coverage %s%% is below %s%%", formatCoverage(cov, d.precision), formatCoverage(min, d.precision)),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,524 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Providers are the code hosts Publish posts to.
+var Providers = []string{"github", "gitlab"}
+
+// PublishTarget is the pull or merge request Publish reports on. Fields
+// left empty are taken from the environment of GitHub Actions or GitLab
+// CI jobs.
+type PublishTarget struct {
+	// Provider is one of Providers.
+	Provider string
+	// Token authenticates the API requests, GITHUB_TOKEN or GITLAB_TOKEN
+	// by default.
+	Token string
+	// Repo is owner/name on GitHub and the project ID or path on GitLab.
+	Repo string
+	// Request is the number of the pull request or merge request. Without
+	// one only the changed lines are annotated.
+	Request string
+	// Commit is the commit the annotations are attached to, HEAD by
+	// default.
+	Commit string
+	// APIURL is the base URL of the API, for GitHub Enterprise or
+	// self-hosted GitLab.
+	APIURL string
+}
+
+// publishMarker is hidden in the summary comment so later runs update it
+// instead of adding another one.
+const publishMarker = "<!-- gocover-html -->"
+
+// worstFiles is the number of least covered files listed in the summary.
+const worstFiles = 5
+
+// maxAnnotations is the number of annotations GitHub accepts per request.
+const maxAnnotations = 50
+
+// uncoveredRange is a run of changed lines of a file that did not run,
+// path is relative to the repository root.
+type uncoveredRange struct {
+	path       string
+	start, end int
+}
+
+// Publish posts a summary of the coverage of the profile sets in
+// opts.Profiles as a comment on the pull or merge request of t, updating
+// the one of an earlier run, and annotates the changed lines that did
+// not run, on GitHub through a check run and on GitLab with commit
+// comments. Changed lines are those since opts.Diff, by default the base
+// of the pull or merge request of the CI job. The coverage gates are
+// checked like Run does once everything is posted.
+func Publish(opts Options, t PublishTarget) error {
+	opts = withDefaults(opts)
+
+	t, base, err := withCIDefaults(t, opts.Timeout)
+	if err != nil {
+		return err
+	}
+
+	if opts.Diff == "" {
+		opts.Diff = base
+	}
+
+	d, _, err := loadReports(opts)
+	if err != nil {
+		return err
+	}
+
+	releaseInterrupts()
+
+	gateErr := checkGates(d, opts)
+	if gateErr == ErrInterrupted {
+		return gateErr
+	}
+
+	ranges, err := uncoveredRanges(d, opts.Timeout)
+	if err != nil {
+		return err
+	}
+
+	c := &apiClient{provider: t.Provider, base: t.APIURL, token: t.Token, client: &http.Client{Timeout: opts.Timeout}}
+	summary := publishSummary(d, opts, gateErr)
+
+	if t.Provider == "github" {
+		err = publishGitHub(c, t, summary, ranges, gateErr == nil)
+	} else {
+		err = publishGitLab(c, t, summary, ranges)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return gateErr
+}
+
+// withCIDefaults fills in the fields of t left empty from the CI
+// environment and checks the result. It also returns the git ref of the
+// base of the pull or merge request of the job, if there is one.
+func withCIDefaults(t PublishTarget, timeout time.Duration) (PublishTarget, string, error) {
+	var base string
+
+	if t.Provider == "" {
+		switch {
+		case os.Getenv("GITHUB_ACTIONS") != "":
+			t.Provider = "github"
+		case os.Getenv("GITLAB_CI") != "":
+			t.Provider = "gitlab"
+		default:
+			return t, "", fmt.Errorf("no provider given and not running in GitHub Actions or GitLab CI")
+		}
+	}
+
+	switch t.Provider {
+	case "github":
+		var event struct {
+			PullRequest struct {
+				Number int `json:"number"`
+				Head   struct {
+					SHA string `json:"sha"`
+				} `json:"head"`
+			} `json:"pull_request"`
+		}
+
+		if name := os.Getenv("GITHUB_EVENT_PATH"); name != "" {
+			if b, err := ioutil.ReadFile(name); err == nil {
+				json.Unmarshal(b, &event)
+			}
+		}
+
+		t.Token = orEnv(t.Token, "GITHUB_TOKEN", "")
+		t.Repo = orEnv(t.Repo, "GITHUB_REPOSITORY", "")
+		t.APIURL = orEnv(t.APIURL, "GITHUB_API_URL", "https://api.github.com")
+		if t.Request == "" && event.PullRequest.Number > 0 {
+			t.Request = strconv.Itoa(event.PullRequest.Number)
+		}
+
+		// Pull request builds check out a merge commit, the annotations
+		// belong to the head of the pull request.
+		if t.Commit == "" {
+			t.Commit = event.PullRequest.Head.SHA
+		}
+
+		if ref := os.Getenv("GITHUB_BASE_REF"); ref != "" {
+			base = "origin/" + ref
+		}
+	case "gitlab":
+		t.Token = orEnv(t.Token, "GITLAB_TOKEN", "")
+		t.Repo = orEnv(t.Repo, "CI_PROJECT_ID", "")
+		t.APIURL = orEnv(t.APIURL, "CI_API_V4_URL", "https://gitlab.com/api/v4")
+		t.Request = orEnv(t.Request, "CI_MERGE_REQUEST_IID", "")
+		t.Commit = orEnv(t.Commit, "CI_COMMIT_SHA", "")
+		base = os.Getenv("CI_MERGE_REQUEST_DIFF_BASE_SHA")
+	default:
+		return t, "", fmt.Errorf("unknown provider %q", t.Provider)
+	}
+
+	if t.Commit == "" {
+		sha, err := gitOutput(timeout, "rev-parse", "HEAD")
+		if err != nil {
+			return t, "", err
+		}
+
+		t.Commit = strings.TrimSpace(sha)
+	}
+
+	if t.Token == "" {
+		return t, "", fmt.Errorf("no %s token given", t.Provider)
+	}
+
+	if t.Repo == "" {
+		return t, "", fmt.Errorf("no %s repository given", t.Provider)
+	}
+
+	t.APIURL = strings.TrimSuffix(t.APIURL, "/")
+	return t, base, nil
+}
+
+// orEnv returns v, or if it is empty the environment variable env, or
+// def if that is empty too.
+func orEnv(v, env, def string) string {
+	if v == "" {
+		v = os.Getenv(env)
+	}
+
+	if v == "" {
+		v = def
+	}
+
+	return v
+}
+
+// uncoveredRanges returns the runs of changed lines of every file of the
+// report that did not run.
+func uncoveredRanges(d *templateData, timeout time.Duration) ([]uncoveredRange, error) {
+	var ranges []uncoveredRange
+	var root string
+
+	for _, f := range d.Files {
+		if len(f.uncoveredChanges) == 0 {
+			continue
+		}
+
+		if root == "" {
+			out, err := gitOutput(timeout, "rev-parse", "--show-toplevel")
+			if err != nil {
+				return nil, err
+			}
+
+			root = strings.TrimSpace(out)
+		}
+
+		abs, err := filepath.Abs(f.file)
+		if err != nil {
+			return nil, err
+		}
+
+		rel, err := filepath.Rel(root, abs)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, l := range f.uncoveredChanges {
+			n := len(ranges)
+			if n > 0 && ranges[n-1].path == filepath.ToSlash(rel) && ranges[n-1].end == l-1 {
+				ranges[n-1].end = l
+				continue
+			}
+
+			ranges = append(ranges, uncoveredRange{filepath.ToSlash(rel), l, l})
+		}
+	}
+
+	return ranges, nil
+}
+
+// message describes the range in annotations.
+func (r uncoveredRange) message() string {
+	if r.start == r.end {
+		return fmt.Sprintf("Changed line %d is not covered by tests.", r.start)
+	}
+
+	return fmt.Sprintf("Changed lines %d-%d are not covered by tests.", r.start, r.end)
+}
+
+// publishSummary returns the Markdown summary of the report posted by
+// Publish, gateErr being the result of its coverage gates.
+func publishSummary(d *templateData, opts Options, gateErr error) string {
+	var b strings.Builder
+
+	covered, total := fileCounts(d.Files)
+	fmt.Fprintf(&b, "%s\n### Coverage report\n\n", publishMarker)
+	fmt.Fprintf(&b, "**Total coverage: %s%%** (%d of %d statements)\n", formatCoverage(totalCoverage(d), d.precision), covered, total)
+
+	if opts.Diff != "" {
+		pc, pt := d.patchCounts()
+		fmt.Fprintf(&b, "\n**Diff coverage: %s%%** (%d of %d changed lines since `%s`)\n", formatCoverage(d.PatchCoverage(), d.precision), pc, pt, opts.Diff)
+	}
+
+	if gateErr != nil {
+		fmt.Fprintf(&b, "\n:x: %s\n", gateErr)
+	}
+
+	worst := make([]*templateFile, 0, len(d.Files))
+	for _, f := range d.Files {
+		if f.Uncovered() > 0 {
+			worst = append(worst, f)
+		}
+	}
+
+	sortFiles(worst, "coverage")
+	if len(worst) > worstFiles {
+		worst = worst[:worstFiles]
+	}
+
+	if len(worst) > 0 {
+		b.WriteString("\n| Least covered files | Coverage | Uncovered statements |\n|---|---:|---:|\n")
+		for _, f := range worst {
+			fmt.Fprintf(&b, "| `%s` | %s%% | %d |\n", f.Name, formatCoverage(f.Coverage, d.precision), f.Uncovered())
+		}
+	}
+
+	return b.String()
+}
+
+// publishGitHub creates a check run of the commit of t annotating ranges,
+// passing if ok is set, and comments the summary on its pull request.
+func publishGitHub(c *apiClient, t PublishTarget, summary string, ranges []uncoveredRange, ok bool) error {
+	repo := "/repos/" + t.Repo
+
+	conclusion := "success"
+	if !ok {
+		conclusion = "failure"
+	}
+
+	type annotation struct {
+		Path      string `json:"path"`
+		StartLine int    `json:"start_line"`
+		EndLine   int    `json:"end_line"`
+		Level     string `json:"annotation_level"`
+		Title     string `json:"title"`
+		Message   string `json:"message"`
+	}
+
+	output := func(batch []uncoveredRange) map[string]interface{} {
+		a := []annotation{}
+		for _, r := range batch {
+			a = append(a, annotation{r.path, r.start, r.end, "warning", "Uncovered change", r.message()})
+		}
+
+		return map[string]interface{}{"title": "Coverage", "summary": summary, "annotations": a}
+	}
+
+	first := ranges
+	if len(first) > maxAnnotations {
+		first = first[:maxAnnotations]
+	}
+
+	var run struct {
+		ID int64 `json:"id"`
+	}
+
+	err := c.do("POST", repo+"/check-runs", map[string]interface{}{
+		"name":       "coverage",
+		"head_sha":   t.Commit,
+		"status":     "completed",
+		"conclusion": conclusion,
+		"output":     output(first),
+	}, &run)
+	if err != nil {
+		return err
+	}
+
+	for i := maxAnnotations; i < len(ranges); i += maxAnnotations {
+		end := i + maxAnnotations
+		if end > len(ranges) {
+			end = len(ranges)
+		}
+
+		err := c.do("PATCH", fmt.Sprintf("%s/check-runs/%d", repo, run.ID),
+			map[string]interface{}{"output": output(ranges[i:end])}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if t.Request == "" {
+		return nil
+	}
+
+	comments := fmt.Sprintf("%s/issues/%s/comments", repo, t.Request)
+	return c.upsertComment(comments, repo+"/issues/comments/", summary)
+}
+
+// publishGitLab comments the summary on the merge request of t and every
+// range on its commit.
+func publishGitLab(c *apiClient, t PublishTarget, summary string, ranges []uncoveredRange) error {
+	project := "/projects/" + url.PathEscape(t.Repo)
+	comments := project + "/repository/commits/" + t.Commit + "/comments"
+
+	// Commit comments can't be edited, so the ones left on the commit by
+	// an earlier run of the job are not posted again.
+	posted, err := c.commitComments(comments)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range ranges {
+		if posted[commitComment{r.message(), r.path, r.start}] {
+			continue
+		}
+
+		err := c.do("POST", comments, map[string]interface{}{
+			"note":      r.message(),
+			"path":      r.path,
+			"line":      r.start,
+			"line_type": "new",
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if t.Request == "" {
+		return nil
+	}
+
+	notes := fmt.Sprintf("%s/merge_requests/%s/notes", project, t.Request)
+	return c.upsertComment(notes, notes+"/", summary)
+}
+
+// commitComment is a GitLab comment on a line of a commit.
+type commitComment struct {
+	Note string `json:"note"`
+	Path string `json:"path"`
+	Line int    `json:"line"`
+}
+
+// commitComments returns the set of comments listed at list, reading every
+// page.
+func (c *apiClient) commitComments(list string) (map[commitComment]bool, error) {
+	const perPage = 100
+
+	posted := map[commitComment]bool{}
+	for page := 1; ; page++ {
+		var comments []commitComment
+		err := c.do("GET", fmt.Sprintf("%s?per_page=%d&page=%d", list, perPage, page), nil, &comments)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cm := range comments {
+			posted[cm] = true
+		}
+
+		if len(comments) < perPage {
+			return posted, nil
+		}
+	}
+}
+
+// apiClient makes requests to the REST API of a provider.
+type apiClient struct {
+	provider string
+	base     string
+	token    string
+	client   *http.Client
+}
+
+// upsertComment updates the comment holding publishMarker among the first
+// page of comments listed at list, at prefix followed by its ID, or adds
+// body to list if there is none.
+func (c *apiClient) upsertComment(list, prefix, body string) error {
+	var comments []struct {
+		ID   int64  `json:"id"`
+		Body string `json:"body"`
+	}
+
+	err := c.do("GET", list+"?per_page=100", nil, &comments)
+	if err != nil {
+		return err
+	}
+
+	for _, cm := range comments {
+		if strings.Contains(cm.Body, publishMarker) {
+			method := "PATCH"
+			if c.provider == "gitlab" {
+				method = "PUT"
+			}
+
+			return c.do(method, prefix+strconv.FormatInt(cm.ID, 10), map[string]string{"body": body}, nil)
+		}
+	}
+
+	return c.do("POST", list, map[string]string{"body": body}, nil)
+}
+
+// do sends body as JSON to the API path and decodes the response into
+// result unless it is nil.
+func (c *apiClient) do(method, path string, body, result interface{}) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		r = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, c.base+path, r)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.provider == "github" {
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	} else {
+		req.Header.Set("PRIVATE-TOKEN", c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		msg := strings.TrimSpace(string(b))
+		if len(msg) > 500 {
+			msg = msg[:500]
+		}
+
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, msg)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.Unmarshal(b, result)
+}
@@ -0,0 +1,321 @@
+// Package report turns Go coverage profiles into coverage reports. It is
+// the implementation of the gocover-html command and can be used to build
+// the same reports from other programs.
+package report
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+
+	"golang.org/x/tools/cover"
+)
+
+// Options configures the reports built from a set of profiles. The zero
+// value builds an HTML report with all assets in the light theme.
+type Options struct {
+	// Profiles are the profile sets of the report, Generate adds its
+	// profile paths as an unlabelled set in front of them.
+	Profiles []*ProfileSet
+	// Outfile is the file Run writes the report to, "-" is stdout. Without
+	// it Run writes to stdout, or for HTML to a temporary file opened in
+	// the browser.
+	Outfile string
+	// OutDir is a directory Run writes HTML reports to as separate pages
+	// instead of a single file.
+	OutDir string
+	// Format is the output format of Run: html, json, text, csv, lcov,
+	// cobertura, sarif, uncovered-funcs or annotated-diff.
+	Format string
+	// Badge is a file Run writes an SVG coverage badge to. Its color goes
+	// from red at BadgeLow percent to green at BadgeHigh percent.
+	Badge     string
+	BadgeLow  float64
+	BadgeHigh float64
+	// CheckOnly makes Run check the coverage gates without writing a
+	// report.
+	CheckOnly bool
+	// Assets are the asset groups inlined into HTML reports, see
+	// AssetGroups. A nil map selects all of them.
+	Assets map[string]bool
+	// Theme is the default theme of HTML reports, one of Themes.
+	Theme string
+	// CustomCSS is a stylesheet added to HTML reports after the bundled
+	// ones. It can restyle them by setting the --gocover-* variables,
+	// like --gocover-uncovered for the color of uncovered lines.
+	CustomCSS string
+	// ResDir is a directory with assets replacing the embedded ones of
+	// the same name, such as index.html or prism.css.
+	ResDir string
+	// Meta annotates the report with key/value pairs.
+	Meta []MetaEntry
+
+	// History is a JSON file every run appends its total and package
+	// coverage to, HTML reports chart the recorded runs.
+	History string
+
+	// Diff is a git ref, the lines changed since then are reported
+	// separately as the patch coverage.
+	Diff string
+
+	// Compare are baseline profiles, of an earlier run for instance, the
+	// report shows the coverage change of every file since and marks the
+	// blocks that no longer run.
+	Compare []string
+
+	// Threshold, MinFile, MinDiff, MaxUncovered, FailZeroPackages and
+	// MinPackage are the coverage gates checked by Report.Check. A zero
+	// Threshold, MinFile, MinDiff or MinPackage and a nil MaxUncovered
+	// disable their gate.
+	Threshold        float64
+	MinFile          float64
+	MinDiff          float64
+	MaxUncovered     *int64
+	FailZeroPackages bool
+	MinPackage       float64
+
+	// Include and Exclude select the files of the report by matching
+	// their import path, generated files are only included with
+	// IncludeGenerated.
+	Include          *regexp.Regexp
+	Exclude          *regexp.Regexp
+	IncludeGenerated bool
+
+	// Bars draws coverage bars in text output. Color, one of ColorModes
+	// and auto if empty, colors its coverage like the badge.
+	Bars  bool
+	Color string
+
+	// Precision is the number of decimals of the coverage percentages of
+	// every output and gate message, 2 if it is nil.
+	Precision *int
+
+	AfterCommand string
+	Timeout      time.Duration
+	ExcludeFunc  *regexp.Regexp
+	Overlap      bool
+	Funcs        string
+	FetchDeps    bool
+	ModuleDirs   []ModuleDir
+	Collapsed    bool
+	Patch        string
+	Overview     bool
+
+	// Sort orders the files of the report, one of SortOrders. They are
+	// in profile order if it is empty.
+	Sort string
+
+	// MaxFileSize is the size in bytes of the largest source shown in
+	// HTML reports. Larger files aren't read, they only have their
+	// coverage reported, without their ignore directives and ExcludeFunc
+	// applied. Zero means no limit.
+	MaxFileSize int64
+
+	// Jobs is the number of files loaded and rendered concurrently,
+	// GOMAXPROCS if it is zero. The report is the same for any number.
+	Jobs int
+
+	// Resolver locates the source files of the report. Without it they
+	// are looked up with go list and go/build.
+	Resolver SourceResolver
+
+	// TrimPrefix is removed from the file names of the profiles, leaving
+	// import paths, and SrcMap rewrites their prefixes, for profiles
+	// recorded on another machine or in a container. The report uses
+	// the new names.
+	TrimPrefix string
+	SrcMap     []PathMap
+
+	// SkipMissing reports files whose source can't be found with their
+	// coverage but without source, instead of failing.
+	SkipMissing bool
+
+	// Warnings receives the problems that don't fail the report, such as
+	// the files reported without source with SkipMissing or merged by
+	// line. They are discarded if it is nil.
+	Warnings io.Writer
+
+	// Archive is the zip file HTML reports are bundled into once written
+	// and Upload the s3://bucket/prefix or HTTP URL they are uploaded to,
+	// the archive if there is one and the report files otherwise.
+	Archive string
+	Upload  string
+
+	// liveReload makes HTML reports reload when Serve regenerates them.
+	liveReload bool
+}
+
+// MetaEntry is a single key/value annotation rendered in the report.
+type MetaEntry struct {
+	Key   string
+	Value string
+}
+
+// PathMap rewrites profile file names starting with Old to start with New
+// instead.
+type PathMap struct {
+	Old string
+	New string
+}
+
+// SourceResolver returns the path of the source of a file named by
+// import path in a coverage profile, like example.com/mod/pkg/file.go.
+type SourceResolver func(name string) (string, error)
+
+// Report is a coverage report built by Generate or BuildReport.
+type Report struct {
+	data *templateData
+	tabs []*templateData
+	opts Options
+}
+
+// Generate builds the report of the profiles in profilePaths merged
+// together, followed by the profile sets in opts.Profiles.
+func Generate(profilePaths []string, opts Options) (Report, error) {
+	if len(profilePaths) > 0 {
+		opts.Profiles = append([]*ProfileSet{{Paths: profilePaths}}, opts.Profiles...)
+	}
+
+	opts = withDefaults(opts)
+
+	d, tabs, err := loadReports(opts)
+	if err != nil {
+		return Report{}, err
+	}
+
+	return Report{data: d, tabs: tabs, opts: opts}, nil
+}
+
+// ParseProfiles parses the profile files at paths, "-" reading stdin, and
+// merges them into one profile per source file.
+func ParseProfiles(paths ...string) ([]*cover.Profile, error) {
+	return parseProfiles(paths, nil)
+}
+
+// BuildReport builds the report of already parsed profiles, locating their
+// sources with resolve, or like Generate if resolve is nil. Profile sets
+// in opts.Profiles are ignored.
+func BuildReport(profiles []*cover.Profile, resolve SourceResolver, opts Options) (Report, error) {
+	opts = withDefaults(opts)
+	if resolve != nil {
+		opts.Resolver = resolve
+	}
+
+	profiles, err := remapProfiles(profiles, opts)
+	if err != nil {
+		return Report{}, err
+	}
+
+	d, err := buildTemplateData(profiles, opts)
+	if err != nil {
+		return Report{}, err
+	}
+
+	d.Meta = opts.Meta
+	d.Collapsed = opts.Collapsed
+
+	return Report{data: &d, opts: opts}, nil
+}
+
+// warnf writes a warning to w unless it is nil.
+func warnf(w io.Writer, format string, args ...interface{}) {
+	if w != nil {
+		fmt.Fprintf(w, "gocover-html: warning: "+format+"\n", args...)
+	}
+}
+
+// withDefaults fills in the options left at their zero value.
+func withDefaults(opts Options) Options {
+	if opts.Format == "" {
+		opts.Format = "html"
+	}
+
+	if opts.Assets == nil {
+		opts.Assets = map[string]bool{}
+		for _, g := range AssetGroups {
+			opts.Assets[g] = true
+		}
+	}
+
+	if opts.Theme == "" {
+		opts.Theme = "light"
+	}
+
+	if opts.BadgeLow == 0 && opts.BadgeHigh == 0 {
+		opts.BadgeLow, opts.BadgeHigh = 50, 80
+	}
+
+	if opts.Precision == nil {
+		precision := 2
+		opts.Precision = &precision
+	}
+
+	return opts
+}
+
+// Coverage returns the statement weighted total coverage of the report as
+// a percentage.
+func (r Report) Coverage() float64 {
+	return totalCoverage(r.data)
+}
+
+// Check runs the coverage gates of the report options and returns the
+// first failure, a *CoverageError, or ErrInterrupted for partial reports.
+func (r Report) Check() error {
+	return checkGates(r.data, r.opts)
+}
+
+// WriteHTML writes the report as a self-contained HTML page to w.
+func (r Report) WriteHTML(w io.Writer) error {
+	return getTemplate(w, r.data, r.tabs, r.opts)
+}
+
+// WriteBadge writes an SVG badge showing the total coverage of the report
+// to w.
+func (r Report) WriteBadge(w io.Writer) error {
+	return writeBadge(w, r.Coverage(), r.opts)
+}
+
+// WriteJSON writes the report in the JSON format described by JSONReport
+// to w.
+func (r Report) WriteJSON(w io.Writer) error {
+	return writeJSON(w, r.data)
+}
+
+// Run writes the report of the profile sets in opts.Profiles in
+// opts.Format, runs opts.AfterCommand and checks the coverage gates, like
+// the gocover-html command does.
+func Run(opts Options) error {
+	opts = withDefaults(opts)
+	if opts.CheckOnly {
+		return checkOutput(opts)
+	}
+
+	switch opts.Format {
+	case "html":
+		if opts.OutDir != "" {
+			return pagesOutput(opts)
+		}
+
+		return htmlOutput(opts)
+	case "json":
+		return jsonOutput(opts)
+	case "text":
+		return textOutput(opts)
+	case "csv":
+		return csvOutput(opts)
+	case "lcov":
+		return lcovOutput(opts)
+	case "cobertura":
+		return coberturaOutput(opts)
+	case "sarif":
+		return sarifOutput(opts)
+	case "uncovered-funcs":
+		return uncoveredFuncsOutput(opts)
+	case "annotated-diff":
+		return annotatedDiffOutput(opts)
+	default:
+		return fmt.Errorf("unknown format %q", opts.Format)
+	}
+}
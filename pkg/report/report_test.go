@@ -0,0 +1,266 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aronluigi/gocover-html/pkg/report"
+	"golang.org/x/tools/cover"
+)
+
+const calcSource = `package calc
+
+// Add returns the sum of a and b.
+func Add(a, b int) int {
+	return a + b
+}
+
+// Abs returns the absolute value of n.
+func Abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+`
+
+const calcProfile = `mode: set
+example.com/mod/calc/calc.go:4.24,6.2 1 1
+example.com/mod/calc/calc.go:9.21,10.11 1 1
+example.com/mod/calc/calc.go:10.11,12.3 1 0
+example.com/mod/calc/calc.go:13.2,13.10 1 1
+`
+
+// writeModule writes the calc package and its profile to a temporary
+// directory and returns the directory and the profile path.
+func writeModule(t *testing.T) (string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	err := os.MkdirAll(filepath.Join(dir, "calc"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, "calc", "calc.go"), []byte(calcSource), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profile := filepath.Join(dir, "cover.out")
+	err = os.WriteFile(profile, []byte(calcProfile), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dir, profile
+}
+
+func TestGenerate(t *testing.T) {
+	dir, profile := writeModule(t)
+
+	r, err := report.Generate([]string{profile}, report.Options{
+		ModuleDirs: []report.ModuleDir{{Path: "example.com/mod", Dir: dir}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := r.Coverage(); got != 75 {
+		t.Errorf("Coverage() = %v, want 75", got)
+	}
+
+	var html bytes.Buffer
+	err = r.WriteHTML(&html)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"example.com/mod/calc/calc.go", "func Abs(n int) int"} {
+		if !strings.Contains(html.String(), want) {
+			t.Errorf("HTML report does not contain %q", want)
+		}
+	}
+
+	if err := r.Check(); err != nil {
+		t.Errorf("Check() = %v without gates", err)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	dir, profile := writeModule(t)
+
+	r, err := report.Generate([]string{profile}, report.Options{
+		ModuleDirs: []report.ModuleDir{{Path: "example.com/mod", Dir: dir}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	err = r.WriteJSON(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got report.JSONReport
+	err = json.Unmarshal(b.Bytes(), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Consumers check the version before reading anything else, bumping
+	// it must be deliberate.
+	if got.SchemaVersion != 2 || report.SchemaVersion != 2 {
+		t.Errorf("JSON report schema version = %d, SchemaVersion = %d, want 2", got.SchemaVersion, report.SchemaVersion)
+	}
+
+	if got.Mode != "set" || got.Coverage != 75 || got.Covered != 3 || got.Statements != 4 {
+		t.Errorf("JSON report totals = %s %v%% %d/%d, want set 75%% 3/4", got.Mode, got.Coverage, got.Covered, got.Statements)
+	}
+
+	if len(got.Files) != 1 {
+		t.Fatalf("JSON report has %d files, want 1", len(got.Files))
+	}
+
+	f := got.Files[0]
+	if f.Name != "example.com/mod/calc/calc.go" || f.Coverage != 75 || f.Covered != 3 || f.Statements != 4 || len(f.Blocks) != 4 {
+		t.Errorf("JSON report file = %s %v%% %d/%d with %d blocks, want example.com/mod/calc/calc.go 75%% 3/4 with 4 blocks",
+			f.Name, f.Coverage, f.Covered, f.Statements, len(f.Blocks))
+	}
+
+	want := report.BlockReport{StartLine: 10, StartCol: 11, EndLine: 12, EndCol: 3, Statements: 1, Count: 0}
+	if len(f.Blocks) == 4 && f.Blocks[2] != want {
+		t.Errorf("JSON report block = %+v, want %+v", f.Blocks[2], want)
+	}
+}
+
+func TestBuildReportZeroOptions(t *testing.T) {
+	dir, _ := writeModule(t)
+
+	profiles, err := cover.ParseProfilesFromReader(strings.NewReader(calcProfile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolve := func(name string) (string, error) {
+		return filepath.Join(dir, strings.TrimPrefix(name, "example.com/mod/")), nil
+	}
+
+	r, err := report.BuildReport(profiles, resolve, report.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Check(); err != nil {
+		t.Errorf("Check() = %v, the zero Options have no gates", err)
+	}
+
+	zero := int64(0)
+	r, err = report.BuildReport(profiles, resolve, report.Options{MaxUncovered: &zero})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Check(); err == nil {
+		t.Error("Check() = nil with an uncovered statement and MaxUncovered 0")
+	}
+}
+
+// writeLargeModule writes a module of n generated files with a few
+// hundred statements each and their profile to a temporary directory and
+// returns the directory and the profile path.
+func writeLargeModule(tb testing.TB, n int) (string, string) {
+	tb.Helper()
+
+	dir := tb.TempDir()
+	err := os.MkdirAll(filepath.Join(dir, "big"), 0755)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	profile := []string{"mode: count"}
+	for i := 0; i < n; i++ {
+		var src strings.Builder
+		src.WriteString("package big\n")
+		for j := 0; j < 200; j++ {
+			line := 2 + 3*j
+			fmt.Fprintf(&src, "\nfunc F%d_%d(a int) bool {\n\treturn a < %d && a > 0\n}\n", i, j, j)
+			profile = append(profile, fmt.Sprintf("example.com/mod/big/f%d.go:%d.25,%d.2 1 %d", i, line+1, line+3, (i+j)%3))
+		}
+
+		err = os.WriteFile(filepath.Join(dir, "big", fmt.Sprintf("f%d.go", i)), []byte(src.String()), 0644)
+		if err != nil {
+			tb.Fatal(err)
+		}
+	}
+
+	name := filepath.Join(dir, "cover.out")
+	err = os.WriteFile(name, []byte(strings.Join(profile, "\n")+"\n"), 0644)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return dir, name
+}
+
+func TestWriteHTMLJobs(t *testing.T) {
+	dir, profile := writeLargeModule(t, 20)
+
+	var want []byte
+	for _, jobs := range []int{1, 4, 32} {
+		r, err := report.Generate([]string{profile}, report.Options{
+			ModuleDirs: []report.ModuleDir{{Path: "example.com/mod", Dir: dir}},
+			Jobs:       jobs,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var b bytes.Buffer
+		err = r.WriteHTML(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if want == nil {
+			if !strings.Contains(b.String(), "func F19_199(a int) bool") {
+				t.Fatal("HTML report does not contain the source of the last file")
+			}
+
+			want = b.Bytes()
+			continue
+		}
+
+		if !bytes.Equal(b.Bytes(), want) {
+			t.Errorf("HTML report with %d jobs differs from the one with 1 job", jobs)
+		}
+	}
+}
+
+func BenchmarkWriteHTML(b *testing.B) {
+	dir, profile := writeLargeModule(b, 50)
+
+	r, err := report.Generate([]string{profile}, report.Options{
+		ModuleDirs: []report.ModuleDir{{Path: "example.com/mod", Dir: dir}},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err := r.WriteHTML(io.Discard)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/tools/cover"
+)
+
+// sarifRuleID is the rule every uncovered region is reported as.
+const sarifRuleID = "uncovered"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool struct {
+		Driver sarifDriver `json:"driver"`
+	} `json:"tool"`
+	OriginalURIBaseIDs map[string]sarifArtifact `json:"originalUriBaseIds,omitempty"`
+	Results            []sarifResult            `json:"results"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	DefaultConfig    struct {
+		Level string `json:"level"`
+	} `json:"defaultConfiguration"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifArtifact struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation sarifArtifact `json:"artifactLocation"`
+		Region           sarifRegion   `json:"region"`
+	} `json:"physicalLocation"`
+}
+
+// sarifRegion is a range of source, lines and columns start at 1 and the
+// end column is exclusive like in profiles.
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+
+	stmts int
+}
+
+// sarifOutput writes the uncovered code as a SARIF log.
+func sarifOutput(opts Options) error {
+	return writeFormat(opts, merged(writeSARIF))
+}
+
+// writeSARIF writes a SARIF 2.1.0 log with a result per uncovered region
+// of the report, for code review tools and editors to show inline. Paths
+// below the working directory are relative to the SRCROOT base.
+func writeSARIF(w io.Writer, d *templateData) error {
+	run := sarifRun{Results: []sarifResult{}}
+	run.Tool.Driver = sarifDriver{
+		Name:           "gocover-html",
+		InformationURI: "https://github.com/aronluigi/gocover-html",
+		Rules:          []sarifRule{{ID: sarifRuleID, ShortDescription: sarifMessage{"Statements not covered by tests"}}},
+	}
+	run.Tool.Driver.Rules[0].DefaultConfig.Level = "note"
+
+	if wd, err := os.Getwd(); err == nil {
+		run.OriginalURIBaseIDs = map[string]sarifArtifact{"SRCROOT": {URI: fileURL(wd) + "/"}}
+	}
+
+	for _, f := range d.Files {
+		loc := sarifArtifact{URI: sourcePath(f), URIBaseID: "SRCROOT"}
+		if filepath.IsAbs(loc.URI) {
+			loc = sarifArtifact{URI: fileURL(loc.URI)}
+		}
+
+		for _, r := range uncoveredRegions(f.profile) {
+			stmts := "statements"
+			if r.stmts == 1 {
+				stmts = "statement"
+			}
+
+			res := sarifResult{
+				RuleID:    sarifRuleID,
+				Level:     "note",
+				Message:   sarifMessage{fmt.Sprintf("%d %s not covered by tests.", r.stmts, stmts)},
+				Locations: make([]sarifLocation, 1),
+			}
+			res.Locations[0].PhysicalLocation.ArtifactLocation = loc
+			res.Locations[0].PhysicalLocation.Region = r
+
+			run.Results = append(run.Results, res)
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	})
+}
+
+// uncoveredRegions returns the runs of uncovered blocks of the profile in
+// source order. Blocks on the same or the next line are joined unless a
+// block that ran lies between them.
+func uncoveredRegions(p *cover.Profile) []sarifRegion {
+	blocks := append([]cover.ProfileBlock(nil), p.Blocks...)
+	sort.SliceStable(blocks, func(i, j int) bool {
+		if blocks[i].StartLine != blocks[j].StartLine {
+			return blocks[i].StartLine < blocks[j].StartLine
+		}
+
+		return blocks[i].StartCol < blocks[j].StartCol
+	})
+
+	var regions []sarifRegion
+	open := false
+	for _, b := range blocks {
+		if b.Count > 0 {
+			open = false
+			continue
+		}
+
+		if b.NumStmt == 0 {
+			continue
+		}
+
+		n := len(regions)
+		if open && b.StartLine <= regions[n-1].EndLine+1 {
+			r := &regions[n-1]
+			if b.EndLine > r.EndLine || (b.EndLine == r.EndLine && b.EndCol > r.EndColumn) {
+				r.EndLine, r.EndColumn = b.EndLine, b.EndCol
+			}
+			r.stmts += b.NumStmt
+			continue
+		}
+
+		regions = append(regions, sarifRegion{b.StartLine, b.StartCol, b.EndLine, b.EndCol, b.NumStmt})
+		open = true
+	}
+
+	return regions
+}
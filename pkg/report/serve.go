@@ -0,0 +1,196 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// pollInterval is how often Serve checks the profiles and sources for
+// changes.
+const pollInterval = 500 * time.Millisecond
+
+// reloadScript reloads the page served by Serve when the report changes.
+const reloadScript = `<script type="text/javascript">
+ new EventSource('/events').addEventListener('reload', function () {
+     location.reload();
+ });
+</script>`
+
+// server holds the report served by Serve and the browsers waiting for it
+// to change.
+type server struct {
+	opts Options
+
+	mu      sync.Mutex
+	page    []byte
+	files   []string
+	clients map[chan struct{}]bool
+}
+
+// Serve serves the HTML report of the profile sets in opts.Profiles on
+// addr. The profiles and the sources of the report are watched, and when
+// any of them changes the report is regenerated and open browsers reload
+// it. Serve only returns when the server fails.
+func Serve(addr string, opts Options) error {
+	s := &server{opts: withDefaults(opts), clients: map[chan struct{}]bool{}}
+	s.opts.liveReload = true
+	s.update()
+
+	go s.watch()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.serveReport)
+	mux.HandleFunc("/events", s.serveEvents)
+
+	fmt.Fprintf(os.Stderr, "serving coverage report on http://%s/\n", addr)
+	return http.ListenAndServe(addr, mux)
+}
+
+// update regenerates the report, or an error page if that fails, and
+// tells the connected browsers to reload.
+func (s *server) update() {
+	var buf bytes.Buffer
+	files := s.profilePaths()
+
+	d, tabs, err := loadReports(s.opts)
+	if err == nil && s.opts.History != "" {
+		// Regenerated reports are not runs of their own, they only
+		// chart the recorded ones.
+		d.History, err = readHistory(s.opts.History)
+	}
+
+	if err == nil {
+		for _, f := range d.Files {
+			if !f.Missing {
+				files = append(files, f.file)
+			}
+		}
+
+		err = getTemplate(&buf, d, tabs, s.opts)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gocover-html:", err)
+		buf.Reset()
+		fmt.Fprintf(&buf, "<!doctype html><title>Coverage report</title><pre>%s</pre>%s",
+			html.EscapeString(err.Error()), reloadScript)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.page = buf.Bytes()
+	s.files = files
+	for c := range s.clients {
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// profilePaths returns the profile files of the report, leaving out stdin
+// which can't change.
+func (s *server) profilePaths() []string {
+	var paths []string
+
+	for _, set := range s.opts.Profiles {
+		for _, p := range set.Paths {
+			if p != stdinName {
+				paths = append(paths, p)
+			}
+		}
+	}
+
+	return paths
+}
+
+// watch polls the modification times of the watched files and updates the
+// report whenever one of them changes.
+func (s *server) watch() {
+	seen := map[string]time.Time{}
+	changed := func() bool {
+		s.mu.Lock()
+		files := s.files
+		s.mu.Unlock()
+
+		c := false
+		for _, f := range files {
+			var mod time.Time
+			if fi, err := os.Stat(f); err == nil {
+				mod = fi.ModTime()
+			}
+
+			if last, ok := seen[f]; ok && !last.Equal(mod) {
+				c = true
+			}
+			seen[f] = mod
+		}
+
+		return c
+	}
+
+	changed()
+	for range time.Tick(pollInterval) {
+		if changed() {
+			s.update()
+			changed()
+		}
+	}
+}
+
+func (s *server) serveReport(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	page := s.page
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(page)
+}
+
+// serveEvents streams server-sent events, sending a reload event every
+// time the report changes.
+func (s *server) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	c := make(chan struct{}, 1)
+	s.mu.Lock()
+	s.clients[c] = true
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, c)
+		s.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-c:
+			fmt.Fprint(w, "event: reload\ndata: \n\n")
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
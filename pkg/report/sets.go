@@ -0,0 +1,75 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CombinedLabel names the tab holding the union of all labelled profile
+// sets.
+const CombinedLabel = "combined"
+
+// ProfileSet is a group of profiles merged into a single report. Sets are
+// labelled when several of them are rendered side by side.
+type ProfileSet struct {
+	Label string
+	Paths []string
+}
+
+// loadReports builds the report of all profiles in opts.Profiles merged
+// together. If any of the profile sets is labelled, a report is also built
+// for every set on its own, preceded by the merged one, so they can be
+// shown side by side, and the overlap of the sets if opts.Overlap is set.
+func loadReports(opts Options) (*templateData, []*templateData, error) {
+	var all []string
+	labelled := false
+
+	for _, s := range opts.Profiles {
+		all = append(all, s.Paths...)
+		labelled = labelled || s.Label != ""
+	}
+
+	d, err := getTemplateData(all, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d.Meta = opts.Meta
+	d.Collapsed = opts.Collapsed
+	if !labelled {
+		if opts.Overlap {
+			return nil, nil, fmt.Errorf("overlap needs two labelled profile sets")
+		}
+
+		return &d, nil, nil
+	}
+
+	d.Label = CombinedLabel
+	d.Prefix = "t0-"
+	tabs := []*templateData{&d}
+
+	for k, s := range opts.Profiles {
+		t, err := getTemplateData(s.Paths, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		t.Label = s.Label
+		if t.Label == "" {
+			t.Label = strings.Join(s.Paths, ",")
+		}
+
+		t.Prefix = fmt.Sprintf("t%d-", k+1)
+		t.Collapsed = opts.Collapsed
+		tabs = append(tabs, &t)
+	}
+
+	if opts.Overlap && !d.Partial {
+		d.Overlap, err = getOverlap(opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return &d, tabs, nil
+}
@@ -0,0 +1,81 @@
+package report
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+)
+
+// ErrInterrupted is returned after a partial report was written because
+// the run was interrupted.
+var ErrInterrupted = errors.New("interrupted, partial report written")
+
+var (
+	interrupts chan os.Signal
+	stopped    bool
+	released   bool
+	stoppedMu  sync.Mutex
+)
+
+// CatchInterrupts installs a SIGINT handler so that an interrupted run
+// stops processing further files and writes out the files processed so
+// far as a partial report. A second SIGINT terminates the tool as usual,
+// so does any SIGINT once the files are processed.
+func CatchInterrupts() {
+	interrupts = make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	go watchInterrupts(interrupts)
+}
+
+// watchInterrupts waits for the first SIGINT on c and restores the default
+// handling, so the next one terminates the tool.
+func watchInterrupts(c chan os.Signal) {
+	_, ok := <-c
+
+	stoppedMu.Lock()
+	defer stoppedMu.Unlock()
+
+	signal.Stop(c)
+	switch {
+	case !ok:
+	case released:
+		// It arrived as the handler was released, too late for a
+		// partial report.
+		raiseInterrupt()
+	default:
+		stopped = true
+	}
+}
+
+// releaseInterrupts restores the default handling of SIGINT once the files
+// of a report are processed, an interrupt while the report is written,
+// shipped or the after command runs terminates the tool.
+func releaseInterrupts() {
+	stoppedMu.Lock()
+	defer stoppedMu.Unlock()
+
+	if interrupts == nil || released {
+		return
+	}
+
+	released = true
+	signal.Stop(interrupts)
+	close(interrupts)
+}
+
+// raiseInterrupt sends SIGINT to the tool itself.
+func raiseInterrupt() {
+	if p, err := os.FindProcess(os.Getpid()); err == nil {
+		p.Signal(os.Interrupt)
+	}
+}
+
+// interrupted reports whether SIGINT was received since CatchInterrupts
+// was called. It is safe for concurrent use.
+func interrupted() bool {
+	stoppedMu.Lock()
+	defer stoppedMu.Unlock()
+
+	return stopped
+}
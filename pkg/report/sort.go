@@ -0,0 +1,66 @@
+package report
+
+import "sort"
+
+// SortOrders are the values accepted by -sort: name ascending, coverage
+// ascending, and most uncovered or most statements first.
+var SortOrders = []string{"name", "coverage", "uncovered", "size"}
+
+// fileOrders compare two files in every sort order. Ties are broken by
+// name, so the order doesn't depend on the order of the profiles.
+var fileOrders = map[string]func(a, b *templateFile) bool{
+	"name": func(a, b *templateFile) bool { return a.Name < b.Name },
+	"coverage": func(a, b *templateFile) bool {
+		if a.Coverage != b.Coverage {
+			return a.Coverage < b.Coverage
+		}
+
+		return a.Name < b.Name
+	},
+	"uncovered": func(a, b *templateFile) bool {
+		if a.Uncovered() != b.Uncovered() {
+			return a.Uncovered() > b.Uncovered()
+		}
+
+		return a.Name < b.Name
+	},
+	"size": func(a, b *templateFile) bool {
+		if a.Statements != b.Statements {
+			return a.Statements > b.Statements
+		}
+
+		return a.Name < b.Name
+	},
+}
+
+// sortFiles sorts files in one of SortOrders, they are left in profile
+// order if by is empty.
+func sortFiles(files []*templateFile, by string) {
+	less := fileOrders[by]
+	if less == nil {
+		return
+	}
+
+	sort.SliceStable(files, func(i, j int) bool { return less(files[i], files[j]) })
+}
+
+// Uncovered returns the number of statements of the file that were not
+// executed.
+func (f *templateFile) Uncovered() int64 {
+	return f.Statements - f.Covered
+}
+
+// SortKey returns the initial order of the file lists of the HTML report,
+// one of the values of its sort control.
+func (d *templateData) SortKey() string {
+	switch d.Sort {
+	case "name":
+		return "name-asc"
+	case "uncovered":
+		return "uncovered-desc"
+	case "size":
+		return "size-desc"
+	default:
+		return "coverage-asc"
+	}
+}
@@ -0,0 +1,313 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// barWidth is the number of characters used to draw a coverage bar.
+const barWidth = 20
+
+// ANSI colors of the coverage in text output. They are all as long, so
+// colored columns stay aligned.
+const (
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiGreen  = "\x1b[32m"
+	ansiReset  = "\x1b[0m"
+)
+
+// ColorModes are the values accepted by -color.
+var ColorModes = []string{"auto", "always", "never"}
+
+// textOutput writes a plain text coverage summary. Labelled profile sets
+// are summarized one after the other.
+func textOutput(opts Options) error {
+	return writeFormat(opts, func(w io.Writer, d *templateData, tabs []*templateData) error {
+		if d.Partial {
+			fmt.Fprintln(w, "partial report, interrupted before all files were read")
+		}
+
+		var err error
+		color := useColor(opts.Color, w)
+		if len(tabs) == 0 {
+			err = writeText(w, d, opts, color)
+		}
+
+		for k, t := range tabs {
+			if err != nil {
+				break
+			}
+
+			if k > 0 {
+				fmt.Fprintln(w)
+			}
+
+			fmt.Fprintf(w, "%s:\n", t.Label)
+			err = writeText(w, t, opts, color)
+		}
+
+		if err == nil && d.Overlap != nil {
+			fmt.Fprintln(w)
+			err = writeOverlap(w, d.Overlap)
+		}
+
+		return err
+	})
+}
+
+// formatWriter writes the merged report d of a run, and tabs, the reports
+// of its labelled profile sets if there are any, to w.
+type formatWriter func(w io.Writer, d *templateData, tabs []*templateData) error
+
+// merged returns the formatWriter of a format only written for the merged
+// report.
+func merged(write func(io.Writer, *templateData) error) formatWriter {
+	return func(w io.Writer, d *templateData, _ []*templateData) error {
+		return write(w, d)
+	}
+}
+
+// writeFormat reads the profile sets in opts.Profiles and writes their
+// report with write to opts.Outfile, or to stdout if outfile is empty.
+// Like for HTML reports the run is recorded in the history, the badge
+// written, the after command run and the coverage gates checked.
+func writeFormat(opts Options, write formatWriter) error {
+	d, tabs, err := loadReports(opts)
+	if err != nil {
+		return err
+	}
+
+	releaseInterrupts()
+
+	err = recordHistory(d, opts)
+	if err != nil {
+		return err
+	}
+
+	out, err := createOutput(opts.Outfile)
+	if err != nil {
+		return err
+	}
+
+	err = write(out, d, tabs)
+	if err == nil {
+		err = closeOutput(out)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = writeBadgeFile(d, opts)
+	if err != nil {
+		return err
+	}
+
+	err = runAfterCommand(opts.AfterCommand, opts.Outfile, opts.Timeout)
+	if err != nil {
+		return err
+	}
+
+	return checkGates(d, opts)
+}
+
+// stdoutName is the output name that writes the report to stdout.
+const stdoutName = "-"
+
+// createOutput creates the named output file, or returns stdout if name is
+// empty or "-".
+func createOutput(name string) (*os.File, error) {
+	if name == "" || name == stdoutName {
+		return os.Stdout, nil
+	}
+
+	return os.Create(name)
+}
+
+// closeOutput closes a file returned by createOutput.
+func closeOutput(f *os.File) error {
+	if f == os.Stdout {
+		return nil
+	}
+
+	return f.Close()
+}
+
+// writeText writes one aligned line per file followed by the report total.
+// If opts.Bars is set each line ends with a bar scaled to the coverage,
+// compared reports add the change since the baseline. If
+// color is set the coverage and bars are red below opts.BadgeLow, green
+// from opts.BadgeHigh and yellow in between.
+func writeText(w io.Writer, d *templateData, opts Options, color bool) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	full, empty := "█", "░"
+	if !unicodeTerminal() {
+		full, empty = "#", "-"
+	}
+
+	// Percentages are right aligned, up to 100 followed by the decimals.
+	width := 3
+	if d.precision > 0 {
+		width += 1 + d.precision
+	}
+
+	line := func(name string, covered, total int64, cov float64, delta string) {
+		start, end := "", ""
+		if color {
+			start, end = coverageColor(cov, opts.BadgeLow, opts.BadgeHigh), ansiReset
+		}
+
+		fmt.Fprintf(tw, "%s\t%d/%d\t%s%*s%%%s", name, covered, total, start, width, formatCoverage(cov, d.precision), end)
+		if opts.Bars {
+			fmt.Fprintf(tw, "\t%s%s%s", start, bar(cov, full, empty), end)
+		}
+		if d.Compared {
+			fmt.Fprintf(tw, "\t%s", delta)
+		}
+		fmt.Fprintln(tw)
+	}
+
+	for _, f := range d.Files {
+		delta := "new"
+		if !f.Added {
+			delta = formatChange(f.Delta(), d.precision) + "%"
+		}
+
+		line(f.Name, f.Covered, f.Statements, f.Coverage, delta)
+	}
+
+	covered, total := fileCounts(d.Files)
+	name := "total"
+	if d.Filtered {
+		name = "total (filtered)"
+	}
+
+	line(name, covered, total, totalCoverage(d), formatChange(d.CoverageDelta(), d.precision)+"%")
+
+	own, deps := splitDependencies(d.Files)
+	if len(deps) > 0 {
+		c, t := fileCounts(own)
+		line("module code", c, t, filesCoverage(own), "")
+		c, t = fileCounts(deps)
+		line("dependency code", c, t, filesCoverage(deps), "")
+	}
+
+	if d.Diff != "" {
+		c, t := d.patchCounts()
+		line("patch lines since "+d.Diff, c, t, d.PatchCoverage(), "")
+	}
+
+	for _, name := range d.Removed {
+		fmt.Fprintf(tw, "%s\tremoved\n", name)
+	}
+
+	err := tw.Flush()
+	if err != nil || !d.HasFloors() {
+		return err
+	}
+
+	return writeFloors(w, d)
+}
+
+// writeFloors writes the coverage of every package of d with a floor next
+// to the floor and where it was declared.
+func writeFloors(w io.Writer, d *templateData) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "\npackage floors:\tcoverage\tfloor\tsource\n")
+	for _, p := range d.Packages {
+		if p.FloorSource == "" {
+			continue
+		}
+
+		status := ""
+		if p.Coverage() < p.Floor {
+			status = "\tbelow"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s%%\t%s%%\t%s%s\n", p.Path, formatCoverage(p.Coverage(), d.precision), formatCoverage(p.Floor, d.precision), p.FloorSource, status)
+	}
+
+	return tw.Flush()
+}
+
+// writeOverlap writes the statements covered by both, either or neither of
+// the compared profile sets, per file and in total.
+func writeOverlap(w io.Writer, r *overlapReport) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "overlap:\tboth\tonly %s\tonly %s\tneither\n", r.A, r.B)
+	for _, c := range append(r.Files, &r.Total) {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\n", c.Name, c.Both, c.OnlyA, c.OnlyB, c.Neither)
+	}
+
+	return tw.Flush()
+}
+
+// bar draws a barWidth wide bar with the covered fraction drawn using full
+// and the remainder using empty.
+func bar(cov float64, full, empty string) string {
+	n := int(cov/100*barWidth + 0.5)
+	if n < 0 {
+		n = 0
+	}
+	if n > barWidth {
+		n = barWidth
+	}
+
+	return strings.Repeat(full, n) + strings.Repeat(empty, barWidth-n)
+}
+
+// coverageColor returns the ANSI color of a coverage percentage.
+func coverageColor(cov, low, high float64) string {
+	switch {
+	case cov < low:
+		return ansiRed
+	case cov < high:
+		return ansiYellow
+	default:
+		return ansiGreen
+	}
+}
+
+// useColor reports whether text output to out is colored in the given
+// mode, one of ColorModes. In auto mode only terminals get colors, unless
+// the NO_COLOR environment variable is set.
+func useColor(mode string, out io.Writer) bool {
+	switch mode {
+	case "always":
+		return true
+	case "never":
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+
+	f, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// unicodeTerminal reports whether the locale of the environment indicates
+// a UTF-8 capable terminal, following the usual LC_ALL, LC_CTYPE, LANG
+// precedence.
+func unicodeTerminal() bool {
+	for _, k := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		v := strings.ToUpper(os.Getenv(k))
+		if v != "" {
+			return strings.Contains(v, "UTF-8") || strings.Contains(v, "UTF8")
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package report
+
+import (
+	"fmt"
+	"go/ast"
+	"io"
+	"io/ioutil"
+	"sort"
+)
+
+// uncoveredFunc is a function none of whose statements were executed.
+type uncoveredFunc struct {
+	name string
+	file string
+	line int
+}
+
+// uncoveredFuncs returns the functions with 0% coverage in the files of
+// the report, sorted by file and line. opts.Funcs selects whether all,
+// only exported or only unexported functions are listed. Functions
+// matching opts.ExcludeFunc have no statements left and are skipped.
+func uncoveredFuncs(d *templateData, opts Options) ([]*uncoveredFunc, error) {
+	var res []*uncoveredFunc
+	for _, f := range d.Files {
+		if f.Missing || f.Oversized {
+			continue
+		}
+
+		src, err := ioutil.ReadFile(f.file)
+		if err != nil {
+			return nil, err
+		}
+
+		funcs, err := findFuncs(f.Name, src)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fn := range funcs {
+			if !matchesExport(fn.name, opts.Funcs) {
+				continue
+			}
+
+			covered, total := fn.coverage(f.profile)
+			if total == 0 || covered > 0 {
+				continue
+			}
+
+			res = append(res, &uncoveredFunc{
+				name: fn.pkg + "." + fn.name,
+				file: f.Name,
+				line: fn.startLine,
+			})
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].file != res[j].file {
+			return res[i].file < res[j].file
+		}
+
+		return res[i].line < res[j].line
+	})
+
+	return res, nil
+}
+
+// matchesExport reports whether the function, named Func or Type.Method,
+// passes the all, exported or unexported filter.
+func matchesExport(name, filter string) bool {
+	for i := len(name) - 1; i >= 0; i-- {
+		if name[i] == '.' {
+			name = name[i+1:]
+			break
+		}
+	}
+
+	switch filter {
+	case "exported":
+		return ast.IsExported(name)
+	case "unexported":
+		return !ast.IsExported(name)
+	}
+
+	return true
+}
+
+// uncoveredFuncsOutput writes the functions with 0% coverage, one per line
+// as pkg.Func (file:line).
+func uncoveredFuncsOutput(opts Options) error {
+	return writeFormat(opts, merged(func(w io.Writer, d *templateData) error {
+		funcs, err := uncoveredFuncs(d, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range funcs {
+			fmt.Fprintf(w, "%s (%s:%d)\n", f.name, f.file, f.line)
+		}
+
+		return nil
+	}))
+}
@@ -0,0 +1,241 @@
+package report
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// UploadAuthEnv is the environment variable whose value, if set, is sent
+// as the Authorization header of uploads to HTTP URLs.
+const UploadAuthEnv = "GOCOVER_HTML_UPLOAD_AUTHORIZATION"
+
+// uploadFiles uploads files, local paths by slash separated name, to dest.
+// An s3://bucket/prefix dest stores them under the prefix with the AWS
+// credentials of the environment. Any other dest is an HTTP URL a single
+// file is PUT to, or the directory URL every file is PUT under.
+func uploadFiles(dest string, files map[string]string, timeout time.Duration) error {
+	client := &http.Client{Timeout: timeout}
+
+	u, err := url.Parse(dest)
+	if err != nil {
+		return err
+	}
+
+	var s3 *s3Target
+	switch u.Scheme {
+	case "s3":
+		s3, err = newS3Target(u)
+		if err != nil {
+			return err
+		}
+	case "http", "https":
+	default:
+		return fmt.Errorf("can't upload to %q, expected an s3:// or http(s):// URL", dest)
+	}
+
+	for _, name := range sortedKeys(files) {
+		body, err := ioutil.ReadFile(files[name])
+		if err != nil {
+			return err
+		}
+
+		var req *http.Request
+		if s3 != nil {
+			req, err = s3.request(name, body)
+		} else {
+			target := dest
+			if len(files) > 1 || strings.HasSuffix(dest, "/") {
+				target = strings.TrimSuffix(dest, "/") + "/" + name
+			}
+
+			req, err = http.NewRequest("PUT", target, bytes.NewReader(body))
+			if auth := os.Getenv(UploadAuthEnv); auth != "" && err == nil {
+				req.Header.Set("Authorization", auth)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if ct := mime.TypeByExtension(path.Ext(name)); ct != "" {
+			req.Header.Set("Content-Type", ct)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+
+		msg, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode/100 != 2 {
+			return fmt.Errorf("upload %s: %s: %s", name, resp.Status, strings.TrimSpace(string(msg)))
+		}
+	}
+
+	return nil
+}
+
+// s3Target is a bucket and key prefix reports are uploaded to.
+type s3Target struct {
+	endpoint  *url.URL
+	pathStyle bool
+	bucket    string
+	prefix    string
+
+	region       string
+	accessKey    string
+	secretKey    string
+	sessionToken string
+}
+
+// newS3Target returns the upload target of an s3://bucket/prefix URL.
+// Credentials and region are read from the usual AWS_* environment
+// variables, AWS_ENDPOINT_URL_S3 or AWS_ENDPOINT_URL select an S3
+// compatible service.
+func newS3Target(u *url.URL) (*s3Target, error) {
+	t := &s3Target{
+		bucket:       u.Host,
+		prefix:       strings.Trim(u.Path, "/"),
+		region:       orEnv(os.Getenv("AWS_REGION"), "AWS_DEFAULT_REGION", "us-east-1"),
+		accessKey:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+	}
+
+	if t.bucket == "" {
+		return nil, fmt.Errorf("no bucket in %q", u)
+	}
+
+	if t.accessKey == "" || t.secretKey == "" {
+		return nil, fmt.Errorf("uploading to S3 needs AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	}
+
+	endpoint := orEnv(os.Getenv("AWS_ENDPOINT_URL_S3"), "AWS_ENDPOINT_URL", "")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", t.bucket, t.region)
+	} else {
+		// Custom endpoints rarely have a host per bucket.
+		t.pathStyle = true
+	}
+
+	var err error
+	t.endpoint, err = url.Parse(endpoint)
+	return t, err
+}
+
+// request returns the signed request storing body as the object name
+// under the prefix of t.
+func (t *s3Target) request(name string, body []byte) (*http.Request, error) {
+	key := name
+	if t.prefix != "" {
+		key = t.prefix + "/" + name
+	}
+
+	u := *t.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	if t.pathStyle {
+		u.Path = strings.TrimSuffix(t.endpoint.Path, "/") + "/" + t.bucket + "/" + key
+	}
+	u.RawPath = awsEscapePath(u.Path)
+
+	req, err := http.NewRequest("PUT", u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if t.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", t.sessionToken)
+	}
+
+	signV4(req, body, t.region, "s3", t.accessKey, t.secretKey, time.Now())
+	return req, nil
+}
+
+// signV4 signs req with the AWS signature version 4 for service in
+// region, covering its host and X-Amz-* headers and the payload body.
+func signV4(req *http.Request, body []byte, region, service, accessKey, secretKey string, now time.Time) {
+	sum := sha256.Sum256(body)
+	payload := hex.EncodeToString(sum[:])
+	amzDate := now.UTC().Format("20060102T150405Z")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payload)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		if k = strings.ToLower(k); strings.HasPrefix(k, "x-amz-") {
+			headers[k] = strings.TrimSpace(strings.Join(v, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, k := range names {
+		canonical.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signed := strings.Join(names, ";")
+
+	request := strings.Join([]string{
+		req.Method,
+		awsEscapePath(req.URL.Path),
+		req.URL.RawQuery,
+		canonical.String(),
+		signed,
+		payload,
+	}, "\n")
+
+	scope := amzDate[:8] + "/" + region + "/" + service + "/aws4_request"
+	reqSum := sha256.Sum256([]byte(request))
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(reqSum[:])
+
+	key := []byte("AWS4" + secretKey)
+	for _, part := range []string{amzDate[:8], region, service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKey, scope, signed, hex.EncodeToString(hmacSHA256(key, toSign))))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// awsEscapePath escapes every byte of the path but unreserved characters
+// and slashes, as signature version 4 requires.
+func awsEscapePath(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || strings.IndexByte("-_.~/", c) >= 0 {
+			b.WriteByte(c)
+			continue
+		}
+
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
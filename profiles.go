@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aronluigi/gocover-html/pkg/report"
+)
+
+// profileFlag collects repeated -p flags. Each value is a comma-separated
+// list of profiles or glob patterns, optionally prefixed by "label=". Values with the same
+// label, including unlabelled ones, are merged into one set. A value naming
+// an existing file, or whose "label" holds a path separator, is a path
+// containing "=" rather than a labelled one.
+type profileFlag []*report.ProfileSet
+
+func (f *profileFlag) String() string {
+	s := make([]string, len(*f))
+	for k, v := range *f {
+		s[k] = strings.Join(v.Paths, ",")
+		if v.Label != "" {
+			s[k] = v.Label + "=" + s[k]
+		}
+	}
+
+	return strings.Join(s, " ")
+}
+
+func (f *profileFlag) Set(v string) error {
+	var label string
+	if i := strings.Index(v, "="); i >= 0 && !isFile(v) && !isPath(v[:i]) {
+		label, v = v[:i], v[i+1:]
+		if label == "" || label == report.CombinedLabel {
+			return fmt.Errorf("invalid profile label %q", label)
+		}
+	}
+
+	var paths []string
+	for _, p := range strings.Split(v, ",") {
+		if !strings.ContainsAny(p, "*?[") {
+			if p != "" {
+				paths = append(paths, p)
+			}
+			continue
+		}
+
+		matches, err := filepath.Glob(p)
+		if err != nil {
+			return fmt.Errorf("invalid profile pattern %q: %v", p, err)
+		}
+
+		if len(matches) == 0 {
+			return fmt.Errorf("no profile matches %q", p)
+		}
+
+		paths = append(paths, matches...)
+	}
+
+	if len(paths) == 0 {
+		return fmt.Errorf("no profile given")
+	}
+
+	for _, s := range *f {
+		if s.Label == label {
+			s.Paths = append(s.Paths, paths...)
+			return nil
+		}
+	}
+
+	*f = append(*f, &report.ProfileSet{Label: label, Paths: paths})
+	return nil
+}
+
+// isFile reports whether name is an existing file.
+func isFile(name string) bool {
+	fi, err := os.Stat(name)
+	return err == nil && !fi.IsDir()
+}
+
+// isPath reports whether s holds a path separator.
+func isPath(s string) bool {
+	return strings.ContainsRune(s, '/') || strings.ContainsRune(s, filepath.Separator)
+}
+
+// splitList splits a comma-separated flag value, dropping empty items.
+func splitList(v string) []string {
+	var items []string
+	for _, s := range strings.Split(v, ",") {
+		if s != "" {
+			items = append(items, s)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestProfileFlagLabels(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	// A profile whose name holds "=" is a path, not a label.
+	if err := os.WriteFile("run=1.out", []byte("mode: set\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var f profileFlag
+	for _, v := range []string{"run=1.out", "unit=a.out,b.out", filepath.Join("out", "x=y.out")} {
+		if err := f.Set(v); err != nil {
+			t.Fatalf("Set(%q) = %v", v, err)
+		}
+	}
+
+	want := map[string][]string{
+		"":     {"run=1.out", filepath.Join("out", "x=y.out")},
+		"unit": {"a.out", "b.out"},
+	}
+
+	got := map[string][]string{}
+	for _, s := range f {
+		got[s.Label] = s.Paths
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("profile sets = %q, want %q", got, want)
+	}
+
+	for _, v := range []string{"=a.out", "combined=a.out"} {
+		if err := f.Set(v); err == nil {
+			t.Errorf("Set(%q) = nil, want an invalid label error", v)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// sizeUnits are the suffixes accepted by sizeFlag.
+var sizeUnits = []struct {
+	suffix string
+	n      int64
+}{
+	{"KB", 1 << 10},
+	{"MB", 1 << 20},
+	{"GB", 1 << 30},
+	{"K", 1 << 10},
+	{"M", 1 << 20},
+	{"G", 1 << 30},
+	{"B", 1},
+}
+
+// sizeFlag is a size in bytes given as a number with an optional K, M or
+// G suffix, like 512K or 2MB.
+type sizeFlag int64
+
+func (s *sizeFlag) String() string {
+	return strconv.FormatInt(int64(*s), 10)
+}
+
+func (s *sizeFlag) Set(v string) error {
+	n := int64(1)
+	num := strings.ToUpper(strings.TrimSpace(v))
+	for _, u := range sizeUnits {
+		if strings.HasSuffix(num, u.suffix) {
+			num, n = strings.TrimSuffix(num, u.suffix), u.n
+			break
+		}
+	}
+
+	size, err := strconv.ParseInt(strings.TrimSpace(num), 10, 64)
+	if err != nil || size < 0 {
+		return fmt.Errorf("invalid size %q, expected bytes or a number with a K, M or G suffix", v)
+	}
+
+	*s = sizeFlag(size * n)
+	return nil
+}